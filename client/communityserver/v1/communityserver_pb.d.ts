@@ -2,8 +2,8 @@
 // @generated from file communityserver/v1/communityserver.proto (package communityserver.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
-import type { Message } from "@bufbuild/protobuf";
+import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import type { Message as Message$1 } from "@bufbuild/protobuf";
 
 /**
  * Describes the file communityserver/v1/communityserver.proto.
@@ -13,7 +13,7 @@ export declare const file_communityserver_v1_communityserver: GenFile;
 /**
  * @generated from message communityserver.v1.GetUserCommunitiesRequest
  */
-export declare type GetUserCommunitiesRequest = Message<"communityserver.v1.GetUserCommunitiesRequest"> & {
+export declare type GetUserCommunitiesRequest = Message$1<"communityserver.v1.GetUserCommunitiesRequest"> & {
 };
 
 /**
@@ -25,7 +25,7 @@ export declare const GetUserCommunitiesRequestSchema: GenMessage<GetUserCommunit
 /**
  * @generated from message communityserver.v1.GetUserCommunitiesResponse
  */
-export declare type GetUserCommunitiesResponse = Message<"communityserver.v1.GetUserCommunitiesResponse"> & {
+export declare type GetUserCommunitiesResponse = Message$1<"communityserver.v1.GetUserCommunitiesResponse"> & {
   /**
    * @generated from field: repeated communityserver.v1.GetUserCommunitiesResponse.Community communities = 1;
    */
@@ -41,7 +41,7 @@ export declare const GetUserCommunitiesResponseSchema: GenMessage<GetUserCommuni
 /**
  * @generated from message communityserver.v1.GetUserCommunitiesResponse.Community
  */
-export declare type GetUserCommunitiesResponse_Community = Message<"communityserver.v1.GetUserCommunitiesResponse.Community"> & {
+export declare type GetUserCommunitiesResponse_Community = Message$1<"communityserver.v1.GetUserCommunitiesResponse.Community"> & {
   /**
    * @generated from field: string id = 1;
    */
@@ -51,6 +51,26 @@ export declare type GetUserCommunitiesResponse_Community = Message<"communityser
    * @generated from field: string name = 2;
    */
   name: string;
+
+  /**
+   * @generated from field: string icon_url = 3;
+   */
+  iconUrl: string;
+
+  /**
+   * @generated from field: int64 online = 4;
+   */
+  online: bigint;
+
+  /**
+   * @generated from field: int64 unread_count = 5;
+   */
+  unreadCount: bigint;
+
+  /**
+   * @generated from field: int64 mention_count = 6;
+   */
+  mentionCount: bigint;
 };
 
 /**
@@ -62,11 +82,16 @@ export declare const GetUserCommunitiesResponse_CommunitySchema: GenMessage<GetU
 /**
  * @generated from message communityserver.v1.JoinServerRequest
  */
-export declare type JoinServerRequest = Message<"communityserver.v1.JoinServerRequest"> & {
+export declare type JoinServerRequest = Message$1<"communityserver.v1.JoinServerRequest"> & {
   /**
    * @generated from field: bool join_default_community = 1;
    */
   joinDefaultCommunity: boolean;
+
+  /**
+   * @generated from field: string invite_code = 2;
+   */
+  inviteCode: string;
 };
 
 /**
@@ -78,7 +103,16 @@ export declare const JoinServerRequestSchema: GenMessage<JoinServerRequest>;
 /**
  * @generated from message communityserver.v1.JoinServerResponse
  */
-export declare type JoinServerResponse = Message<"communityserver.v1.JoinServerResponse"> & {
+export declare type JoinServerResponse = Message$1<"communityserver.v1.JoinServerResponse"> & {
+  /**
+   * @generated from field: string community_id = 1;
+   */
+  communityId: string;
+
+  /**
+   * @generated from field: string channel_id = 2;
+   */
+  channelId: string;
 };
 
 /**
@@ -87,3 +121,3385 @@ export declare type JoinServerResponse = Message<"communityserver.v1.JoinServerR
  */
 export declare const JoinServerResponseSchema: GenMessage<JoinServerResponse>;
 
+/**
+ * @generated from message communityserver.v1.Channel
+ */
+export declare type Channel = Message$1<"communityserver.v1.Channel"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: int32 slow_mode_seconds = 3;
+   */
+  slowModeSeconds: number;
+};
+
+/**
+ * Describes the message communityserver.v1.Channel.
+ * Use `create(ChannelSchema)` to create a new message.
+ */
+export declare const ChannelSchema: GenMessage<Channel>;
+
+/**
+ * @generated from message communityserver.v1.GetChannelsRequest
+ */
+export declare type GetChannelsRequest = Message$1<"communityserver.v1.GetChannelsRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.GetChannelsRequest.
+ * Use `create(GetChannelsRequestSchema)` to create a new message.
+ */
+export declare const GetChannelsRequestSchema: GenMessage<GetChannelsRequest>;
+
+/**
+ * @generated from message communityserver.v1.GetChannelsResponse
+ */
+export declare type GetChannelsResponse = Message$1<"communityserver.v1.GetChannelsResponse"> & {
+  /**
+   * @generated from field: repeated communityserver.v1.Channel channels = 1;
+   */
+  channels: Channel[];
+};
+
+/**
+ * Describes the message communityserver.v1.GetChannelsResponse.
+ * Use `create(GetChannelsResponseSchema)` to create a new message.
+ */
+export declare const GetChannelsResponseSchema: GenMessage<GetChannelsResponse>;
+
+/**
+ * @generated from message communityserver.v1.CreateChannelRequest
+ */
+export declare type CreateChannelRequest = Message$1<"communityserver.v1.CreateChannelRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message communityserver.v1.CreateChannelRequest.
+ * Use `create(CreateChannelRequestSchema)` to create a new message.
+ */
+export declare const CreateChannelRequestSchema: GenMessage<CreateChannelRequest>;
+
+/**
+ * @generated from message communityserver.v1.CreateChannelResponse
+ */
+export declare type CreateChannelResponse = Message$1<"communityserver.v1.CreateChannelResponse"> & {
+  /**
+   * @generated from field: communityserver.v1.Channel channel = 1;
+   */
+  channel?: Channel;
+};
+
+/**
+ * Describes the message communityserver.v1.CreateChannelResponse.
+ * Use `create(CreateChannelResponseSchema)` to create a new message.
+ */
+export declare const CreateChannelResponseSchema: GenMessage<CreateChannelResponse>;
+
+/**
+ * @generated from message communityserver.v1.UpdateChannelRequest
+ */
+export declare type UpdateChannelRequest = Message$1<"communityserver.v1.UpdateChannelRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message communityserver.v1.UpdateChannelRequest.
+ * Use `create(UpdateChannelRequestSchema)` to create a new message.
+ */
+export declare const UpdateChannelRequestSchema: GenMessage<UpdateChannelRequest>;
+
+/**
+ * @generated from message communityserver.v1.UpdateChannelResponse
+ */
+export declare type UpdateChannelResponse = Message$1<"communityserver.v1.UpdateChannelResponse"> & {
+  /**
+   * @generated from field: communityserver.v1.Channel channel = 1;
+   */
+  channel?: Channel;
+};
+
+/**
+ * Describes the message communityserver.v1.UpdateChannelResponse.
+ * Use `create(UpdateChannelResponseSchema)` to create a new message.
+ */
+export declare const UpdateChannelResponseSchema: GenMessage<UpdateChannelResponse>;
+
+/**
+ * @generated from message communityserver.v1.DeleteChannelRequest
+ */
+export declare type DeleteChannelRequest = Message$1<"communityserver.v1.DeleteChannelRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.DeleteChannelRequest.
+ * Use `create(DeleteChannelRequestSchema)` to create a new message.
+ */
+export declare const DeleteChannelRequestSchema: GenMessage<DeleteChannelRequest>;
+
+/**
+ * @generated from message communityserver.v1.DeleteChannelResponse
+ */
+export declare type DeleteChannelResponse = Message$1<"communityserver.v1.DeleteChannelResponse"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.DeleteChannelResponse.
+ * Use `create(DeleteChannelResponseSchema)` to create a new message.
+ */
+export declare const DeleteChannelResponseSchema: GenMessage<DeleteChannelResponse>;
+
+/**
+ * @generated from message communityserver.v1.Message
+ */
+export declare type Message = Message$1<"communityserver.v1.Message"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string channel_id = 2;
+   */
+  channelId: string;
+
+  /**
+   * @generated from field: string user_address = 3;
+   */
+  userAddress: string;
+
+  /**
+   * @generated from field: string body = 4;
+   */
+  body: string;
+
+  /**
+   * @generated from field: string created_at = 5;
+   */
+  createdAt: string;
+
+  /**
+   * @generated from field: string updated_at = 6;
+   */
+  updatedAt: string;
+
+  /**
+   * @generated from field: bool deleted = 7;
+   */
+  deleted: boolean;
+
+  /**
+   * @generated from field: string reply_to_message_id = 8;
+   */
+  replyToMessageId: string;
+
+  /**
+   * @generated from field: communityserver.v1.MessageReference reply_to = 9;
+   */
+  replyTo?: MessageReference;
+
+  /**
+   * @generated from field: string thread_id = 10;
+   */
+  threadId: string;
+
+  /**
+   * @generated from field: int32 thread_reply_count = 11;
+   */
+  threadReplyCount: number;
+
+  /**
+   * @generated from field: string thread_last_activity_at = 12;
+   */
+  threadLastActivityAt: string;
+
+  /**
+   * @generated from field: repeated communityserver.v1.Reaction reactions = 13;
+   */
+  reactions: Reaction[];
+
+  /**
+   * @generated from field: repeated communityserver.v1.Attachment attachments = 14;
+   */
+  attachments: Attachment[];
+
+  /**
+   * @generated from field: repeated communityserver.v1.LinkPreview link_previews = 15;
+   */
+  linkPreviews: LinkPreview[];
+};
+
+/**
+ * Describes the message communityserver.v1.Message.
+ * Use `create(MessageSchema)` to create a new message.
+ */
+export declare const MessageSchema: GenMessage<Message>;
+
+/**
+ * @generated from message communityserver.v1.Attachment
+ */
+export declare type Attachment = Message$1<"communityserver.v1.Attachment"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string filename = 2;
+   */
+  filename: string;
+
+  /**
+   * @generated from field: string content_type = 3;
+   */
+  contentType: string;
+
+  /**
+   * @generated from field: int64 size = 4;
+   */
+  size: bigint;
+
+  /**
+   * @generated from field: int32 width = 5;
+   */
+  width: number;
+
+  /**
+   * @generated from field: int32 height = 6;
+   */
+  height: number;
+
+  /**
+   * @generated from field: string url = 7;
+   */
+  url: string;
+};
+
+/**
+ * Describes the message communityserver.v1.Attachment.
+ * Use `create(AttachmentSchema)` to create a new message.
+ */
+export declare const AttachmentSchema: GenMessage<Attachment>;
+
+/**
+ * @generated from message communityserver.v1.LinkPreview
+ */
+export declare type LinkPreview = Message$1<"communityserver.v1.LinkPreview"> & {
+  /**
+   * @generated from field: string url = 1;
+   */
+  url: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: string site_name = 4;
+   */
+  siteName: string;
+
+  /**
+   * @generated from field: string image_url = 5;
+   */
+  imageUrl: string;
+};
+
+/**
+ * Describes the message communityserver.v1.LinkPreview.
+ * Use `create(LinkPreviewSchema)` to create a new message.
+ */
+export declare const LinkPreviewSchema: GenMessage<LinkPreview>;
+
+/**
+ * @generated from message communityserver.v1.Reaction
+ */
+export declare type Reaction = Message$1<"communityserver.v1.Reaction"> & {
+  /**
+   * @generated from field: string emoji = 1;
+   */
+  emoji: string;
+
+  /**
+   * @generated from field: string custom_emoji_id = 2;
+   */
+  customEmojiId: string;
+
+  /**
+   * @generated from field: int64 count = 3;
+   */
+  count: bigint;
+
+  /**
+   * @generated from field: bool me = 4;
+   */
+  me: boolean;
+};
+
+/**
+ * Describes the message communityserver.v1.Reaction.
+ * Use `create(ReactionSchema)` to create a new message.
+ */
+export declare const ReactionSchema: GenMessage<Reaction>;
+
+/**
+ * @generated from message communityserver.v1.MessageReference
+ */
+export declare type MessageReference = Message$1<"communityserver.v1.MessageReference"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_address = 2;
+   */
+  userAddress: string;
+
+  /**
+   * @generated from field: string body = 3;
+   */
+  body: string;
+
+  /**
+   * @generated from field: bool deleted = 4;
+   */
+  deleted: boolean;
+};
+
+/**
+ * Describes the message communityserver.v1.MessageReference.
+ * Use `create(MessageReferenceSchema)` to create a new message.
+ */
+export declare const MessageReferenceSchema: GenMessage<MessageReference>;
+
+/**
+ * @generated from message communityserver.v1.GetMessagesRequest
+ */
+export declare type GetMessagesRequest = Message$1<"communityserver.v1.GetMessagesRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.GetMessagesRequest.
+ * Use `create(GetMessagesRequestSchema)` to create a new message.
+ */
+export declare const GetMessagesRequestSchema: GenMessage<GetMessagesRequest>;
+
+/**
+ * @generated from message communityserver.v1.GetMessagesResponse
+ */
+export declare type GetMessagesResponse = Message$1<"communityserver.v1.GetMessagesResponse"> & {
+  /**
+   * @generated from field: repeated communityserver.v1.Message messages = 1;
+   */
+  messages: Message[];
+
+  /**
+   * @generated from field: bool has_more = 2;
+   */
+  hasMore: boolean;
+};
+
+/**
+ * Describes the message communityserver.v1.GetMessagesResponse.
+ * Use `create(GetMessagesResponseSchema)` to create a new message.
+ */
+export declare const GetMessagesResponseSchema: GenMessage<GetMessagesResponse>;
+
+/**
+ * @generated from message communityserver.v1.SendMessageRequest
+ */
+export declare type SendMessageRequest = Message$1<"communityserver.v1.SendMessageRequest"> & {
+  /**
+   * @generated from field: string body = 1;
+   */
+  body: string;
+
+  /**
+   * @generated from field: string reply_to_message_id = 2;
+   */
+  replyToMessageId: string;
+
+  /**
+   * @generated from field: repeated string attachment_ids = 3;
+   */
+  attachmentIds: string[];
+};
+
+/**
+ * Describes the message communityserver.v1.SendMessageRequest.
+ * Use `create(SendMessageRequestSchema)` to create a new message.
+ */
+export declare const SendMessageRequestSchema: GenMessage<SendMessageRequest>;
+
+/**
+ * @generated from message communityserver.v1.SendMessageResponse
+ */
+export declare type SendMessageResponse = Message$1<"communityserver.v1.SendMessageResponse"> & {
+  /**
+   * @generated from field: communityserver.v1.Message message = 1;
+   */
+  message?: Message;
+};
+
+/**
+ * Describes the message communityserver.v1.SendMessageResponse.
+ * Use `create(SendMessageResponseSchema)` to create a new message.
+ */
+export declare const SendMessageResponseSchema: GenMessage<SendMessageResponse>;
+
+/**
+ * @generated from message communityserver.v1.Event
+ */
+export declare type Event = Message$1<"communityserver.v1.Event"> & {
+  /**
+   * @generated from field: communityserver.v1.Event.Type type = 1;
+   */
+  type: Event_Type;
+
+  /**
+   * @generated from field: string community_id = 2;
+   */
+  communityId: string;
+
+  /**
+   * @generated from field: bytes payload = 3;
+   */
+  payload: Uint8Array;
+
+  /**
+   * @generated from field: string channel_id = 4;
+   */
+  channelId: string;
+};
+
+/**
+ * Describes the message communityserver.v1.Event.
+ * Use `create(EventSchema)` to create a new message.
+ */
+export declare const EventSchema: GenMessage<Event>;
+
+/**
+ * @generated from enum communityserver.v1.Event.Type
+ */
+export enum Event_Type {
+  /**
+   * @generated from enum value: TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TYPE_MESSAGE_CREATED = 1;
+   */
+  MESSAGE_CREATED = 1,
+
+  /**
+   * @generated from enum value: TYPE_MEMBER_JOINED = 2;
+   */
+  MEMBER_JOINED = 2,
+
+  /**
+   * @generated from enum value: TYPE_CHANNEL_CREATED = 3;
+   */
+  CHANNEL_CREATED = 3,
+
+  /**
+   * @generated from enum value: TYPE_CHANNEL_UPDATED = 4;
+   */
+  CHANNEL_UPDATED = 4,
+
+  /**
+   * @generated from enum value: TYPE_CHANNEL_DELETED = 5;
+   */
+  CHANNEL_DELETED = 5,
+
+  /**
+   * @generated from enum value: TYPE_MEMBER_REMOVED = 6;
+   */
+  MEMBER_REMOVED = 6,
+
+  /**
+   * @generated from enum value: TYPE_MEMBER_MUTED = 7;
+   */
+  MEMBER_MUTED = 7,
+
+  /**
+   * @generated from enum value: TYPE_COMMUNITY_UPDATED = 8;
+   */
+  COMMUNITY_UPDATED = 8,
+
+  /**
+   * @generated from enum value: TYPE_COMMUNITY_DELETED = 9;
+   */
+  COMMUNITY_DELETED = 9,
+
+  /**
+   * @generated from enum value: TYPE_PRESENCE_UPDATED = 10;
+   */
+  PRESENCE_UPDATED = 10,
+
+  /**
+   * @generated from enum value: TYPE_TYPING_STARTED = 11;
+   */
+  TYPING_STARTED = 11,
+
+  /**
+   * @generated from enum value: TYPE_MESSAGE_UPDATED = 12;
+   */
+  MESSAGE_UPDATED = 12,
+
+  /**
+   * @generated from enum value: TYPE_MESSAGE_DELETED = 13;
+   */
+  MESSAGE_DELETED = 13,
+
+  /**
+   * @generated from enum value: TYPE_REACTION_ADDED = 14;
+   */
+  REACTION_ADDED = 14,
+
+  /**
+   * @generated from enum value: TYPE_REACTION_REMOVED = 15;
+   */
+  REACTION_REMOVED = 15,
+
+  /**
+   * @generated from enum value: TYPE_CUSTOM_EMOJI_CREATED = 16;
+   */
+  CUSTOM_EMOJI_CREATED = 16,
+
+  /**
+   * @generated from enum value: TYPE_CUSTOM_EMOJI_DELETED = 17;
+   */
+  CUSTOM_EMOJI_DELETED = 17,
+
+  /**
+   * @generated from enum value: TYPE_MESSAGE_PINNED = 18;
+   */
+  MESSAGE_PINNED = 18,
+
+  /**
+   * @generated from enum value: TYPE_MESSAGE_UNPINNED = 19;
+   */
+  MESSAGE_UNPINNED = 19,
+
+  /**
+   * @generated from enum value: TYPE_ROLE_CREATED = 20;
+   */
+  ROLE_CREATED = 20,
+
+  /**
+   * @generated from enum value: TYPE_ROLE_UPDATED = 21;
+   */
+  ROLE_UPDATED = 21,
+
+  /**
+   * @generated from enum value: TYPE_ROLE_DELETED = 22;
+   */
+  ROLE_DELETED = 22,
+
+  /**
+   * @generated from enum value: TYPE_MEMBER_ROLE_ADDED = 23;
+   */
+  MEMBER_ROLE_ADDED = 23,
+
+  /**
+   * @generated from enum value: TYPE_MEMBER_ROLE_REMOVED = 24;
+   */
+  MEMBER_ROLE_REMOVED = 24,
+}
+
+/**
+ * Describes the enum communityserver.v1.Event.Type.
+ */
+export declare const Event_TypeSchema: GenEnum<Event_Type>;
+
+/**
+ * @generated from message communityserver.v1.MessageCreatedEvent
+ */
+export declare type MessageCreatedEvent = Message$1<"communityserver.v1.MessageCreatedEvent"> & {
+  /**
+   * @generated from field: communityserver.v1.Message message = 1;
+   */
+  message?: Message;
+};
+
+/**
+ * Describes the message communityserver.v1.MessageCreatedEvent.
+ * Use `create(MessageCreatedEventSchema)` to create a new message.
+ */
+export declare const MessageCreatedEventSchema: GenMessage<MessageCreatedEvent>;
+
+/**
+ * @generated from message communityserver.v1.MessageUpdatedEvent
+ */
+export declare type MessageUpdatedEvent = Message$1<"communityserver.v1.MessageUpdatedEvent"> & {
+  /**
+   * @generated from field: communityserver.v1.Message message = 1;
+   */
+  message?: Message;
+};
+
+/**
+ * Describes the message communityserver.v1.MessageUpdatedEvent.
+ * Use `create(MessageUpdatedEventSchema)` to create a new message.
+ */
+export declare const MessageUpdatedEventSchema: GenMessage<MessageUpdatedEvent>;
+
+/**
+ * @generated from message communityserver.v1.MessageDeletedEvent
+ */
+export declare type MessageDeletedEvent = Message$1<"communityserver.v1.MessageDeletedEvent"> & {
+  /**
+   * @generated from field: string message_id = 1;
+   */
+  messageId: string;
+
+  /**
+   * @generated from field: string channel_id = 2;
+   */
+  channelId: string;
+};
+
+/**
+ * Describes the message communityserver.v1.MessageDeletedEvent.
+ * Use `create(MessageDeletedEventSchema)` to create a new message.
+ */
+export declare const MessageDeletedEventSchema: GenMessage<MessageDeletedEvent>;
+
+/**
+ * @generated from message communityserver.v1.MemberJoinedEvent
+ */
+export declare type MemberJoinedEvent = Message$1<"communityserver.v1.MemberJoinedEvent"> & {
+  /**
+   * @generated from field: string user_address = 1;
+   */
+  userAddress: string;
+};
+
+/**
+ * Describes the message communityserver.v1.MemberJoinedEvent.
+ * Use `create(MemberJoinedEventSchema)` to create a new message.
+ */
+export declare const MemberJoinedEventSchema: GenMessage<MemberJoinedEvent>;
+
+/**
+ * @generated from message communityserver.v1.ChannelCreatedEvent
+ */
+export declare type ChannelCreatedEvent = Message$1<"communityserver.v1.ChannelCreatedEvent"> & {
+  /**
+   * @generated from field: communityserver.v1.Channel channel = 1;
+   */
+  channel?: Channel;
+};
+
+/**
+ * Describes the message communityserver.v1.ChannelCreatedEvent.
+ * Use `create(ChannelCreatedEventSchema)` to create a new message.
+ */
+export declare const ChannelCreatedEventSchema: GenMessage<ChannelCreatedEvent>;
+
+/**
+ * @generated from message communityserver.v1.ChannelUpdatedEvent
+ */
+export declare type ChannelUpdatedEvent = Message$1<"communityserver.v1.ChannelUpdatedEvent"> & {
+  /**
+   * @generated from field: communityserver.v1.Channel channel = 1;
+   */
+  channel?: Channel;
+};
+
+/**
+ * Describes the message communityserver.v1.ChannelUpdatedEvent.
+ * Use `create(ChannelUpdatedEventSchema)` to create a new message.
+ */
+export declare const ChannelUpdatedEventSchema: GenMessage<ChannelUpdatedEvent>;
+
+/**
+ * @generated from message communityserver.v1.ChannelDeletedEvent
+ */
+export declare type ChannelDeletedEvent = Message$1<"communityserver.v1.ChannelDeletedEvent"> & {
+  /**
+   * @generated from field: string channel_id = 1;
+   */
+  channelId: string;
+};
+
+/**
+ * Describes the message communityserver.v1.ChannelDeletedEvent.
+ * Use `create(ChannelDeletedEventSchema)` to create a new message.
+ */
+export declare const ChannelDeletedEventSchema: GenMessage<ChannelDeletedEvent>;
+
+/**
+ * @generated from message communityserver.v1.Role
+ */
+export declare type Role = Message$1<"communityserver.v1.Role"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: int64 permissions = 3;
+   */
+  permissions: bigint;
+};
+
+/**
+ * Describes the message communityserver.v1.Role.
+ * Use `create(RoleSchema)` to create a new message.
+ */
+export declare const RoleSchema: GenMessage<Role>;
+
+/**
+ * @generated from message communityserver.v1.RoleCreatedEvent
+ */
+export declare type RoleCreatedEvent = Message$1<"communityserver.v1.RoleCreatedEvent"> & {
+  /**
+   * @generated from field: communityserver.v1.Role role = 1;
+   */
+  role?: Role;
+};
+
+/**
+ * Describes the message communityserver.v1.RoleCreatedEvent.
+ * Use `create(RoleCreatedEventSchema)` to create a new message.
+ */
+export declare const RoleCreatedEventSchema: GenMessage<RoleCreatedEvent>;
+
+/**
+ * @generated from message communityserver.v1.RoleUpdatedEvent
+ */
+export declare type RoleUpdatedEvent = Message$1<"communityserver.v1.RoleUpdatedEvent"> & {
+  /**
+   * @generated from field: communityserver.v1.Role role = 1;
+   */
+  role?: Role;
+};
+
+/**
+ * Describes the message communityserver.v1.RoleUpdatedEvent.
+ * Use `create(RoleUpdatedEventSchema)` to create a new message.
+ */
+export declare const RoleUpdatedEventSchema: GenMessage<RoleUpdatedEvent>;
+
+/**
+ * @generated from message communityserver.v1.RoleDeletedEvent
+ */
+export declare type RoleDeletedEvent = Message$1<"communityserver.v1.RoleDeletedEvent"> & {
+  /**
+   * @generated from field: string role_id = 1;
+   */
+  roleId: string;
+};
+
+/**
+ * Describes the message communityserver.v1.RoleDeletedEvent.
+ * Use `create(RoleDeletedEventSchema)` to create a new message.
+ */
+export declare const RoleDeletedEventSchema: GenMessage<RoleDeletedEvent>;
+
+/**
+ * @generated from message communityserver.v1.MemberRoleAddedEvent
+ */
+export declare type MemberRoleAddedEvent = Message$1<"communityserver.v1.MemberRoleAddedEvent"> & {
+  /**
+   * @generated from field: string user_address = 1;
+   */
+  userAddress: string;
+
+  /**
+   * @generated from field: string role_id = 2;
+   */
+  roleId: string;
+};
+
+/**
+ * Describes the message communityserver.v1.MemberRoleAddedEvent.
+ * Use `create(MemberRoleAddedEventSchema)` to create a new message.
+ */
+export declare const MemberRoleAddedEventSchema: GenMessage<MemberRoleAddedEvent>;
+
+/**
+ * @generated from message communityserver.v1.MemberRoleRemovedEvent
+ */
+export declare type MemberRoleRemovedEvent = Message$1<"communityserver.v1.MemberRoleRemovedEvent"> & {
+  /**
+   * @generated from field: string user_address = 1;
+   */
+  userAddress: string;
+
+  /**
+   * @generated from field: string role_id = 2;
+   */
+  roleId: string;
+};
+
+/**
+ * Describes the message communityserver.v1.MemberRoleRemovedEvent.
+ * Use `create(MemberRoleRemovedEventSchema)` to create a new message.
+ */
+export declare const MemberRoleRemovedEventSchema: GenMessage<MemberRoleRemovedEvent>;
+
+/**
+ * @generated from message communityserver.v1.GetRolesRequest
+ */
+export declare type GetRolesRequest = Message$1<"communityserver.v1.GetRolesRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.GetRolesRequest.
+ * Use `create(GetRolesRequestSchema)` to create a new message.
+ */
+export declare const GetRolesRequestSchema: GenMessage<GetRolesRequest>;
+
+/**
+ * @generated from message communityserver.v1.GetRolesResponse
+ */
+export declare type GetRolesResponse = Message$1<"communityserver.v1.GetRolesResponse"> & {
+  /**
+   * @generated from field: repeated communityserver.v1.Role roles = 1;
+   */
+  roles: Role[];
+};
+
+/**
+ * Describes the message communityserver.v1.GetRolesResponse.
+ * Use `create(GetRolesResponseSchema)` to create a new message.
+ */
+export declare const GetRolesResponseSchema: GenMessage<GetRolesResponse>;
+
+/**
+ * @generated from message communityserver.v1.CreateRoleRequest
+ */
+export declare type CreateRoleRequest = Message$1<"communityserver.v1.CreateRoleRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: int64 permissions = 2;
+   */
+  permissions: bigint;
+};
+
+/**
+ * Describes the message communityserver.v1.CreateRoleRequest.
+ * Use `create(CreateRoleRequestSchema)` to create a new message.
+ */
+export declare const CreateRoleRequestSchema: GenMessage<CreateRoleRequest>;
+
+/**
+ * @generated from message communityserver.v1.CreateRoleResponse
+ */
+export declare type CreateRoleResponse = Message$1<"communityserver.v1.CreateRoleResponse"> & {
+  /**
+   * @generated from field: communityserver.v1.Role role = 1;
+   */
+  role?: Role;
+};
+
+/**
+ * Describes the message communityserver.v1.CreateRoleResponse.
+ * Use `create(CreateRoleResponseSchema)` to create a new message.
+ */
+export declare const CreateRoleResponseSchema: GenMessage<CreateRoleResponse>;
+
+/**
+ * @generated from message communityserver.v1.UpdateRoleRequest
+ */
+export declare type UpdateRoleRequest = Message$1<"communityserver.v1.UpdateRoleRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: int64 permissions = 2;
+   */
+  permissions: bigint;
+};
+
+/**
+ * Describes the message communityserver.v1.UpdateRoleRequest.
+ * Use `create(UpdateRoleRequestSchema)` to create a new message.
+ */
+export declare const UpdateRoleRequestSchema: GenMessage<UpdateRoleRequest>;
+
+/**
+ * @generated from message communityserver.v1.UpdateRoleResponse
+ */
+export declare type UpdateRoleResponse = Message$1<"communityserver.v1.UpdateRoleResponse"> & {
+  /**
+   * @generated from field: communityserver.v1.Role role = 1;
+   */
+  role?: Role;
+};
+
+/**
+ * Describes the message communityserver.v1.UpdateRoleResponse.
+ * Use `create(UpdateRoleResponseSchema)` to create a new message.
+ */
+export declare const UpdateRoleResponseSchema: GenMessage<UpdateRoleResponse>;
+
+/**
+ * @generated from message communityserver.v1.DeleteRoleRequest
+ */
+export declare type DeleteRoleRequest = Message$1<"communityserver.v1.DeleteRoleRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.DeleteRoleRequest.
+ * Use `create(DeleteRoleRequestSchema)` to create a new message.
+ */
+export declare const DeleteRoleRequestSchema: GenMessage<DeleteRoleRequest>;
+
+/**
+ * @generated from message communityserver.v1.DeleteRoleResponse
+ */
+export declare type DeleteRoleResponse = Message$1<"communityserver.v1.DeleteRoleResponse"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.DeleteRoleResponse.
+ * Use `create(DeleteRoleResponseSchema)` to create a new message.
+ */
+export declare const DeleteRoleResponseSchema: GenMessage<DeleteRoleResponse>;
+
+/**
+ * @generated from message communityserver.v1.AssignRoleRequest
+ */
+export declare type AssignRoleRequest = Message$1<"communityserver.v1.AssignRoleRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.AssignRoleRequest.
+ * Use `create(AssignRoleRequestSchema)` to create a new message.
+ */
+export declare const AssignRoleRequestSchema: GenMessage<AssignRoleRequest>;
+
+/**
+ * @generated from message communityserver.v1.AssignRoleResponse
+ */
+export declare type AssignRoleResponse = Message$1<"communityserver.v1.AssignRoleResponse"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.AssignRoleResponse.
+ * Use `create(AssignRoleResponseSchema)` to create a new message.
+ */
+export declare const AssignRoleResponseSchema: GenMessage<AssignRoleResponse>;
+
+/**
+ * @generated from message communityserver.v1.UnassignRoleRequest
+ */
+export declare type UnassignRoleRequest = Message$1<"communityserver.v1.UnassignRoleRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.UnassignRoleRequest.
+ * Use `create(UnassignRoleRequestSchema)` to create a new message.
+ */
+export declare const UnassignRoleRequestSchema: GenMessage<UnassignRoleRequest>;
+
+/**
+ * @generated from message communityserver.v1.UnassignRoleResponse
+ */
+export declare type UnassignRoleResponse = Message$1<"communityserver.v1.UnassignRoleResponse"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.UnassignRoleResponse.
+ * Use `create(UnassignRoleResponseSchema)` to create a new message.
+ */
+export declare const UnassignRoleResponseSchema: GenMessage<UnassignRoleResponse>;
+
+/**
+ * @generated from message communityserver.v1.PermissionOverwrite
+ */
+export declare type PermissionOverwrite = Message$1<"communityserver.v1.PermissionOverwrite"> & {
+  /**
+   * @generated from field: communityserver.v1.PermissionOverwrite.TargetType target_type = 1;
+   */
+  targetType: PermissionOverwrite_TargetType;
+
+  /**
+   * @generated from field: string target_id = 2;
+   */
+  targetId: string;
+
+  /**
+   * @generated from field: int64 allow = 3;
+   */
+  allow: bigint;
+
+  /**
+   * @generated from field: int64 deny = 4;
+   */
+  deny: bigint;
+};
+
+/**
+ * Describes the message communityserver.v1.PermissionOverwrite.
+ * Use `create(PermissionOverwriteSchema)` to create a new message.
+ */
+export declare const PermissionOverwriteSchema: GenMessage<PermissionOverwrite>;
+
+/**
+ * @generated from enum communityserver.v1.PermissionOverwrite.TargetType
+ */
+export enum PermissionOverwrite_TargetType {
+  /**
+   * @generated from enum value: TARGET_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TARGET_TYPE_EVERYONE = 1;
+   */
+  EVERYONE = 1,
+
+  /**
+   * @generated from enum value: TARGET_TYPE_ROLE = 2;
+   */
+  ROLE = 2,
+
+  /**
+   * @generated from enum value: TARGET_TYPE_MEMBER = 3;
+   */
+  MEMBER = 3,
+}
+
+/**
+ * Describes the enum communityserver.v1.PermissionOverwrite.TargetType.
+ */
+export declare const PermissionOverwrite_TargetTypeSchema: GenEnum<PermissionOverwrite_TargetType>;
+
+/**
+ * @generated from message communityserver.v1.GetChannelOverwritesRequest
+ */
+export declare type GetChannelOverwritesRequest = Message$1<"communityserver.v1.GetChannelOverwritesRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.GetChannelOverwritesRequest.
+ * Use `create(GetChannelOverwritesRequestSchema)` to create a new message.
+ */
+export declare const GetChannelOverwritesRequestSchema: GenMessage<GetChannelOverwritesRequest>;
+
+/**
+ * @generated from message communityserver.v1.GetChannelOverwritesResponse
+ */
+export declare type GetChannelOverwritesResponse = Message$1<"communityserver.v1.GetChannelOverwritesResponse"> & {
+  /**
+   * @generated from field: repeated communityserver.v1.PermissionOverwrite overwrites = 1;
+   */
+  overwrites: PermissionOverwrite[];
+};
+
+/**
+ * Describes the message communityserver.v1.GetChannelOverwritesResponse.
+ * Use `create(GetChannelOverwritesResponseSchema)` to create a new message.
+ */
+export declare const GetChannelOverwritesResponseSchema: GenMessage<GetChannelOverwritesResponse>;
+
+/**
+ * @generated from message communityserver.v1.SetChannelOverwriteRequest
+ */
+export declare type SetChannelOverwriteRequest = Message$1<"communityserver.v1.SetChannelOverwriteRequest"> & {
+  /**
+   * @generated from field: communityserver.v1.PermissionOverwrite overwrite = 1;
+   */
+  overwrite?: PermissionOverwrite;
+};
+
+/**
+ * Describes the message communityserver.v1.SetChannelOverwriteRequest.
+ * Use `create(SetChannelOverwriteRequestSchema)` to create a new message.
+ */
+export declare const SetChannelOverwriteRequestSchema: GenMessage<SetChannelOverwriteRequest>;
+
+/**
+ * @generated from message communityserver.v1.SetChannelOverwriteResponse
+ */
+export declare type SetChannelOverwriteResponse = Message$1<"communityserver.v1.SetChannelOverwriteResponse"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.SetChannelOverwriteResponse.
+ * Use `create(SetChannelOverwriteResponseSchema)` to create a new message.
+ */
+export declare const SetChannelOverwriteResponseSchema: GenMessage<SetChannelOverwriteResponse>;
+
+/**
+ * @generated from message communityserver.v1.Invite
+ */
+export declare type Invite = Message$1<"communityserver.v1.Invite"> & {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code: string;
+
+  /**
+   * @generated from field: string community_id = 2;
+   */
+  communityId: string;
+
+  /**
+   * @generated from field: string channel_id = 3;
+   */
+  channelId: string;
+
+  /**
+   * @generated from field: string creator_user_address = 4;
+   */
+  creatorUserAddress: string;
+
+  /**
+   * @generated from field: int32 max_uses = 5;
+   */
+  maxUses: number;
+
+  /**
+   * @generated from field: int32 uses = 6;
+   */
+  uses: number;
+
+  /**
+   * @generated from field: string expires_at = 7;
+   */
+  expiresAt: string;
+
+  /**
+   * @generated from field: string created_at = 8;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message communityserver.v1.Invite.
+ * Use `create(InviteSchema)` to create a new message.
+ */
+export declare const InviteSchema: GenMessage<Invite>;
+
+/**
+ * @generated from message communityserver.v1.GetInvitesRequest
+ */
+export declare type GetInvitesRequest = Message$1<"communityserver.v1.GetInvitesRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.GetInvitesRequest.
+ * Use `create(GetInvitesRequestSchema)` to create a new message.
+ */
+export declare const GetInvitesRequestSchema: GenMessage<GetInvitesRequest>;
+
+/**
+ * @generated from message communityserver.v1.GetInvitesResponse
+ */
+export declare type GetInvitesResponse = Message$1<"communityserver.v1.GetInvitesResponse"> & {
+  /**
+   * @generated from field: repeated communityserver.v1.Invite invites = 1;
+   */
+  invites: Invite[];
+};
+
+/**
+ * Describes the message communityserver.v1.GetInvitesResponse.
+ * Use `create(GetInvitesResponseSchema)` to create a new message.
+ */
+export declare const GetInvitesResponseSchema: GenMessage<GetInvitesResponse>;
+
+/**
+ * @generated from message communityserver.v1.CreateInviteRequest
+ */
+export declare type CreateInviteRequest = Message$1<"communityserver.v1.CreateInviteRequest"> & {
+  /**
+   * @generated from field: string channel_id = 1;
+   */
+  channelId: string;
+
+  /**
+   * @generated from field: int32 max_uses = 2;
+   */
+  maxUses: number;
+
+  /**
+   * @generated from field: int64 max_age_seconds = 3;
+   */
+  maxAgeSeconds: bigint;
+};
+
+/**
+ * Describes the message communityserver.v1.CreateInviteRequest.
+ * Use `create(CreateInviteRequestSchema)` to create a new message.
+ */
+export declare const CreateInviteRequestSchema: GenMessage<CreateInviteRequest>;
+
+/**
+ * @generated from message communityserver.v1.CreateInviteResponse
+ */
+export declare type CreateInviteResponse = Message$1<"communityserver.v1.CreateInviteResponse"> & {
+  /**
+   * @generated from field: communityserver.v1.Invite invite = 1;
+   */
+  invite?: Invite;
+};
+
+/**
+ * Describes the message communityserver.v1.CreateInviteResponse.
+ * Use `create(CreateInviteResponseSchema)` to create a new message.
+ */
+export declare const CreateInviteResponseSchema: GenMessage<CreateInviteResponse>;
+
+/**
+ * @generated from message communityserver.v1.RevokeInviteRequest
+ */
+export declare type RevokeInviteRequest = Message$1<"communityserver.v1.RevokeInviteRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.RevokeInviteRequest.
+ * Use `create(RevokeInviteRequestSchema)` to create a new message.
+ */
+export declare const RevokeInviteRequestSchema: GenMessage<RevokeInviteRequest>;
+
+/**
+ * @generated from message communityserver.v1.RevokeInviteResponse
+ */
+export declare type RevokeInviteResponse = Message$1<"communityserver.v1.RevokeInviteResponse"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.RevokeInviteResponse.
+ * Use `create(RevokeInviteResponseSchema)` to create a new message.
+ */
+export declare const RevokeInviteResponseSchema: GenMessage<RevokeInviteResponse>;
+
+/**
+ * @generated from message communityserver.v1.ResolveInviteRequest
+ */
+export declare type ResolveInviteRequest = Message$1<"communityserver.v1.ResolveInviteRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.ResolveInviteRequest.
+ * Use `create(ResolveInviteRequestSchema)` to create a new message.
+ */
+export declare const ResolveInviteRequestSchema: GenMessage<ResolveInviteRequest>;
+
+/**
+ * @generated from message communityserver.v1.ResolveInviteResponse
+ */
+export declare type ResolveInviteResponse = Message$1<"communityserver.v1.ResolveInviteResponse"> & {
+  /**
+   * @generated from field: communityserver.v1.Invite invite = 1;
+   */
+  invite?: Invite;
+
+  /**
+   * @generated from field: communityserver.v1.ResolveInviteResponse.Community community = 2;
+   */
+  community?: ResolveInviteResponse_Community;
+
+  /**
+   * @generated from field: communityserver.v1.Channel channel = 3;
+   */
+  channel?: Channel;
+};
+
+/**
+ * Describes the message communityserver.v1.ResolveInviteResponse.
+ * Use `create(ResolveInviteResponseSchema)` to create a new message.
+ */
+export declare const ResolveInviteResponseSchema: GenMessage<ResolveInviteResponse>;
+
+/**
+ * @generated from message communityserver.v1.ResolveInviteResponse.Community
+ */
+export declare type ResolveInviteResponse_Community = Message$1<"communityserver.v1.ResolveInviteResponse.Community"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: int64 member_count = 3;
+   */
+  memberCount: bigint;
+};
+
+/**
+ * Describes the message communityserver.v1.ResolveInviteResponse.Community.
+ * Use `create(ResolveInviteResponse_CommunitySchema)` to create a new message.
+ */
+export declare const ResolveInviteResponse_CommunitySchema: GenMessage<ResolveInviteResponse_Community>;
+
+/**
+ * @generated from message communityserver.v1.MemberRemovedEvent
+ */
+export declare type MemberRemovedEvent = Message$1<"communityserver.v1.MemberRemovedEvent"> & {
+  /**
+   * @generated from field: string user_address = 1;
+   */
+  userAddress: string;
+};
+
+/**
+ * Describes the message communityserver.v1.MemberRemovedEvent.
+ * Use `create(MemberRemovedEventSchema)` to create a new message.
+ */
+export declare const MemberRemovedEventSchema: GenMessage<MemberRemovedEvent>;
+
+/**
+ * @generated from message communityserver.v1.MemberMutedEvent
+ */
+export declare type MemberMutedEvent = Message$1<"communityserver.v1.MemberMutedEvent"> & {
+  /**
+   * @generated from field: string user_address = 1;
+   */
+  userAddress: string;
+
+  /**
+   * @generated from field: string muted_until = 2;
+   */
+  mutedUntil: string;
+};
+
+/**
+ * Describes the message communityserver.v1.MemberMutedEvent.
+ * Use `create(MemberMutedEventSchema)` to create a new message.
+ */
+export declare const MemberMutedEventSchema: GenMessage<MemberMutedEvent>;
+
+/**
+ * @generated from message communityserver.v1.KickMemberRequest
+ */
+export declare type KickMemberRequest = Message$1<"communityserver.v1.KickMemberRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.KickMemberRequest.
+ * Use `create(KickMemberRequestSchema)` to create a new message.
+ */
+export declare const KickMemberRequestSchema: GenMessage<KickMemberRequest>;
+
+/**
+ * @generated from message communityserver.v1.KickMemberResponse
+ */
+export declare type KickMemberResponse = Message$1<"communityserver.v1.KickMemberResponse"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.KickMemberResponse.
+ * Use `create(KickMemberResponseSchema)` to create a new message.
+ */
+export declare const KickMemberResponseSchema: GenMessage<KickMemberResponse>;
+
+/**
+ * @generated from message communityserver.v1.MuteMemberRequest
+ */
+export declare type MuteMemberRequest = Message$1<"communityserver.v1.MuteMemberRequest"> & {
+  /**
+   * @generated from field: int64 duration_seconds = 1;
+   */
+  durationSeconds: bigint;
+};
+
+/**
+ * Describes the message communityserver.v1.MuteMemberRequest.
+ * Use `create(MuteMemberRequestSchema)` to create a new message.
+ */
+export declare const MuteMemberRequestSchema: GenMessage<MuteMemberRequest>;
+
+/**
+ * @generated from message communityserver.v1.MuteMemberResponse
+ */
+export declare type MuteMemberResponse = Message$1<"communityserver.v1.MuteMemberResponse"> & {
+  /**
+   * @generated from field: string muted_until = 1;
+   */
+  mutedUntil: string;
+};
+
+/**
+ * Describes the message communityserver.v1.MuteMemberResponse.
+ * Use `create(MuteMemberResponseSchema)` to create a new message.
+ */
+export declare const MuteMemberResponseSchema: GenMessage<MuteMemberResponse>;
+
+/**
+ * @generated from message communityserver.v1.UnmuteMemberRequest
+ */
+export declare type UnmuteMemberRequest = Message$1<"communityserver.v1.UnmuteMemberRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.UnmuteMemberRequest.
+ * Use `create(UnmuteMemberRequestSchema)` to create a new message.
+ */
+export declare const UnmuteMemberRequestSchema: GenMessage<UnmuteMemberRequest>;
+
+/**
+ * @generated from message communityserver.v1.UnmuteMemberResponse
+ */
+export declare type UnmuteMemberResponse = Message$1<"communityserver.v1.UnmuteMemberResponse"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.UnmuteMemberResponse.
+ * Use `create(UnmuteMemberResponseSchema)` to create a new message.
+ */
+export declare const UnmuteMemberResponseSchema: GenMessage<UnmuteMemberResponse>;
+
+/**
+ * @generated from message communityserver.v1.Ban
+ */
+export declare type Ban = Message$1<"communityserver.v1.Ban"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_address = 2;
+   */
+  userAddress: string;
+
+  /**
+   * @generated from field: string host = 3;
+   */
+  host: string;
+
+  /**
+   * @generated from field: string reason = 4;
+   */
+  reason: string;
+
+  /**
+   * @generated from field: string banned_by = 5;
+   */
+  bannedBy: string;
+
+  /**
+   * @generated from field: string expires_at = 6;
+   */
+  expiresAt: string;
+
+  /**
+   * @generated from field: string created_at = 7;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message communityserver.v1.Ban.
+ * Use `create(BanSchema)` to create a new message.
+ */
+export declare const BanSchema: GenMessage<Ban>;
+
+/**
+ * @generated from message communityserver.v1.GetBansRequest
+ */
+export declare type GetBansRequest = Message$1<"communityserver.v1.GetBansRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.GetBansRequest.
+ * Use `create(GetBansRequestSchema)` to create a new message.
+ */
+export declare const GetBansRequestSchema: GenMessage<GetBansRequest>;
+
+/**
+ * @generated from message communityserver.v1.GetBansResponse
+ */
+export declare type GetBansResponse = Message$1<"communityserver.v1.GetBansResponse"> & {
+  /**
+   * @generated from field: repeated communityserver.v1.Ban bans = 1;
+   */
+  bans: Ban[];
+};
+
+/**
+ * Describes the message communityserver.v1.GetBansResponse.
+ * Use `create(GetBansResponseSchema)` to create a new message.
+ */
+export declare const GetBansResponseSchema: GenMessage<GetBansResponse>;
+
+/**
+ * @generated from message communityserver.v1.CreateBanRequest
+ */
+export declare type CreateBanRequest = Message$1<"communityserver.v1.CreateBanRequest"> & {
+  /**
+   * @generated from field: string user_address = 1;
+   */
+  userAddress: string;
+
+  /**
+   * @generated from field: string host = 2;
+   */
+  host: string;
+
+  /**
+   * @generated from field: string reason = 3;
+   */
+  reason: string;
+
+  /**
+   * @generated from field: int64 duration_seconds = 4;
+   */
+  durationSeconds: bigint;
+};
+
+/**
+ * Describes the message communityserver.v1.CreateBanRequest.
+ * Use `create(CreateBanRequestSchema)` to create a new message.
+ */
+export declare const CreateBanRequestSchema: GenMessage<CreateBanRequest>;
+
+/**
+ * @generated from message communityserver.v1.CreateBanResponse
+ */
+export declare type CreateBanResponse = Message$1<"communityserver.v1.CreateBanResponse"> & {
+  /**
+   * @generated from field: communityserver.v1.Ban ban = 1;
+   */
+  ban?: Ban;
+};
+
+/**
+ * Describes the message communityserver.v1.CreateBanResponse.
+ * Use `create(CreateBanResponseSchema)` to create a new message.
+ */
+export declare const CreateBanResponseSchema: GenMessage<CreateBanResponse>;
+
+/**
+ * @generated from message communityserver.v1.DeleteBanRequest
+ */
+export declare type DeleteBanRequest = Message$1<"communityserver.v1.DeleteBanRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.DeleteBanRequest.
+ * Use `create(DeleteBanRequestSchema)` to create a new message.
+ */
+export declare const DeleteBanRequestSchema: GenMessage<DeleteBanRequest>;
+
+/**
+ * @generated from message communityserver.v1.DeleteBanResponse
+ */
+export declare type DeleteBanResponse = Message$1<"communityserver.v1.DeleteBanResponse"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.DeleteBanResponse.
+ * Use `create(DeleteBanResponseSchema)` to create a new message.
+ */
+export declare const DeleteBanResponseSchema: GenMessage<DeleteBanResponse>;
+
+/**
+ * @generated from message communityserver.v1.AuditLogEntry
+ */
+export declare type AuditLogEntry = Message$1<"communityserver.v1.AuditLogEntry"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string actor_user_address = 2;
+   */
+  actorUserAddress: string;
+
+  /**
+   * @generated from field: communityserver.v1.AuditLogEntry.Action action = 3;
+   */
+  action: AuditLogEntry_Action;
+
+  /**
+   * @generated from field: string target_id = 4;
+   */
+  targetId: string;
+
+  /**
+   * @generated from field: string reason = 5;
+   */
+  reason: string;
+
+  /**
+   * @generated from field: string before = 6;
+   */
+  before: string;
+
+  /**
+   * @generated from field: string after = 7;
+   */
+  after: string;
+
+  /**
+   * @generated from field: string created_at = 8;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message communityserver.v1.AuditLogEntry.
+ * Use `create(AuditLogEntrySchema)` to create a new message.
+ */
+export declare const AuditLogEntrySchema: GenMessage<AuditLogEntry>;
+
+/**
+ * @generated from enum communityserver.v1.AuditLogEntry.Action
+ */
+export enum AuditLogEntry_Action {
+  /**
+   * @generated from enum value: ACTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ACTION_CHANNEL_CREATE = 1;
+   */
+  CHANNEL_CREATE = 1,
+
+  /**
+   * @generated from enum value: ACTION_CHANNEL_UPDATE = 2;
+   */
+  CHANNEL_UPDATE = 2,
+
+  /**
+   * @generated from enum value: ACTION_CHANNEL_DELETE = 3;
+   */
+  CHANNEL_DELETE = 3,
+
+  /**
+   * @generated from enum value: ACTION_CHANNEL_OVERWRITE_UPDATE = 4;
+   */
+  CHANNEL_OVERWRITE_UPDATE = 4,
+
+  /**
+   * @generated from enum value: ACTION_ROLE_CREATE = 5;
+   */
+  ROLE_CREATE = 5,
+
+  /**
+   * @generated from enum value: ACTION_ROLE_UPDATE = 6;
+   */
+  ROLE_UPDATE = 6,
+
+  /**
+   * @generated from enum value: ACTION_ROLE_DELETE = 7;
+   */
+  ROLE_DELETE = 7,
+
+  /**
+   * @generated from enum value: ACTION_MEMBER_ROLE_ADD = 8;
+   */
+  MEMBER_ROLE_ADD = 8,
+
+  /**
+   * @generated from enum value: ACTION_MEMBER_ROLE_REMOVE = 9;
+   */
+  MEMBER_ROLE_REMOVE = 9,
+
+  /**
+   * @generated from enum value: ACTION_MEMBER_KICK = 10;
+   */
+  MEMBER_KICK = 10,
+
+  /**
+   * @generated from enum value: ACTION_MEMBER_MUTE = 11;
+   */
+  MEMBER_MUTE = 11,
+
+  /**
+   * @generated from enum value: ACTION_MEMBER_UNMUTE = 12;
+   */
+  MEMBER_UNMUTE = 12,
+
+  /**
+   * @generated from enum value: ACTION_BAN_CREATE = 13;
+   */
+  BAN_CREATE = 13,
+
+  /**
+   * @generated from enum value: ACTION_BAN_DELETE = 14;
+   */
+  BAN_DELETE = 14,
+
+  /**
+   * @generated from enum value: ACTION_INVITE_REVOKE = 15;
+   */
+  INVITE_REVOKE = 15,
+
+  /**
+   * @generated from enum value: ACTION_COMMUNITY_UPDATE = 16;
+   */
+  COMMUNITY_UPDATE = 16,
+
+  /**
+   * @generated from enum value: ACTION_MESSAGE_DELETE = 17;
+   */
+  MESSAGE_DELETE = 17,
+
+  /**
+   * @generated from enum value: ACTION_CUSTOM_EMOJI_CREATE = 18;
+   */
+  CUSTOM_EMOJI_CREATE = 18,
+
+  /**
+   * @generated from enum value: ACTION_CUSTOM_EMOJI_DELETE = 19;
+   */
+  CUSTOM_EMOJI_DELETE = 19,
+
+  /**
+   * @generated from enum value: ACTION_MESSAGE_PIN = 20;
+   */
+  MESSAGE_PIN = 20,
+
+  /**
+   * @generated from enum value: ACTION_MESSAGE_UNPIN = 21;
+   */
+  MESSAGE_UNPIN = 21,
+
+  /**
+   * @generated from enum value: ACTION_RATE_LIMIT_EXEMPTION_CREATE = 22;
+   */
+  RATE_LIMIT_EXEMPTION_CREATE = 22,
+
+  /**
+   * @generated from enum value: ACTION_RATE_LIMIT_EXEMPTION_DELETE = 23;
+   */
+  RATE_LIMIT_EXEMPTION_DELETE = 23,
+
+  /**
+   * @generated from enum value: ACTION_BAN_MEMBER_REMOVE = 24;
+   */
+  BAN_MEMBER_REMOVE = 24,
+}
+
+/**
+ * Describes the enum communityserver.v1.AuditLogEntry.Action.
+ */
+export declare const AuditLogEntry_ActionSchema: GenEnum<AuditLogEntry_Action>;
+
+/**
+ * @generated from message communityserver.v1.GetAuditLogRequest
+ */
+export declare type GetAuditLogRequest = Message$1<"communityserver.v1.GetAuditLogRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.GetAuditLogRequest.
+ * Use `create(GetAuditLogRequestSchema)` to create a new message.
+ */
+export declare const GetAuditLogRequestSchema: GenMessage<GetAuditLogRequest>;
+
+/**
+ * @generated from message communityserver.v1.GetAuditLogResponse
+ */
+export declare type GetAuditLogResponse = Message$1<"communityserver.v1.GetAuditLogResponse"> & {
+  /**
+   * @generated from field: repeated communityserver.v1.AuditLogEntry entries = 1;
+   */
+  entries: AuditLogEntry[];
+
+  /**
+   * @generated from field: bool has_more = 2;
+   */
+  hasMore: boolean;
+};
+
+/**
+ * Describes the message communityserver.v1.GetAuditLogResponse.
+ * Use `create(GetAuditLogResponseSchema)` to create a new message.
+ */
+export declare const GetAuditLogResponseSchema: GenMessage<GetAuditLogResponse>;
+
+/**
+ * @generated from message communityserver.v1.Community
+ */
+export declare type Community = Message$1<"communityserver.v1.Community"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string icon_url = 3;
+   */
+  iconUrl: string;
+
+  /**
+   * @generated from field: bool is_default = 4;
+   */
+  isDefault: boolean;
+};
+
+/**
+ * Describes the message communityserver.v1.Community.
+ * Use `create(CommunitySchema)` to create a new message.
+ */
+export declare const CommunitySchema: GenMessage<Community>;
+
+/**
+ * @generated from message communityserver.v1.GetCommunityRequest
+ */
+export declare type GetCommunityRequest = Message$1<"communityserver.v1.GetCommunityRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.GetCommunityRequest.
+ * Use `create(GetCommunityRequestSchema)` to create a new message.
+ */
+export declare const GetCommunityRequestSchema: GenMessage<GetCommunityRequest>;
+
+/**
+ * @generated from message communityserver.v1.GetCommunityResponse
+ */
+export declare type GetCommunityResponse = Message$1<"communityserver.v1.GetCommunityResponse"> & {
+  /**
+   * @generated from field: communityserver.v1.Community community = 1;
+   */
+  community?: Community;
+};
+
+/**
+ * Describes the message communityserver.v1.GetCommunityResponse.
+ * Use `create(GetCommunityResponseSchema)` to create a new message.
+ */
+export declare const GetCommunityResponseSchema: GenMessage<GetCommunityResponse>;
+
+/**
+ * @generated from message communityserver.v1.CreateCommunityRequest
+ */
+export declare type CreateCommunityRequest = Message$1<"communityserver.v1.CreateCommunityRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string icon_url = 2;
+   */
+  iconUrl: string;
+};
+
+/**
+ * Describes the message communityserver.v1.CreateCommunityRequest.
+ * Use `create(CreateCommunityRequestSchema)` to create a new message.
+ */
+export declare const CreateCommunityRequestSchema: GenMessage<CreateCommunityRequest>;
+
+/**
+ * @generated from message communityserver.v1.CreateCommunityResponse
+ */
+export declare type CreateCommunityResponse = Message$1<"communityserver.v1.CreateCommunityResponse"> & {
+  /**
+   * @generated from field: communityserver.v1.Community community = 1;
+   */
+  community?: Community;
+};
+
+/**
+ * Describes the message communityserver.v1.CreateCommunityResponse.
+ * Use `create(CreateCommunityResponseSchema)` to create a new message.
+ */
+export declare const CreateCommunityResponseSchema: GenMessage<CreateCommunityResponse>;
+
+/**
+ * @generated from message communityserver.v1.UpdateCommunityRequest
+ */
+export declare type UpdateCommunityRequest = Message$1<"communityserver.v1.UpdateCommunityRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string icon_url = 2;
+   */
+  iconUrl: string;
+};
+
+/**
+ * Describes the message communityserver.v1.UpdateCommunityRequest.
+ * Use `create(UpdateCommunityRequestSchema)` to create a new message.
+ */
+export declare const UpdateCommunityRequestSchema: GenMessage<UpdateCommunityRequest>;
+
+/**
+ * @generated from message communityserver.v1.UpdateCommunityResponse
+ */
+export declare type UpdateCommunityResponse = Message$1<"communityserver.v1.UpdateCommunityResponse"> & {
+  /**
+   * @generated from field: communityserver.v1.Community community = 1;
+   */
+  community?: Community;
+};
+
+/**
+ * Describes the message communityserver.v1.UpdateCommunityResponse.
+ * Use `create(UpdateCommunityResponseSchema)` to create a new message.
+ */
+export declare const UpdateCommunityResponseSchema: GenMessage<UpdateCommunityResponse>;
+
+/**
+ * @generated from message communityserver.v1.DeleteCommunityRequest
+ */
+export declare type DeleteCommunityRequest = Message$1<"communityserver.v1.DeleteCommunityRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.DeleteCommunityRequest.
+ * Use `create(DeleteCommunityRequestSchema)` to create a new message.
+ */
+export declare const DeleteCommunityRequestSchema: GenMessage<DeleteCommunityRequest>;
+
+/**
+ * @generated from message communityserver.v1.DeleteCommunityResponse
+ */
+export declare type DeleteCommunityResponse = Message$1<"communityserver.v1.DeleteCommunityResponse"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.DeleteCommunityResponse.
+ * Use `create(DeleteCommunityResponseSchema)` to create a new message.
+ */
+export declare const DeleteCommunityResponseSchema: GenMessage<DeleteCommunityResponse>;
+
+/**
+ * @generated from message communityserver.v1.CommunityUpdatedEvent
+ */
+export declare type CommunityUpdatedEvent = Message$1<"communityserver.v1.CommunityUpdatedEvent"> & {
+  /**
+   * @generated from field: communityserver.v1.Community community = 1;
+   */
+  community?: Community;
+};
+
+/**
+ * Describes the message communityserver.v1.CommunityUpdatedEvent.
+ * Use `create(CommunityUpdatedEventSchema)` to create a new message.
+ */
+export declare const CommunityUpdatedEventSchema: GenMessage<CommunityUpdatedEvent>;
+
+/**
+ * @generated from message communityserver.v1.CommunityDeletedEvent
+ */
+export declare type CommunityDeletedEvent = Message$1<"communityserver.v1.CommunityDeletedEvent"> & {
+  /**
+   * @generated from field: string community_id = 1;
+   */
+  communityId: string;
+};
+
+/**
+ * Describes the message communityserver.v1.CommunityDeletedEvent.
+ * Use `create(CommunityDeletedEventSchema)` to create a new message.
+ */
+export declare const CommunityDeletedEventSchema: GenMessage<CommunityDeletedEvent>;
+
+/**
+ * @generated from message communityserver.v1.LeaveCommunityRequest
+ */
+export declare type LeaveCommunityRequest = Message$1<"communityserver.v1.LeaveCommunityRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.LeaveCommunityRequest.
+ * Use `create(LeaveCommunityRequestSchema)` to create a new message.
+ */
+export declare const LeaveCommunityRequestSchema: GenMessage<LeaveCommunityRequest>;
+
+/**
+ * @generated from message communityserver.v1.LeaveCommunityResponse
+ */
+export declare type LeaveCommunityResponse = Message$1<"communityserver.v1.LeaveCommunityResponse"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.LeaveCommunityResponse.
+ * Use `create(LeaveCommunityResponseSchema)` to create a new message.
+ */
+export declare const LeaveCommunityResponseSchema: GenMessage<LeaveCommunityResponse>;
+
+/**
+ * @generated from message communityserver.v1.LeaveServerRequest
+ */
+export declare type LeaveServerRequest = Message$1<"communityserver.v1.LeaveServerRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.LeaveServerRequest.
+ * Use `create(LeaveServerRequestSchema)` to create a new message.
+ */
+export declare const LeaveServerRequestSchema: GenMessage<LeaveServerRequest>;
+
+/**
+ * @generated from message communityserver.v1.LeaveServerResponse
+ */
+export declare type LeaveServerResponse = Message$1<"communityserver.v1.LeaveServerResponse"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.LeaveServerResponse.
+ * Use `create(LeaveServerResponseSchema)` to create a new message.
+ */
+export declare const LeaveServerResponseSchema: GenMessage<LeaveServerResponse>;
+
+/**
+ * @generated from message communityserver.v1.Presence
+ */
+export declare type Presence = Message$1<"communityserver.v1.Presence"> & {
+  /**
+   * @generated from field: string user_address = 1;
+   */
+  userAddress: string;
+
+  /**
+   * @generated from field: communityserver.v1.PresenceStatus status = 2;
+   */
+  status: PresenceStatus;
+
+  /**
+   * @generated from field: string custom_status = 3;
+   */
+  customStatus: string;
+};
+
+/**
+ * Describes the message communityserver.v1.Presence.
+ * Use `create(PresenceSchema)` to create a new message.
+ */
+export declare const PresenceSchema: GenMessage<Presence>;
+
+/**
+ * @generated from message communityserver.v1.PresenceUpdatedEvent
+ */
+export declare type PresenceUpdatedEvent = Message$1<"communityserver.v1.PresenceUpdatedEvent"> & {
+  /**
+   * @generated from field: communityserver.v1.Presence presence = 1;
+   */
+  presence?: Presence;
+};
+
+/**
+ * Describes the message communityserver.v1.PresenceUpdatedEvent.
+ * Use `create(PresenceUpdatedEventSchema)` to create a new message.
+ */
+export declare const PresenceUpdatedEventSchema: GenMessage<PresenceUpdatedEvent>;
+
+/**
+ * @generated from message communityserver.v1.GetPresencesRequest
+ */
+export declare type GetPresencesRequest = Message$1<"communityserver.v1.GetPresencesRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.GetPresencesRequest.
+ * Use `create(GetPresencesRequestSchema)` to create a new message.
+ */
+export declare const GetPresencesRequestSchema: GenMessage<GetPresencesRequest>;
+
+/**
+ * @generated from message communityserver.v1.GetPresencesResponse
+ */
+export declare type GetPresencesResponse = Message$1<"communityserver.v1.GetPresencesResponse"> & {
+  /**
+   * @generated from field: repeated communityserver.v1.Presence presences = 1;
+   */
+  presences: Presence[];
+};
+
+/**
+ * Describes the message communityserver.v1.GetPresencesResponse.
+ * Use `create(GetPresencesResponseSchema)` to create a new message.
+ */
+export declare const GetPresencesResponseSchema: GenMessage<GetPresencesResponse>;
+
+/**
+ * @generated from message communityserver.v1.GatewayCommand
+ */
+export declare type GatewayCommand = Message$1<"communityserver.v1.GatewayCommand"> & {
+  /**
+   * @generated from field: communityserver.v1.GatewayCommand.Type type = 1;
+   */
+  type: GatewayCommand_Type;
+
+  /**
+   * @generated from field: bytes payload = 2;
+   */
+  payload: Uint8Array;
+};
+
+/**
+ * Describes the message communityserver.v1.GatewayCommand.
+ * Use `create(GatewayCommandSchema)` to create a new message.
+ */
+export declare const GatewayCommandSchema: GenMessage<GatewayCommand>;
+
+/**
+ * @generated from enum communityserver.v1.GatewayCommand.Type
+ */
+export enum GatewayCommand_Type {
+  /**
+   * @generated from enum value: TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TYPE_UPDATE_PRESENCE = 1;
+   */
+  UPDATE_PRESENCE = 1,
+
+  /**
+   * @generated from enum value: TYPE_START_TYPING = 2;
+   */
+  START_TYPING = 2,
+}
+
+/**
+ * Describes the enum communityserver.v1.GatewayCommand.Type.
+ */
+export declare const GatewayCommand_TypeSchema: GenEnum<GatewayCommand_Type>;
+
+/**
+ * @generated from message communityserver.v1.UpdatePresenceCommand
+ */
+export declare type UpdatePresenceCommand = Message$1<"communityserver.v1.UpdatePresenceCommand"> & {
+  /**
+   * @generated from field: communityserver.v1.PresenceStatus status = 1;
+   */
+  status: PresenceStatus;
+
+  /**
+   * @generated from field: string custom_status = 2;
+   */
+  customStatus: string;
+};
+
+/**
+ * Describes the message communityserver.v1.UpdatePresenceCommand.
+ * Use `create(UpdatePresenceCommandSchema)` to create a new message.
+ */
+export declare const UpdatePresenceCommandSchema: GenMessage<UpdatePresenceCommand>;
+
+/**
+ * @generated from message communityserver.v1.StartTypingCommand
+ */
+export declare type StartTypingCommand = Message$1<"communityserver.v1.StartTypingCommand"> & {
+  /**
+   * @generated from field: string community_id = 1;
+   */
+  communityId: string;
+
+  /**
+   * @generated from field: string channel_id = 2;
+   */
+  channelId: string;
+};
+
+/**
+ * Describes the message communityserver.v1.StartTypingCommand.
+ * Use `create(StartTypingCommandSchema)` to create a new message.
+ */
+export declare const StartTypingCommandSchema: GenMessage<StartTypingCommand>;
+
+/**
+ * @generated from message communityserver.v1.TypingStartedEvent
+ */
+export declare type TypingStartedEvent = Message$1<"communityserver.v1.TypingStartedEvent"> & {
+  /**
+   * @generated from field: string channel_id = 1;
+   */
+  channelId: string;
+
+  /**
+   * @generated from field: string user_address = 2;
+   */
+  userAddress: string;
+
+  /**
+   * @generated from field: string expires_at = 3;
+   */
+  expiresAt: string;
+};
+
+/**
+ * Describes the message communityserver.v1.TypingStartedEvent.
+ * Use `create(TypingStartedEventSchema)` to create a new message.
+ */
+export declare const TypingStartedEventSchema: GenMessage<TypingStartedEvent>;
+
+/**
+ * @generated from message communityserver.v1.ReadState
+ */
+export declare type ReadState = Message$1<"communityserver.v1.ReadState"> & {
+  /**
+   * @generated from field: string channel_id = 1;
+   */
+  channelId: string;
+
+  /**
+   * @generated from field: string last_read_message_id = 2;
+   */
+  lastReadMessageId: string;
+
+  /**
+   * @generated from field: int64 unread_count = 3;
+   */
+  unreadCount: bigint;
+
+  /**
+   * @generated from field: int64 mention_count = 4;
+   */
+  mentionCount: bigint;
+};
+
+/**
+ * Describes the message communityserver.v1.ReadState.
+ * Use `create(ReadStateSchema)` to create a new message.
+ */
+export declare const ReadStateSchema: GenMessage<ReadState>;
+
+/**
+ * @generated from message communityserver.v1.GetReadStatesRequest
+ */
+export declare type GetReadStatesRequest = Message$1<"communityserver.v1.GetReadStatesRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.GetReadStatesRequest.
+ * Use `create(GetReadStatesRequestSchema)` to create a new message.
+ */
+export declare const GetReadStatesRequestSchema: GenMessage<GetReadStatesRequest>;
+
+/**
+ * @generated from message communityserver.v1.GetReadStatesResponse
+ */
+export declare type GetReadStatesResponse = Message$1<"communityserver.v1.GetReadStatesResponse"> & {
+  /**
+   * @generated from field: repeated communityserver.v1.ReadState read_states = 1;
+   */
+  readStates: ReadState[];
+};
+
+/**
+ * Describes the message communityserver.v1.GetReadStatesResponse.
+ * Use `create(GetReadStatesResponseSchema)` to create a new message.
+ */
+export declare const GetReadStatesResponseSchema: GenMessage<GetReadStatesResponse>;
+
+/**
+ * @generated from message communityserver.v1.AckChannelRequest
+ */
+export declare type AckChannelRequest = Message$1<"communityserver.v1.AckChannelRequest"> & {
+  /**
+   * @generated from field: string message_id = 1;
+   */
+  messageId: string;
+};
+
+/**
+ * Describes the message communityserver.v1.AckChannelRequest.
+ * Use `create(AckChannelRequestSchema)` to create a new message.
+ */
+export declare const AckChannelRequestSchema: GenMessage<AckChannelRequest>;
+
+/**
+ * @generated from message communityserver.v1.AckChannelResponse
+ */
+export declare type AckChannelResponse = Message$1<"communityserver.v1.AckChannelResponse"> & {
+  /**
+   * @generated from field: communityserver.v1.ReadState read_state = 1;
+   */
+  readState?: ReadState;
+};
+
+/**
+ * Describes the message communityserver.v1.AckChannelResponse.
+ * Use `create(AckChannelResponseSchema)` to create a new message.
+ */
+export declare const AckChannelResponseSchema: GenMessage<AckChannelResponse>;
+
+/**
+ * @generated from message communityserver.v1.UpdateMessageRequest
+ */
+export declare type UpdateMessageRequest = Message$1<"communityserver.v1.UpdateMessageRequest"> & {
+  /**
+   * @generated from field: string body = 1;
+   */
+  body: string;
+};
+
+/**
+ * Describes the message communityserver.v1.UpdateMessageRequest.
+ * Use `create(UpdateMessageRequestSchema)` to create a new message.
+ */
+export declare const UpdateMessageRequestSchema: GenMessage<UpdateMessageRequest>;
+
+/**
+ * @generated from message communityserver.v1.UpdateMessageResponse
+ */
+export declare type UpdateMessageResponse = Message$1<"communityserver.v1.UpdateMessageResponse"> & {
+  /**
+   * @generated from field: communityserver.v1.Message message = 1;
+   */
+  message?: Message;
+};
+
+/**
+ * Describes the message communityserver.v1.UpdateMessageResponse.
+ * Use `create(UpdateMessageResponseSchema)` to create a new message.
+ */
+export declare const UpdateMessageResponseSchema: GenMessage<UpdateMessageResponse>;
+
+/**
+ * @generated from message communityserver.v1.DeleteMessageRequest
+ */
+export declare type DeleteMessageRequest = Message$1<"communityserver.v1.DeleteMessageRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.DeleteMessageRequest.
+ * Use `create(DeleteMessageRequestSchema)` to create a new message.
+ */
+export declare const DeleteMessageRequestSchema: GenMessage<DeleteMessageRequest>;
+
+/**
+ * @generated from message communityserver.v1.DeleteMessageResponse
+ */
+export declare type DeleteMessageResponse = Message$1<"communityserver.v1.DeleteMessageResponse"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.DeleteMessageResponse.
+ * Use `create(DeleteMessageResponseSchema)` to create a new message.
+ */
+export declare const DeleteMessageResponseSchema: GenMessage<DeleteMessageResponse>;
+
+/**
+ * @generated from message communityserver.v1.MessageRevision
+ */
+export declare type MessageRevision = Message$1<"communityserver.v1.MessageRevision"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string body = 2;
+   */
+  body: string;
+
+  /**
+   * @generated from field: string created_at = 3;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message communityserver.v1.MessageRevision.
+ * Use `create(MessageRevisionSchema)` to create a new message.
+ */
+export declare const MessageRevisionSchema: GenMessage<MessageRevision>;
+
+/**
+ * @generated from message communityserver.v1.GetMessageRevisionsRequest
+ */
+export declare type GetMessageRevisionsRequest = Message$1<"communityserver.v1.GetMessageRevisionsRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.GetMessageRevisionsRequest.
+ * Use `create(GetMessageRevisionsRequestSchema)` to create a new message.
+ */
+export declare const GetMessageRevisionsRequestSchema: GenMessage<GetMessageRevisionsRequest>;
+
+/**
+ * @generated from message communityserver.v1.GetMessageRevisionsResponse
+ */
+export declare type GetMessageRevisionsResponse = Message$1<"communityserver.v1.GetMessageRevisionsResponse"> & {
+  /**
+   * @generated from field: repeated communityserver.v1.MessageRevision revisions = 1;
+   */
+  revisions: MessageRevision[];
+};
+
+/**
+ * Describes the message communityserver.v1.GetMessageRevisionsResponse.
+ * Use `create(GetMessageRevisionsResponseSchema)` to create a new message.
+ */
+export declare const GetMessageRevisionsResponseSchema: GenMessage<GetMessageRevisionsResponse>;
+
+/**
+ * @generated from message communityserver.v1.CustomEmoji
+ */
+export declare type CustomEmoji = Message$1<"communityserver.v1.CustomEmoji"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string image_url = 3;
+   */
+  imageUrl: string;
+
+  /**
+   * @generated from field: string created_by = 4;
+   */
+  createdBy: string;
+
+  /**
+   * @generated from field: string created_at = 5;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message communityserver.v1.CustomEmoji.
+ * Use `create(CustomEmojiSchema)` to create a new message.
+ */
+export declare const CustomEmojiSchema: GenMessage<CustomEmoji>;
+
+/**
+ * @generated from message communityserver.v1.GetCustomEmojisRequest
+ */
+export declare type GetCustomEmojisRequest = Message$1<"communityserver.v1.GetCustomEmojisRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.GetCustomEmojisRequest.
+ * Use `create(GetCustomEmojisRequestSchema)` to create a new message.
+ */
+export declare const GetCustomEmojisRequestSchema: GenMessage<GetCustomEmojisRequest>;
+
+/**
+ * @generated from message communityserver.v1.GetCustomEmojisResponse
+ */
+export declare type GetCustomEmojisResponse = Message$1<"communityserver.v1.GetCustomEmojisResponse"> & {
+  /**
+   * @generated from field: repeated communityserver.v1.CustomEmoji emojis = 1;
+   */
+  emojis: CustomEmoji[];
+};
+
+/**
+ * Describes the message communityserver.v1.GetCustomEmojisResponse.
+ * Use `create(GetCustomEmojisResponseSchema)` to create a new message.
+ */
+export declare const GetCustomEmojisResponseSchema: GenMessage<GetCustomEmojisResponse>;
+
+/**
+ * @generated from message communityserver.v1.CreateCustomEmojiResponse
+ */
+export declare type CreateCustomEmojiResponse = Message$1<"communityserver.v1.CreateCustomEmojiResponse"> & {
+  /**
+   * @generated from field: communityserver.v1.CustomEmoji emoji = 1;
+   */
+  emoji?: CustomEmoji;
+};
+
+/**
+ * Describes the message communityserver.v1.CreateCustomEmojiResponse.
+ * Use `create(CreateCustomEmojiResponseSchema)` to create a new message.
+ */
+export declare const CreateCustomEmojiResponseSchema: GenMessage<CreateCustomEmojiResponse>;
+
+/**
+ * @generated from message communityserver.v1.DeleteCustomEmojiRequest
+ */
+export declare type DeleteCustomEmojiRequest = Message$1<"communityserver.v1.DeleteCustomEmojiRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.DeleteCustomEmojiRequest.
+ * Use `create(DeleteCustomEmojiRequestSchema)` to create a new message.
+ */
+export declare const DeleteCustomEmojiRequestSchema: GenMessage<DeleteCustomEmojiRequest>;
+
+/**
+ * @generated from message communityserver.v1.DeleteCustomEmojiResponse
+ */
+export declare type DeleteCustomEmojiResponse = Message$1<"communityserver.v1.DeleteCustomEmojiResponse"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.DeleteCustomEmojiResponse.
+ * Use `create(DeleteCustomEmojiResponseSchema)` to create a new message.
+ */
+export declare const DeleteCustomEmojiResponseSchema: GenMessage<DeleteCustomEmojiResponse>;
+
+/**
+ * @generated from message communityserver.v1.CustomEmojiCreatedEvent
+ */
+export declare type CustomEmojiCreatedEvent = Message$1<"communityserver.v1.CustomEmojiCreatedEvent"> & {
+  /**
+   * @generated from field: communityserver.v1.CustomEmoji emoji = 1;
+   */
+  emoji?: CustomEmoji;
+};
+
+/**
+ * Describes the message communityserver.v1.CustomEmojiCreatedEvent.
+ * Use `create(CustomEmojiCreatedEventSchema)` to create a new message.
+ */
+export declare const CustomEmojiCreatedEventSchema: GenMessage<CustomEmojiCreatedEvent>;
+
+/**
+ * @generated from message communityserver.v1.CustomEmojiDeletedEvent
+ */
+export declare type CustomEmojiDeletedEvent = Message$1<"communityserver.v1.CustomEmojiDeletedEvent"> & {
+  /**
+   * @generated from field: string emoji_id = 1;
+   */
+  emojiId: string;
+};
+
+/**
+ * Describes the message communityserver.v1.CustomEmojiDeletedEvent.
+ * Use `create(CustomEmojiDeletedEventSchema)` to create a new message.
+ */
+export declare const CustomEmojiDeletedEventSchema: GenMessage<CustomEmojiDeletedEvent>;
+
+/**
+ * @generated from message communityserver.v1.AddReactionRequest
+ */
+export declare type AddReactionRequest = Message$1<"communityserver.v1.AddReactionRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.AddReactionRequest.
+ * Use `create(AddReactionRequestSchema)` to create a new message.
+ */
+export declare const AddReactionRequestSchema: GenMessage<AddReactionRequest>;
+
+/**
+ * @generated from message communityserver.v1.AddReactionResponse
+ */
+export declare type AddReactionResponse = Message$1<"communityserver.v1.AddReactionResponse"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.AddReactionResponse.
+ * Use `create(AddReactionResponseSchema)` to create a new message.
+ */
+export declare const AddReactionResponseSchema: GenMessage<AddReactionResponse>;
+
+/**
+ * @generated from message communityserver.v1.RemoveReactionRequest
+ */
+export declare type RemoveReactionRequest = Message$1<"communityserver.v1.RemoveReactionRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.RemoveReactionRequest.
+ * Use `create(RemoveReactionRequestSchema)` to create a new message.
+ */
+export declare const RemoveReactionRequestSchema: GenMessage<RemoveReactionRequest>;
+
+/**
+ * @generated from message communityserver.v1.RemoveReactionResponse
+ */
+export declare type RemoveReactionResponse = Message$1<"communityserver.v1.RemoveReactionResponse"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.RemoveReactionResponse.
+ * Use `create(RemoveReactionResponseSchema)` to create a new message.
+ */
+export declare const RemoveReactionResponseSchema: GenMessage<RemoveReactionResponse>;
+
+/**
+ * @generated from message communityserver.v1.ReactionAddedEvent
+ */
+export declare type ReactionAddedEvent = Message$1<"communityserver.v1.ReactionAddedEvent"> & {
+  /**
+   * @generated from field: string message_id = 1;
+   */
+  messageId: string;
+
+  /**
+   * @generated from field: string channel_id = 2;
+   */
+  channelId: string;
+
+  /**
+   * @generated from field: string user_address = 3;
+   */
+  userAddress: string;
+
+  /**
+   * @generated from field: string emoji = 4;
+   */
+  emoji: string;
+
+  /**
+   * @generated from field: string custom_emoji_id = 5;
+   */
+  customEmojiId: string;
+};
+
+/**
+ * Describes the message communityserver.v1.ReactionAddedEvent.
+ * Use `create(ReactionAddedEventSchema)` to create a new message.
+ */
+export declare const ReactionAddedEventSchema: GenMessage<ReactionAddedEvent>;
+
+/**
+ * @generated from message communityserver.v1.ReactionRemovedEvent
+ */
+export declare type ReactionRemovedEvent = Message$1<"communityserver.v1.ReactionRemovedEvent"> & {
+  /**
+   * @generated from field: string message_id = 1;
+   */
+  messageId: string;
+
+  /**
+   * @generated from field: string channel_id = 2;
+   */
+  channelId: string;
+
+  /**
+   * @generated from field: string user_address = 3;
+   */
+  userAddress: string;
+
+  /**
+   * @generated from field: string emoji = 4;
+   */
+  emoji: string;
+
+  /**
+   * @generated from field: string custom_emoji_id = 5;
+   */
+  customEmojiId: string;
+};
+
+/**
+ * Describes the message communityserver.v1.ReactionRemovedEvent.
+ * Use `create(ReactionRemovedEventSchema)` to create a new message.
+ */
+export declare const ReactionRemovedEventSchema: GenMessage<ReactionRemovedEvent>;
+
+/**
+ * @generated from message communityserver.v1.SearchMessagesResponse
+ */
+export declare type SearchMessagesResponse = Message$1<"communityserver.v1.SearchMessagesResponse"> & {
+  /**
+   * @generated from field: repeated communityserver.v1.Message messages = 1;
+   */
+  messages: Message[];
+
+  /**
+   * @generated from field: bool has_more = 2;
+   */
+  hasMore: boolean;
+};
+
+/**
+ * Describes the message communityserver.v1.SearchMessagesResponse.
+ * Use `create(SearchMessagesResponseSchema)` to create a new message.
+ */
+export declare const SearchMessagesResponseSchema: GenMessage<SearchMessagesResponse>;
+
+/**
+ * @generated from message communityserver.v1.UploadAttachmentResponse
+ */
+export declare type UploadAttachmentResponse = Message$1<"communityserver.v1.UploadAttachmentResponse"> & {
+  /**
+   * @generated from field: communityserver.v1.Attachment attachment = 1;
+   */
+  attachment?: Attachment;
+};
+
+/**
+ * Describes the message communityserver.v1.UploadAttachmentResponse.
+ * Use `create(UploadAttachmentResponseSchema)` to create a new message.
+ */
+export declare const UploadAttachmentResponseSchema: GenMessage<UploadAttachmentResponse>;
+
+/**
+ * @generated from message communityserver.v1.PinnedMessage
+ */
+export declare type PinnedMessage = Message$1<"communityserver.v1.PinnedMessage"> & {
+  /**
+   * @generated from field: communityserver.v1.Message message = 1;
+   */
+  message?: Message;
+
+  /**
+   * @generated from field: string pinned_by = 2;
+   */
+  pinnedBy: string;
+
+  /**
+   * @generated from field: string pinned_at = 3;
+   */
+  pinnedAt: string;
+};
+
+/**
+ * Describes the message communityserver.v1.PinnedMessage.
+ * Use `create(PinnedMessageSchema)` to create a new message.
+ */
+export declare const PinnedMessageSchema: GenMessage<PinnedMessage>;
+
+/**
+ * @generated from message communityserver.v1.GetPinnedMessagesRequest
+ */
+export declare type GetPinnedMessagesRequest = Message$1<"communityserver.v1.GetPinnedMessagesRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.GetPinnedMessagesRequest.
+ * Use `create(GetPinnedMessagesRequestSchema)` to create a new message.
+ */
+export declare const GetPinnedMessagesRequestSchema: GenMessage<GetPinnedMessagesRequest>;
+
+/**
+ * @generated from message communityserver.v1.GetPinnedMessagesResponse
+ */
+export declare type GetPinnedMessagesResponse = Message$1<"communityserver.v1.GetPinnedMessagesResponse"> & {
+  /**
+   * @generated from field: repeated communityserver.v1.PinnedMessage pins = 1;
+   */
+  pins: PinnedMessage[];
+};
+
+/**
+ * Describes the message communityserver.v1.GetPinnedMessagesResponse.
+ * Use `create(GetPinnedMessagesResponseSchema)` to create a new message.
+ */
+export declare const GetPinnedMessagesResponseSchema: GenMessage<GetPinnedMessagesResponse>;
+
+/**
+ * @generated from message communityserver.v1.PinMessageRequest
+ */
+export declare type PinMessageRequest = Message$1<"communityserver.v1.PinMessageRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.PinMessageRequest.
+ * Use `create(PinMessageRequestSchema)` to create a new message.
+ */
+export declare const PinMessageRequestSchema: GenMessage<PinMessageRequest>;
+
+/**
+ * @generated from message communityserver.v1.PinMessageResponse
+ */
+export declare type PinMessageResponse = Message$1<"communityserver.v1.PinMessageResponse"> & {
+  /**
+   * @generated from field: communityserver.v1.PinnedMessage pin = 1;
+   */
+  pin?: PinnedMessage;
+};
+
+/**
+ * Describes the message communityserver.v1.PinMessageResponse.
+ * Use `create(PinMessageResponseSchema)` to create a new message.
+ */
+export declare const PinMessageResponseSchema: GenMessage<PinMessageResponse>;
+
+/**
+ * @generated from message communityserver.v1.UnpinMessageRequest
+ */
+export declare type UnpinMessageRequest = Message$1<"communityserver.v1.UnpinMessageRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.UnpinMessageRequest.
+ * Use `create(UnpinMessageRequestSchema)` to create a new message.
+ */
+export declare const UnpinMessageRequestSchema: GenMessage<UnpinMessageRequest>;
+
+/**
+ * @generated from message communityserver.v1.UnpinMessageResponse
+ */
+export declare type UnpinMessageResponse = Message$1<"communityserver.v1.UnpinMessageResponse"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.UnpinMessageResponse.
+ * Use `create(UnpinMessageResponseSchema)` to create a new message.
+ */
+export declare const UnpinMessageResponseSchema: GenMessage<UnpinMessageResponse>;
+
+/**
+ * @generated from message communityserver.v1.MessagePinnedEvent
+ */
+export declare type MessagePinnedEvent = Message$1<"communityserver.v1.MessagePinnedEvent"> & {
+  /**
+   * @generated from field: communityserver.v1.PinnedMessage pin = 1;
+   */
+  pin?: PinnedMessage;
+};
+
+/**
+ * Describes the message communityserver.v1.MessagePinnedEvent.
+ * Use `create(MessagePinnedEventSchema)` to create a new message.
+ */
+export declare const MessagePinnedEventSchema: GenMessage<MessagePinnedEvent>;
+
+/**
+ * @generated from message communityserver.v1.MessageUnpinnedEvent
+ */
+export declare type MessageUnpinnedEvent = Message$1<"communityserver.v1.MessageUnpinnedEvent"> & {
+  /**
+   * @generated from field: string message_id = 1;
+   */
+  messageId: string;
+
+  /**
+   * @generated from field: string channel_id = 2;
+   */
+  channelId: string;
+};
+
+/**
+ * Describes the message communityserver.v1.MessageUnpinnedEvent.
+ * Use `create(MessageUnpinnedEventSchema)` to create a new message.
+ */
+export declare const MessageUnpinnedEventSchema: GenMessage<MessageUnpinnedEvent>;
+
+/**
+ * @generated from message communityserver.v1.UpdateSlowModeRequest
+ */
+export declare type UpdateSlowModeRequest = Message$1<"communityserver.v1.UpdateSlowModeRequest"> & {
+  /**
+   * @generated from field: int32 slow_mode_seconds = 1;
+   */
+  slowModeSeconds: number;
+};
+
+/**
+ * Describes the message communityserver.v1.UpdateSlowModeRequest.
+ * Use `create(UpdateSlowModeRequestSchema)` to create a new message.
+ */
+export declare const UpdateSlowModeRequestSchema: GenMessage<UpdateSlowModeRequest>;
+
+/**
+ * @generated from message communityserver.v1.UpdateSlowModeResponse
+ */
+export declare type UpdateSlowModeResponse = Message$1<"communityserver.v1.UpdateSlowModeResponse"> & {
+  /**
+   * @generated from field: communityserver.v1.Channel channel = 1;
+   */
+  channel?: Channel;
+};
+
+/**
+ * Describes the message communityserver.v1.UpdateSlowModeResponse.
+ * Use `create(UpdateSlowModeResponseSchema)` to create a new message.
+ */
+export declare const UpdateSlowModeResponseSchema: GenMessage<UpdateSlowModeResponse>;
+
+/**
+ * @generated from message communityserver.v1.RateLimitedError
+ */
+export declare type RateLimitedError = Message$1<"communityserver.v1.RateLimitedError"> & {
+  /**
+   * @generated from field: communityserver.v1.RateLimitedError.Scope scope = 1;
+   */
+  scope: RateLimitedError_Scope;
+
+  /**
+   * @generated from field: int64 retry_after_ms = 2;
+   */
+  retryAfterMs: bigint;
+};
+
+/**
+ * Describes the message communityserver.v1.RateLimitedError.
+ * Use `create(RateLimitedErrorSchema)` to create a new message.
+ */
+export declare const RateLimitedErrorSchema: GenMessage<RateLimitedError>;
+
+/**
+ * @generated from enum communityserver.v1.RateLimitedError.Scope
+ */
+export enum RateLimitedError_Scope {
+  /**
+   * @generated from enum value: SCOPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SCOPE_SLOW_MODE = 1;
+   */
+  SLOW_MODE = 1,
+
+  /**
+   * @generated from enum value: SCOPE_BURST = 2;
+   */
+  BURST = 2,
+}
+
+/**
+ * Describes the enum communityserver.v1.RateLimitedError.Scope.
+ */
+export declare const RateLimitedError_ScopeSchema: GenEnum<RateLimitedError_Scope>;
+
+/**
+ * @generated from message communityserver.v1.RateLimitExemption
+ */
+export declare type RateLimitExemption = Message$1<"communityserver.v1.RateLimitExemption"> & {
+  /**
+   * @generated from field: string user_address = 1;
+   */
+  userAddress: string;
+
+  /**
+   * @generated from field: string created_by = 2;
+   */
+  createdBy: string;
+
+  /**
+   * @generated from field: string created_at = 3;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message communityserver.v1.RateLimitExemption.
+ * Use `create(RateLimitExemptionSchema)` to create a new message.
+ */
+export declare const RateLimitExemptionSchema: GenMessage<RateLimitExemption>;
+
+/**
+ * @generated from message communityserver.v1.GetRateLimitExemptionsRequest
+ */
+export declare type GetRateLimitExemptionsRequest = Message$1<"communityserver.v1.GetRateLimitExemptionsRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.GetRateLimitExemptionsRequest.
+ * Use `create(GetRateLimitExemptionsRequestSchema)` to create a new message.
+ */
+export declare const GetRateLimitExemptionsRequestSchema: GenMessage<GetRateLimitExemptionsRequest>;
+
+/**
+ * @generated from message communityserver.v1.GetRateLimitExemptionsResponse
+ */
+export declare type GetRateLimitExemptionsResponse = Message$1<"communityserver.v1.GetRateLimitExemptionsResponse"> & {
+  /**
+   * @generated from field: repeated communityserver.v1.RateLimitExemption exemptions = 1;
+   */
+  exemptions: RateLimitExemption[];
+};
+
+/**
+ * Describes the message communityserver.v1.GetRateLimitExemptionsResponse.
+ * Use `create(GetRateLimitExemptionsResponseSchema)` to create a new message.
+ */
+export declare const GetRateLimitExemptionsResponseSchema: GenMessage<GetRateLimitExemptionsResponse>;
+
+/**
+ * @generated from message communityserver.v1.AddRateLimitExemptionRequest
+ */
+export declare type AddRateLimitExemptionRequest = Message$1<"communityserver.v1.AddRateLimitExemptionRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.AddRateLimitExemptionRequest.
+ * Use `create(AddRateLimitExemptionRequestSchema)` to create a new message.
+ */
+export declare const AddRateLimitExemptionRequestSchema: GenMessage<AddRateLimitExemptionRequest>;
+
+/**
+ * @generated from message communityserver.v1.AddRateLimitExemptionResponse
+ */
+export declare type AddRateLimitExemptionResponse = Message$1<"communityserver.v1.AddRateLimitExemptionResponse"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.AddRateLimitExemptionResponse.
+ * Use `create(AddRateLimitExemptionResponseSchema)` to create a new message.
+ */
+export declare const AddRateLimitExemptionResponseSchema: GenMessage<AddRateLimitExemptionResponse>;
+
+/**
+ * @generated from message communityserver.v1.RemoveRateLimitExemptionRequest
+ */
+export declare type RemoveRateLimitExemptionRequest = Message$1<"communityserver.v1.RemoveRateLimitExemptionRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.RemoveRateLimitExemptionRequest.
+ * Use `create(RemoveRateLimitExemptionRequestSchema)` to create a new message.
+ */
+export declare const RemoveRateLimitExemptionRequestSchema: GenMessage<RemoveRateLimitExemptionRequest>;
+
+/**
+ * @generated from message communityserver.v1.RemoveRateLimitExemptionResponse
+ */
+export declare type RemoveRateLimitExemptionResponse = Message$1<"communityserver.v1.RemoveRateLimitExemptionResponse"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.RemoveRateLimitExemptionResponse.
+ * Use `create(RemoveRateLimitExemptionResponseSchema)` to create a new message.
+ */
+export declare const RemoveRateLimitExemptionResponseSchema: GenMessage<RemoveRateLimitExemptionResponse>;
+
+/**
+ * @generated from message communityserver.v1.Conversation
+ */
+export declare type Conversation = Message$1<"communityserver.v1.Conversation"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: repeated string participant_addresses = 2;
+   */
+  participantAddresses: string[];
+
+  /**
+   * @generated from field: string created_by = 3;
+   */
+  createdBy: string;
+
+  /**
+   * @generated from field: string created_at = 4;
+   */
+  createdAt: string;
+
+  /**
+   * @generated from field: string last_message_at = 5;
+   */
+  lastMessageAt: string;
+};
+
+/**
+ * Describes the message communityserver.v1.Conversation.
+ * Use `create(ConversationSchema)` to create a new message.
+ */
+export declare const ConversationSchema: GenMessage<Conversation>;
+
+/**
+ * @generated from message communityserver.v1.ConversationMessage
+ */
+export declare type ConversationMessage = Message$1<"communityserver.v1.ConversationMessage"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string conversation_id = 2;
+   */
+  conversationId: string;
+
+  /**
+   * @generated from field: string user_address = 3;
+   */
+  userAddress: string;
+
+  /**
+   * @generated from field: string body = 4;
+   */
+  body: string;
+
+  /**
+   * @generated from field: string created_at = 5;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message communityserver.v1.ConversationMessage.
+ * Use `create(ConversationMessageSchema)` to create a new message.
+ */
+export declare const ConversationMessageSchema: GenMessage<ConversationMessage>;
+
+/**
+ * @generated from message communityserver.v1.CreateConversationRequest
+ */
+export declare type CreateConversationRequest = Message$1<"communityserver.v1.CreateConversationRequest"> & {
+  /**
+   * @generated from field: repeated string participant_addresses = 1;
+   */
+  participantAddresses: string[];
+};
+
+/**
+ * Describes the message communityserver.v1.CreateConversationRequest.
+ * Use `create(CreateConversationRequestSchema)` to create a new message.
+ */
+export declare const CreateConversationRequestSchema: GenMessage<CreateConversationRequest>;
+
+/**
+ * @generated from message communityserver.v1.CreateConversationResponse
+ */
+export declare type CreateConversationResponse = Message$1<"communityserver.v1.CreateConversationResponse"> & {
+  /**
+   * @generated from field: communityserver.v1.Conversation conversation = 1;
+   */
+  conversation?: Conversation;
+};
+
+/**
+ * Describes the message communityserver.v1.CreateConversationResponse.
+ * Use `create(CreateConversationResponseSchema)` to create a new message.
+ */
+export declare const CreateConversationResponseSchema: GenMessage<CreateConversationResponse>;
+
+/**
+ * @generated from message communityserver.v1.GetConversationsRequest
+ */
+export declare type GetConversationsRequest = Message$1<"communityserver.v1.GetConversationsRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.GetConversationsRequest.
+ * Use `create(GetConversationsRequestSchema)` to create a new message.
+ */
+export declare const GetConversationsRequestSchema: GenMessage<GetConversationsRequest>;
+
+/**
+ * @generated from message communityserver.v1.GetConversationsResponse
+ */
+export declare type GetConversationsResponse = Message$1<"communityserver.v1.GetConversationsResponse"> & {
+  /**
+   * @generated from field: repeated communityserver.v1.Conversation conversations = 1;
+   */
+  conversations: Conversation[];
+};
+
+/**
+ * Describes the message communityserver.v1.GetConversationsResponse.
+ * Use `create(GetConversationsResponseSchema)` to create a new message.
+ */
+export declare const GetConversationsResponseSchema: GenMessage<GetConversationsResponse>;
+
+/**
+ * @generated from message communityserver.v1.GetConversationMessagesRequest
+ */
+export declare type GetConversationMessagesRequest = Message$1<"communityserver.v1.GetConversationMessagesRequest"> & {
+};
+
+/**
+ * Describes the message communityserver.v1.GetConversationMessagesRequest.
+ * Use `create(GetConversationMessagesRequestSchema)` to create a new message.
+ */
+export declare const GetConversationMessagesRequestSchema: GenMessage<GetConversationMessagesRequest>;
+
+/**
+ * @generated from message communityserver.v1.GetConversationMessagesResponse
+ */
+export declare type GetConversationMessagesResponse = Message$1<"communityserver.v1.GetConversationMessagesResponse"> & {
+  /**
+   * @generated from field: repeated communityserver.v1.ConversationMessage messages = 1;
+   */
+  messages: ConversationMessage[];
+
+  /**
+   * @generated from field: bool has_more = 2;
+   */
+  hasMore: boolean;
+};
+
+/**
+ * Describes the message communityserver.v1.GetConversationMessagesResponse.
+ * Use `create(GetConversationMessagesResponseSchema)` to create a new message.
+ */
+export declare const GetConversationMessagesResponseSchema: GenMessage<GetConversationMessagesResponse>;
+
+/**
+ * @generated from message communityserver.v1.SendConversationMessageRequest
+ */
+export declare type SendConversationMessageRequest = Message$1<"communityserver.v1.SendConversationMessageRequest"> & {
+  /**
+   * @generated from field: string body = 1;
+   */
+  body: string;
+};
+
+/**
+ * Describes the message communityserver.v1.SendConversationMessageRequest.
+ * Use `create(SendConversationMessageRequestSchema)` to create a new message.
+ */
+export declare const SendConversationMessageRequestSchema: GenMessage<SendConversationMessageRequest>;
+
+/**
+ * @generated from message communityserver.v1.SendConversationMessageResponse
+ */
+export declare type SendConversationMessageResponse = Message$1<"communityserver.v1.SendConversationMessageResponse"> & {
+  /**
+   * @generated from field: communityserver.v1.ConversationMessage message = 1;
+   */
+  message?: ConversationMessage;
+};
+
+/**
+ * Describes the message communityserver.v1.SendConversationMessageResponse.
+ * Use `create(SendConversationMessageResponseSchema)` to create a new message.
+ */
+export declare const SendConversationMessageResponseSchema: GenMessage<SendConversationMessageResponse>;
+
+/**
+ * @generated from enum communityserver.v1.Permission
+ */
+export enum Permission {
+  /**
+   * @generated from enum value: PERMISSION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PERMISSION_MANAGE_CHANNELS = 1;
+   */
+  MANAGE_CHANNELS = 1,
+
+  /**
+   * @generated from enum value: PERMISSION_MANAGE_MESSAGES = 2;
+   */
+  MANAGE_MESSAGES = 2,
+
+  /**
+   * @generated from enum value: PERMISSION_KICK_MEMBERS = 4;
+   */
+  KICK_MEMBERS = 4,
+
+  /**
+   * @generated from enum value: PERMISSION_BAN_MEMBERS = 8;
+   */
+  BAN_MEMBERS = 8,
+
+  /**
+   * @generated from enum value: PERMISSION_MANAGE_ROLES = 16;
+   */
+  MANAGE_ROLES = 16,
+
+  /**
+   * @generated from enum value: PERMISSION_ADMINISTRATOR = 32;
+   */
+  ADMINISTRATOR = 32,
+
+  /**
+   * @generated from enum value: PERMISSION_VIEW_CHANNEL = 64;
+   */
+  VIEW_CHANNEL = 64,
+
+  /**
+   * @generated from enum value: PERMISSION_SEND_MESSAGES = 128;
+   */
+  SEND_MESSAGES = 128,
+
+  /**
+   * @generated from enum value: PERMISSION_MANAGE_INVITES = 256;
+   */
+  MANAGE_INVITES = 256,
+
+  /**
+   * @generated from enum value: PERMISSION_MUTE_MEMBERS = 512;
+   */
+  MUTE_MEMBERS = 512,
+
+  /**
+   * @generated from enum value: PERMISSION_VIEW_AUDIT_LOG = 1024;
+   */
+  VIEW_AUDIT_LOG = 1024,
+
+  /**
+   * @generated from enum value: PERMISSION_MANAGE_COMMUNITY = 2048;
+   */
+  MANAGE_COMMUNITY = 2048,
+
+  /**
+   * @generated from enum value: PERMISSION_MANAGE_EMOJIS = 4096;
+   */
+  MANAGE_EMOJIS = 4096,
+
+  /**
+   * @generated from enum value: PERMISSION_MENTION_EVERYONE = 8192;
+   */
+  MENTION_EVERYONE = 8192,
+}
+
+/**
+ * Describes the enum communityserver.v1.Permission.
+ */
+export declare const PermissionSchema: GenEnum<Permission>;
+
+/**
+ * @generated from enum communityserver.v1.PresenceStatus
+ */
+export enum PresenceStatus {
+  /**
+   * @generated from enum value: PRESENCE_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PRESENCE_STATUS_ONLINE = 1;
+   */
+  ONLINE = 1,
+
+  /**
+   * @generated from enum value: PRESENCE_STATUS_IDLE = 2;
+   */
+  IDLE = 2,
+
+  /**
+   * @generated from enum value: PRESENCE_STATUS_DO_NOT_DISTURB = 3;
+   */
+  DO_NOT_DISTURB = 3,
+
+  /**
+   * @generated from enum value: PRESENCE_STATUS_OFFLINE = 4;
+   */
+  OFFLINE = 4,
+}
+
+/**
+ * Describes the enum communityserver.v1.PresenceStatus.
+ */
+export declare const PresenceStatusSchema: GenEnum<PresenceStatus>;
+
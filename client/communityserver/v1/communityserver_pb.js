@@ -2,13 +2,13 @@
 // @generated from file communityserver/v1/communityserver.proto (package communityserver.v1, syntax proto3)
 /* eslint-disable */
 
-import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
 
 /**
  * Describes the file communityserver/v1/communityserver.proto.
  */
 export const file_communityserver_v1_communityserver = /*@__PURE__*/
-  fileDesc("Cihjb21tdW5pdHlzZXJ2ZXIvdjEvY29tbXVuaXR5c2VydmVyLnByb3RvEhJjb21tdW5pdHlzZXJ2ZXIudjEiGwoZR2V0VXNlckNvbW11bml0aWVzUmVxdWVzdCKSAQoaR2V0VXNlckNvbW11bml0aWVzUmVzcG9uc2USTQoLY29tbXVuaXRpZXMYASADKAsyOC5jb21tdW5pdHlzZXJ2ZXIudjEuR2V0VXNlckNvbW11bml0aWVzUmVzcG9uc2UuQ29tbXVuaXR5GiUKCUNvbW11bml0eRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJIjMKEUpvaW5TZXJ2ZXJSZXF1ZXN0Eh4KFmpvaW5fZGVmYXVsdF9jb21tdW5pdHkYASABKAgiFAoSSm9pblNlcnZlclJlc3BvbnNlQvIBChZjb20uY29tbXVuaXR5c2VydmVyLnYxQhRDb21tdW5pdHlzZXJ2ZXJQcm90b1ABWllnaXRodWIuY29tL3ZhcnNvL3Byb3RjaGF0LXNlcnZlci9pbnRlcm5hbC9tb2RlbHMvZ2VuL2NvbW11bml0eXNlcnZlci92MTtjb21tdW5pdHlzZXJ2ZXJ2MaICA0NYWKoCEkNvbW11bml0eXNlcnZlci5WMcoCEkNvbW11bml0eXNlcnZlclxWMeICHkNvbW11bml0eXNlcnZlclxWMVxHUEJNZXRhZGF0YeoCE0NvbW11bml0eXNlcnZlcjo6VjFiBnByb3RvMw");
+  fileDesc("Cihjb21tdW5pdHlzZXJ2ZXIvdjEvY29tbXVuaXR5c2VydmVyLnByb3RvEhJjb21tdW5pdHlzZXJ2ZXIudjEiGwoZR2V0VXNlckNvbW11bml0aWVzUmVxdWVzdCLhAQoaR2V0VXNlckNvbW11bml0aWVzUmVzcG9uc2USTQoLY29tbXVuaXRpZXMYASADKAsyOC5jb21tdW5pdHlzZXJ2ZXIudjEuR2V0VXNlckNvbW11bml0aWVzUmVzcG9uc2UuQ29tbXVuaXR5GnQKCUNvbW11bml0eRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhAKCGljb25fdXJsGAMgASgJEg4KBm9ubGluZRgEIAEoAxIUCgx1bnJlYWRfY291bnQYBSABKAMSFQoNbWVudGlvbl9jb3VudBgGIAEoAyJIChFKb2luU2VydmVyUmVxdWVzdBIeChZqb2luX2RlZmF1bHRfY29tbXVuaXR5GAEgASgIEhMKC2ludml0ZV9jb2RlGAIgASgJIj4KEkpvaW5TZXJ2ZXJSZXNwb25zZRIUCgxjb21tdW5pdHlfaWQYASABKAkSEgoKY2hhbm5lbF9pZBgCIAEoCSI+CgdDaGFubmVsEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSGQoRc2xvd19tb2RlX3NlY29uZHMYAyABKAUiFAoSR2V0Q2hhbm5lbHNSZXF1ZXN0IkQKE0dldENoYW5uZWxzUmVzcG9uc2USLQoIY2hhbm5lbHMYASADKAsyGy5jb21tdW5pdHlzZXJ2ZXIudjEuQ2hhbm5lbCIkChRDcmVhdGVDaGFubmVsUmVxdWVzdBIMCgRuYW1lGAEgASgJIkUKFUNyZWF0ZUNoYW5uZWxSZXNwb25zZRIsCgdjaGFubmVsGAEgASgLMhsuY29tbXVuaXR5c2VydmVyLnYxLkNoYW5uZWwiJAoUVXBkYXRlQ2hhbm5lbFJlcXVlc3QSDAoEbmFtZRgBIAEoCSJFChVVcGRhdGVDaGFubmVsUmVzcG9uc2USLAoHY2hhbm5lbBgBIAEoCzIbLmNvbW11bml0eXNlcnZlci52MS5DaGFubmVsIhYKFERlbGV0ZUNoYW5uZWxSZXF1ZXN0IhcKFURlbGV0ZUNoYW5uZWxSZXNwb25zZSLJAwoHTWVzc2FnZRIKCgJpZBgBIAEoCRISCgpjaGFubmVsX2lkGAIgASgJEhQKDHVzZXJfYWRkcmVzcxgDIAEoCRIMCgRib2R5GAQgASgJEhIKCmNyZWF0ZWRfYXQYBSABKAkSEgoKdXBkYXRlZF9hdBgGIAEoCRIPCgdkZWxldGVkGAcgASgIEhsKE3JlcGx5X3RvX21lc3NhZ2VfaWQYCCABKAkSNgoIcmVwbHlfdG8YCSABKAsyJC5jb21tdW5pdHlzZXJ2ZXIudjEuTWVzc2FnZVJlZmVyZW5jZRIRCgl0aHJlYWRfaWQYCiABKAkSGgoSdGhyZWFkX3JlcGx5X2NvdW50GAsgASgFEh8KF3RocmVhZF9sYXN0X2FjdGl2aXR5X2F0GAwgASgJEi8KCXJlYWN0aW9ucxgNIAMoCzIcLmNvbW11bml0eXNlcnZlci52MS5SZWFjdGlvbhIzCgthdHRhY2htZW50cxgOIAMoCzIeLmNvbW11bml0eXNlcnZlci52MS5BdHRhY2htZW50EjYKDWxpbmtfcHJldmlld3MYDyADKAsyHy5jb21tdW5pdHlzZXJ2ZXIudjEuTGlua1ByZXZpZXciegoKQXR0YWNobWVudBIKCgJpZBgBIAEoCRIQCghmaWxlbmFtZRgCIAEoCRIUCgxjb250ZW50X3R5cGUYAyABKAkSDAoEc2l6ZRgEIAEoAxINCgV3aWR0aBgFIAEoBRIOCgZoZWlnaHQYBiABKAUSCwoDdXJsGAcgASgJImQKC0xpbmtQcmV2aWV3EgsKA3VybBgBIAEoCRINCgV0aXRsZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIRCglzaXRlX25hbWUYBCABKAkSEQoJaW1hZ2VfdXJsGAUgASgJIk0KCFJlYWN0aW9uEg0KBWVtb2ppGAEgASgJEhcKD2N1c3RvbV9lbW9qaV9pZBgCIAEoCRINCgVjb3VudBgDIAEoAxIKCgJtZRgEIAEoCCJTChBNZXNzYWdlUmVmZXJlbmNlEgoKAmlkGAEgASgJEhQKDHVzZXJfYWRkcmVzcxgCIAEoCRIMCgRib2R5GAMgASgJEg8KB2RlbGV0ZWQYBCABKAgiFAoSR2V0TWVzc2FnZXNSZXF1ZXN0IlYKE0dldE1lc3NhZ2VzUmVzcG9uc2USLQoIbWVzc2FnZXMYASADKAsyGy5jb21tdW5pdHlzZXJ2ZXIudjEuTWVzc2FnZRIQCghoYXNfbW9yZRgCIAEoCCJXChJTZW5kTWVzc2FnZVJlcXVlc3QSDAoEYm9keRgBIAEoCRIbChNyZXBseV90b19tZXNzYWdlX2lkGAIgASgJEhYKDmF0dGFjaG1lbnRfaWRzGAMgAygJIkMKE1NlbmRNZXNzYWdlUmVzcG9uc2USLAoHbWVzc2FnZRgBIAEoCzIbLmNvbW11bml0eXNlcnZlci52MS5NZXNzYWdlIoQGCgVFdmVudBIsCgR0eXBlGAEgASgOMh4uY29tbXVuaXR5c2VydmVyLnYxLkV2ZW50LlR5cGUSFAoMY29tbXVuaXR5X2lkGAIgASgJEg8KB3BheWxvYWQYAyABKAwSEgoKY2hhbm5lbF9pZBgEIAEoCSKRBQoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASGAoUVFlQRV9NRVNTQUdFX0NSRUFURUQQARIWChJUWVBFX01FTUJFUl9KT0lORUQQAhIYChRUWVBFX0NIQU5ORUxfQ1JFQVRFRBADEhgKFFRZUEVfQ0hBTk5FTF9VUERBVEVEEAQSGAoUVFlQRV9DSEFOTkVMX0RFTEVURUQQBRIXChNUWVBFX01FTUJFUl9SRU1PVkVEEAYSFQoRVFlQRV9NRU1CRVJfTVVURUQQBxIaChZUWVBFX0NPTU1VTklUWV9VUERBVEVEEAgSGgoWVFlQRV9DT01NVU5JVFlfREVMRVRFRBAJEhkKFVRZUEVfUFJFU0VOQ0VfVVBEQVRFRBAKEhcKE1RZUEVfVFlQSU5HX1NUQVJURUQQCxIYChRUWVBFX01FU1NBR0VfVVBEQVRFRBAMEhgKFFRZUEVfTUVTU0FHRV9ERUxFVEVEEA0SFwoTVFlQRV9SRUFDVElPTl9BRERFRBAOEhkKFVRZUEVfUkVBQ1RJT05fUkVNT1ZFRBAPEh0KGVRZUEVfQ1VTVE9NX0VNT0pJX0NSRUFURUQQEBIdChlUWVBFX0NVU1RPTV9FTU9KSV9ERUxFVEVEEBESFwoTVFlQRV9NRVNTQUdFX1BJTk5FRBASEhkKFVRZUEVfTUVTU0FHRV9VTlBJTk5FRBATEhUKEVRZUEVfUk9MRV9DUkVBVEVEEBQSFQoRVFlQRV9ST0xFX1VQREFURUQQFRIVChFUWVBFX1JPTEVfREVMRVRFRBAWEhoKFlRZUEVfTUVNQkVSX1JPTEVfQURERUQQFxIcChhUWVBFX01FTUJFUl9ST0xFX1JFTU9WRUQQGCJDChNNZXNzYWdlQ3JlYXRlZEV2ZW50EiwKB21lc3NhZ2UYASABKAsyGy5jb21tdW5pdHlzZXJ2ZXIudjEuTWVzc2FnZSJDChNNZXNzYWdlVXBkYXRlZEV2ZW50EiwKB21lc3NhZ2UYASABKAsyGy5jb21tdW5pdHlzZXJ2ZXIudjEuTWVzc2FnZSI9ChNNZXNzYWdlRGVsZXRlZEV2ZW50EhIKCm1lc3NhZ2VfaWQYASABKAkSEgoKY2hhbm5lbF9pZBgCIAEoCSIpChFNZW1iZXJKb2luZWRFdmVudBIUCgx1c2VyX2FkZHJlc3MYASABKAkiQwoTQ2hhbm5lbENyZWF0ZWRFdmVudBIsCgdjaGFubmVsGAEgASgLMhsuY29tbXVuaXR5c2VydmVyLnYxLkNoYW5uZWwiQwoTQ2hhbm5lbFVwZGF0ZWRFdmVudBIsCgdjaGFubmVsGAEgASgLMhsuY29tbXVuaXR5c2VydmVyLnYxLkNoYW5uZWwiKQoTQ2hhbm5lbERlbGV0ZWRFdmVudBISCgpjaGFubmVsX2lkGAEgASgJIjUKBFJvbGUSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtwZXJtaXNzaW9ucxgDIAEoAyI6ChBSb2xlQ3JlYXRlZEV2ZW50EiYKBHJvbGUYASABKAsyGC5jb21tdW5pdHlzZXJ2ZXIudjEuUm9sZSI6ChBSb2xlVXBkYXRlZEV2ZW50EiYKBHJvbGUYASABKAsyGC5jb21tdW5pdHlzZXJ2ZXIudjEuUm9sZSIjChBSb2xlRGVsZXRlZEV2ZW50Eg8KB3JvbGVfaWQYASABKAkiPQoUTWVtYmVyUm9sZUFkZGVkRXZlbnQSFAoMdXNlcl9hZGRyZXNzGAEgASgJEg8KB3JvbGVfaWQYAiABKAkiPwoWTWVtYmVyUm9sZVJlbW92ZWRFdmVudBIUCgx1c2VyX2FkZHJlc3MYASABKAkSDwoHcm9sZV9pZBgCIAEoCSIRCg9HZXRSb2xlc1JlcXVlc3QiOwoQR2V0Um9sZXNSZXNwb25zZRInCgVyb2xlcxgBIAMoCzIYLmNvbW11bml0eXNlcnZlci52MS5Sb2xlIjYKEUNyZWF0ZVJvbGVSZXF1ZXN0EgwKBG5hbWUYASABKAkSEwoLcGVybWlzc2lvbnMYAiABKAMiPAoSQ3JlYXRlUm9sZVJlc3BvbnNlEiYKBHJvbGUYASABKAsyGC5jb21tdW5pdHlzZXJ2ZXIudjEuUm9sZSI2ChFVcGRhdGVSb2xlUmVxdWVzdBIMCgRuYW1lGAEgASgJEhMKC3Blcm1pc3Npb25zGAIgASgDIjwKElVwZGF0ZVJvbGVSZXNwb25zZRImCgRyb2xlGAEgASgLMhguY29tbXVuaXR5c2VydmVyLnYxLlJvbGUiEwoRRGVsZXRlUm9sZVJlcXVlc3QiFAoSRGVsZXRlUm9sZVJlc3BvbnNlIhMKEUFzc2lnblJvbGVSZXF1ZXN0IhQKEkFzc2lnblJvbGVSZXNwb25zZSIVChNVbmFzc2lnblJvbGVSZXF1ZXN0IhYKFFVuYXNzaWduUm9sZVJlc3BvbnNlIoECChNQZXJtaXNzaW9uT3ZlcndyaXRlEkcKC3RhcmdldF90eXBlGAEgASgOMjIuY29tbXVuaXR5c2VydmVyLnYxLlBlcm1pc3Npb25PdmVyd3JpdGUuVGFyZ2V0VHlwZRIRCgl0YXJnZXRfaWQYAiABKAkSDQoFYWxsb3cYAyABKAMSDAoEZGVueRgEIAEoAyJxCgpUYXJnZXRUeXBlEhsKF1RBUkdFVF9UWVBFX1VOU1BFQ0lGSUVEEAASGAoUVEFSR0VUX1RZUEVfRVZFUllPTkUQARIUChBUQVJHRVRfVFlQRV9ST0xFEAISFgoSVEFSR0VUX1RZUEVfTUVNQkVSEAMiHQobR2V0Q2hhbm5lbE92ZXJ3cml0ZXNSZXF1ZXN0IlsKHEdldENoYW5uZWxPdmVyd3JpdGVzUmVzcG9uc2USOwoKb3ZlcndyaXRlcxgBIAMoCzInLmNvbW11bml0eXNlcnZlci52MS5QZXJtaXNzaW9uT3ZlcndyaXRlIlgKGlNldENoYW5uZWxPdmVyd3JpdGVSZXF1ZXN0EjoKCW92ZXJ3cml0ZRgBIAEoCzInLmNvbW11bml0eXNlcnZlci52MS5QZXJtaXNzaW9uT3ZlcndyaXRlIh0KG1NldENoYW5uZWxPdmVyd3JpdGVSZXNwb25zZSKmAQoGSW52aXRlEgwKBGNvZGUYASABKAkSFAoMY29tbXVuaXR5X2lkGAIgASgJEhIKCmNoYW5uZWxfaWQYAyABKAkSHAoUY3JlYXRvcl91c2VyX2FkZHJlc3MYBCABKAkSEAoIbWF4X3VzZXMYBSABKAUSDAoEdXNlcxgGIAEoBRISCgpleHBpcmVzX2F0GAcgASgJEhIKCmNyZWF0ZWRfYXQYCCABKAkiEwoRR2V0SW52aXRlc1JlcXVlc3QiQQoSR2V0SW52aXRlc1Jlc3BvbnNlEisKB2ludml0ZXMYASADKAsyGi5jb21tdW5pdHlzZXJ2ZXIudjEuSW52aXRlIlQKE0NyZWF0ZUludml0ZVJlcXVlc3QSEgoKY2hhbm5lbF9pZBgBIAEoCRIQCghtYXhfdXNlcxgCIAEoBRIXCg9tYXhfYWdlX3NlY29uZHMYAyABKAMiQgoUQ3JlYXRlSW52aXRlUmVzcG9uc2USKgoGaW52aXRlGAEgASgLMhouY29tbXVuaXR5c2VydmVyLnYxLkludml0ZSIVChNSZXZva2VJbnZpdGVSZXF1ZXN0IhYKFFJldm9rZUludml0ZVJlc3BvbnNlIhYKFFJlc29sdmVJbnZpdGVSZXF1ZXN0IvYBChVSZXNvbHZlSW52aXRlUmVzcG9uc2USKgoGaW52aXRlGAEgASgLMhouY29tbXVuaXR5c2VydmVyLnYxLkludml0ZRJGCgljb21tdW5pdHkYAiABKAsyMy5jb21tdW5pdHlzZXJ2ZXIudjEuUmVzb2x2ZUludml0ZVJlc3BvbnNlLkNvbW11bml0eRIsCgdjaGFubmVsGAMgASgLMhsuY29tbXVuaXR5c2VydmVyLnYxLkNoYW5uZWwaOwoJQ29tbXVuaXR5EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSFAoMbWVtYmVyX2NvdW50GAMgASgDIioKEk1lbWJlclJlbW92ZWRFdmVudBIUCgx1c2VyX2FkZHJlc3MYASABKAkiPQoQTWVtYmVyTXV0ZWRFdmVudBIUCgx1c2VyX2FkZHJlc3MYASABKAkSEwoLbXV0ZWRfdW50aWwYAiABKAkiEwoRS2lja01lbWJlclJlcXVlc3QiFAoSS2lja01lbWJlclJlc3BvbnNlIi0KEU11dGVNZW1iZXJSZXF1ZXN0EhgKEGR1cmF0aW9uX3NlY29uZHMYASABKAMiKQoSTXV0ZU1lbWJlclJlc3BvbnNlEhMKC211dGVkX3VudGlsGAEgASgJIhUKE1VubXV0ZU1lbWJlclJlcXVlc3QiFgoUVW5tdXRlTWVtYmVyUmVzcG9uc2UigAEKA0JhbhIKCgJpZBgBIAEoCRIUCgx1c2VyX2FkZHJlc3MYAiABKAkSDAoEaG9zdBgDIAEoCRIOCgZyZWFzb24YBCABKAkSEQoJYmFubmVkX2J5GAUgASgJEhIKCmV4cGlyZXNfYXQYBiABKAkSEgoKY3JlYXRlZF9hdBgHIAEoCSIQCg5HZXRCYW5zUmVxdWVzdCI4Cg9HZXRCYW5zUmVzcG9uc2USJQoEYmFucxgBIAMoCzIXLmNvbW11bml0eXNlcnZlci52MS5CYW4iYAoQQ3JlYXRlQmFuUmVxdWVzdBIUCgx1c2VyX2FkZHJlc3MYASABKAkSDAoEaG9zdBgCIAEoCRIOCgZyZWFzb24YAyABKAkSGAoQZHVyYXRpb25fc2Vjb25kcxgEIAEoAyI5ChFDcmVhdGVCYW5SZXNwb25zZRIkCgNiYW4YASABKAsyFy5jb21tdW5pdHlzZXJ2ZXIudjEuQmFuIhIKEERlbGV0ZUJhblJlcXVlc3QiEwoRRGVsZXRlQmFuUmVzcG9uc2UijQcKDUF1ZGl0TG9nRW50cnkSCgoCaWQYASABKAkSGgoSYWN0b3JfdXNlcl9hZGRyZXNzGAIgASgJEjgKBmFjdGlvbhgDIAEoDjIoLmNvbW11bml0eXNlcnZlci52MS5BdWRpdExvZ0VudHJ5LkFjdGlvbhIRCgl0YXJnZXRfaWQYBCABKAkSDgoGcmVhc29uGAUgASgJEg4KBmJlZm9yZRgGIAEoCRINCgVhZnRlchgHIAEoCRISCgpjcmVhdGVkX2F0GAggASgJIsMFCgZBY3Rpb24SFgoSQUNUSU9OX1VOU1BFQ0lGSUVEEAASGQoVQUNUSU9OX0NIQU5ORUxfQ1JFQVRFEAESGQoVQUNUSU9OX0NIQU5ORUxfVVBEQVRFEAISGQoVQUNUSU9OX0NIQU5ORUxfREVMRVRFEAMSIwofQUNUSU9OX0NIQU5ORUxfT1ZFUldSSVRFX1VQREFURRAEEhYKEkFDVElPTl9ST0xFX0NSRUFURRAFEhYKEkFDVElPTl9ST0xFX1VQREFURRAGEhYKEkFDVElPTl9ST0xFX0RFTEVURRAHEhoKFkFDVElPTl9NRU1CRVJfUk9MRV9BREQQCBIdChlBQ1RJT05fTUVNQkVSX1JPTEVfUkVNT1ZFEAkSFgoSQUNUSU9OX01FTUJFUl9LSUNLEAoSFgoSQUNUSU9OX01FTUJFUl9NVVRFEAsSGAoUQUNUSU9OX01FTUJFUl9VTk1VVEUQDBIVChFBQ1RJT05fQkFOX0NSRUFURRANEhUKEUFDVElPTl9CQU5fREVMRVRFEA4SGAoUQUNUSU9OX0lOVklURV9SRVZPS0UQDxIbChdBQ1RJT05fQ09NTVVOSVRZX1VQREFURRAQEhkKFUFDVElPTl9NRVNTQUdFX0RFTEVURRAREh4KGkFDVElPTl9DVVNUT01fRU1PSklfQ1JFQVRFEBISHgoaQUNUSU9OX0NVU1RPTV9FTU9KSV9ERUxFVEUQExIWChJBQ1RJT05fTUVTU0FHRV9QSU4QFBIYChRBQ1RJT05fTUVTU0FHRV9VTlBJThAVEiYKIkFDVElPTl9SQVRFX0xJTUlUX0VYRU1QVElPTl9DUkVBVEUQFhImCiJBQ1RJT05fUkFURV9MSU1JVF9FWEVNUFRJT05fREVMRVRFEBcSHAoYQUNUSU9OX0JBTl9NRU1CRVJfUkVNT1ZFEBgiFAoSR2V0QXVkaXRMb2dSZXF1ZXN0IlsKE0dldEF1ZGl0TG9nUmVzcG9uc2USMgoHZW50cmllcxgBIAMoCzIhLmNvbW11bml0eXNlcnZlci52MS5BdWRpdExvZ0VudHJ5EhAKCGhhc19tb3JlGAIgASgIIksKCUNvbW11bml0eRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhAKCGljb25fdXJsGAMgASgJEhIKCmlzX2RlZmF1bHQYBCABKAgiFQoTR2V0Q29tbXVuaXR5UmVxdWVzdCJIChRHZXRDb21tdW5pdHlSZXNwb25zZRIwCgljb21tdW5pdHkYASABKAsyHS5jb21tdW5pdHlzZXJ2ZXIudjEuQ29tbXVuaXR5IjgKFkNyZWF0ZUNvbW11bml0eVJlcXVlc3QSDAoEbmFtZRgBIAEoCRIQCghpY29uX3VybBgCIAEoCSJLChdDcmVhdGVDb21tdW5pdHlSZXNwb25zZRIwCgljb21tdW5pdHkYASABKAsyHS5jb21tdW5pdHlzZXJ2ZXIudjEuQ29tbXVuaXR5IjgKFlVwZGF0ZUNvbW11bml0eVJlcXVlc3QSDAoEbmFtZRgBIAEoCRIQCghpY29uX3VybBgCIAEoCSJLChdVcGRhdGVDb21tdW5pdHlSZXNwb25zZRIwCgljb21tdW5pdHkYASABKAsyHS5jb21tdW5pdHlzZXJ2ZXIudjEuQ29tbXVuaXR5IhgKFkRlbGV0ZUNvbW11bml0eVJlcXVlc3QiGQoXRGVsZXRlQ29tbXVuaXR5UmVzcG9uc2UiSQoVQ29tbXVuaXR5VXBkYXRlZEV2ZW50EjAKCWNvbW11bml0eRgBIAEoCzIdLmNvbW11bml0eXNlcnZlci52MS5Db21tdW5pdHkiLQoVQ29tbXVuaXR5RGVsZXRlZEV2ZW50EhQKDGNvbW11bml0eV9pZBgBIAEoCSIXChVMZWF2ZUNvbW11bml0eVJlcXVlc3QiGAoWTGVhdmVDb21tdW5pdHlSZXNwb25zZSIUChJMZWF2ZVNlcnZlclJlcXVlc3QiFQoTTGVhdmVTZXJ2ZXJSZXNwb25zZSJrCghQcmVzZW5jZRIUCgx1c2VyX2FkZHJlc3MYASABKAkSMgoGc3RhdHVzGAIgASgOMiIuY29tbXVuaXR5c2VydmVyLnYxLlByZXNlbmNlU3RhdHVzEhUKDWN1c3RvbV9zdGF0dXMYAyABKAkiRgoUUHJlc2VuY2VVcGRhdGVkRXZlbnQSLgoIcHJlc2VuY2UYASABKAsyHC5jb21tdW5pdHlzZXJ2ZXIudjEuUHJlc2VuY2UiFQoTR2V0UHJlc2VuY2VzUmVxdWVzdCJHChRHZXRQcmVzZW5jZXNSZXNwb25zZRIvCglwcmVzZW5jZXMYASADKAsyHC5jb21tdW5pdHlzZXJ2ZXIudjEuUHJlc2VuY2UipwEKDkdhdGV3YXlDb21tYW5kEjUKBHR5cGUYASABKA4yJy5jb21tdW5pdHlzZXJ2ZXIudjEuR2F0ZXdheUNvbW1hbmQuVHlwZRIPCgdwYXlsb2FkGAIgASgMIk0KBFR5cGUSFAoQVFlQRV9VTlNQRUNJRklFRBAAEhgKFFRZUEVfVVBEQVRFX1BSRVNFTkNFEAESFQoRVFlQRV9TVEFSVF9UWVBJTkcQAiJiChVVcGRhdGVQcmVzZW5jZUNvbW1hbmQSMgoGc3RhdHVzGAEgASgOMiIuY29tbXVuaXR5c2VydmVyLnYxLlByZXNlbmNlU3RhdHVzEhUKDWN1c3RvbV9zdGF0dXMYAiABKAkiPgoSU3RhcnRUeXBpbmdDb21tYW5kEhQKDGNvbW11bml0eV9pZBgBIAEoCRISCgpjaGFubmVsX2lkGAIgASgJIlIKElR5cGluZ1N0YXJ0ZWRFdmVudBISCgpjaGFubmVsX2lkGAEgASgJEhQKDHVzZXJfYWRkcmVzcxgCIAEoCRISCgpleHBpcmVzX2F0GAMgASgJImoKCVJlYWRTdGF0ZRISCgpjaGFubmVsX2lkGAEgASgJEhwKFGxhc3RfcmVhZF9tZXNzYWdlX2lkGAIgASgJEhQKDHVucmVhZF9jb3VudBgDIAEoAxIVCg1tZW50aW9uX2NvdW50GAQgASgDIhYKFEdldFJlYWRTdGF0ZXNSZXF1ZXN0IksKFUdldFJlYWRTdGF0ZXNSZXNwb25zZRIyCgtyZWFkX3N0YXRlcxgBIAMoCzIdLmNvbW11bml0eXNlcnZlci52MS5SZWFkU3RhdGUiJwoRQWNrQ2hhbm5lbFJlcXVlc3QSEgoKbWVzc2FnZV9pZBgBIAEoCSJHChJBY2tDaGFubmVsUmVzcG9uc2USMQoKcmVhZF9zdGF0ZRgBIAEoCzIdLmNvbW11bml0eXNlcnZlci52MS5SZWFkU3RhdGUiJAoUVXBkYXRlTWVzc2FnZVJlcXVlc3QSDAoEYm9keRgBIAEoCSJFChVVcGRhdGVNZXNzYWdlUmVzcG9uc2USLAoHbWVzc2FnZRgBIAEoCzIbLmNvbW11bml0eXNlcnZlci52MS5NZXNzYWdlIhYKFERlbGV0ZU1lc3NhZ2VSZXF1ZXN0IhcKFURlbGV0ZU1lc3NhZ2VSZXNwb25zZSI/Cg9NZXNzYWdlUmV2aXNpb24SCgoCaWQYASABKAkSDAoEYm9keRgCIAEoCRISCgpjcmVhdGVkX2F0GAMgASgJIhwKGkdldE1lc3NhZ2VSZXZpc2lvbnNSZXF1ZXN0IlUKG0dldE1lc3NhZ2VSZXZpc2lvbnNSZXNwb25zZRI2CglyZXZpc2lvbnMYASADKAsyIy5jb21tdW5pdHlzZXJ2ZXIudjEuTWVzc2FnZVJldmlzaW9uImIKC0N1c3RvbUVtb2ppEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEQoJaW1hZ2VfdXJsGAMgASgJEhIKCmNyZWF0ZWRfYnkYBCABKAkSEgoKY3JlYXRlZF9hdBgFIAEoCSIYChZHZXRDdXN0b21FbW9qaXNSZXF1ZXN0IkoKF0dldEN1c3RvbUVtb2ppc1Jlc3BvbnNlEi8KBmVtb2ppcxgBIAMoCzIfLmNvbW11bml0eXNlcnZlci52MS5DdXN0b21FbW9qaSJLChlDcmVhdGVDdXN0b21FbW9qaVJlc3BvbnNlEi4KBWVtb2ppGAEgASgLMh8uY29tbXVuaXR5c2VydmVyLnYxLkN1c3RvbUVtb2ppIhoKGERlbGV0ZUN1c3RvbUVtb2ppUmVxdWVzdCIbChlEZWxldGVDdXN0b21FbW9qaVJlc3BvbnNlIkkKF0N1c3RvbUVtb2ppQ3JlYXRlZEV2ZW50Ei4KBWVtb2ppGAEgASgLMh8uY29tbXVuaXR5c2VydmVyLnYxLkN1c3RvbUVtb2ppIisKF0N1c3RvbUVtb2ppRGVsZXRlZEV2ZW50EhAKCGVtb2ppX2lkGAEgASgJIhQKEkFkZFJlYWN0aW9uUmVxdWVzdCIVChNBZGRSZWFjdGlvblJlc3BvbnNlIhcKFVJlbW92ZVJlYWN0aW9uUmVxdWVzdCIYChZSZW1vdmVSZWFjdGlvblJlc3BvbnNlInoKElJlYWN0aW9uQWRkZWRFdmVudBISCgptZXNzYWdlX2lkGAEgASgJEhIKCmNoYW5uZWxfaWQYAiABKAkSFAoMdXNlcl9hZGRyZXNzGAMgASgJEg0KBWVtb2ppGAQgASgJEhcKD2N1c3RvbV9lbW9qaV9pZBgFIAEoCSJ8ChRSZWFjdGlvblJlbW92ZWRFdmVudBISCgptZXNzYWdlX2lkGAEgASgJEhIKCmNoYW5uZWxfaWQYAiABKAkSFAoMdXNlcl9hZGRyZXNzGAMgASgJEg0KBWVtb2ppGAQgASgJEhcKD2N1c3RvbV9lbW9qaV9pZBgFIAEoCSJZChZTZWFyY2hNZXNzYWdlc1Jlc3BvbnNlEi0KCG1lc3NhZ2VzGAEgAygLMhsuY29tbXVuaXR5c2VydmVyLnYxLk1lc3NhZ2USEAoIaGFzX21vcmUYAiABKAgiTgoYVXBsb2FkQXR0YWNobWVudFJlc3BvbnNlEjIKCmF0dGFjaG1lbnQYASABKAsyHi5jb21tdW5pdHlzZXJ2ZXIudjEuQXR0YWNobWVudCJjCg1QaW5uZWRNZXNzYWdlEiwKB21lc3NhZ2UYASABKAsyGy5jb21tdW5pdHlzZXJ2ZXIudjEuTWVzc2FnZRIRCglwaW5uZWRfYnkYAiABKAkSEQoJcGlubmVkX2F0GAMgASgJIhoKGEdldFBpbm5lZE1lc3NhZ2VzUmVxdWVzdCJMChlHZXRQaW5uZWRNZXNzYWdlc1Jlc3BvbnNlEi8KBHBpbnMYASADKAsyIS5jb21tdW5pdHlzZXJ2ZXIudjEuUGlubmVkTWVzc2FnZSITChFQaW5NZXNzYWdlUmVxdWVzdCJEChJQaW5NZXNzYWdlUmVzcG9uc2USLgoDcGluGAEgASgLMiEuY29tbXVuaXR5c2VydmVyLnYxLlBpbm5lZE1lc3NhZ2UiFQoTVW5waW5NZXNzYWdlUmVxdWVzdCIWChRVbnBpbk1lc3NhZ2VSZXNwb25zZSJEChJNZXNzYWdlUGlubmVkRXZlbnQSLgoDcGluGAEgASgLMiEuY29tbXVuaXR5c2VydmVyLnYxLlBpbm5lZE1lc3NhZ2UiPgoUTWVzc2FnZVVucGlubmVkRXZlbnQSEgoKbWVzc2FnZV9pZBgBIAEoCRISCgpjaGFubmVsX2lkGAIgASgJIjIKFVVwZGF0ZVNsb3dNb2RlUmVxdWVzdBIZChFzbG93X21vZGVfc2Vjb25kcxgBIAEoBSJGChZVcGRhdGVTbG93TW9kZVJlc3BvbnNlEiwKB2NoYW5uZWwYASABKAsyGy5jb21tdW5pdHlzZXJ2ZXIudjEuQ2hhbm5lbCKrAQoQUmF0ZUxpbWl0ZWRFcnJvchI5CgVzY29wZRgBIAEoDjIqLmNvbW11bml0eXNlcnZlci52MS5SYXRlTGltaXRlZEVycm9yLlNjb3BlEhYKDnJldHJ5X2FmdGVyX21zGAIgASgDIkQKBVNjb3BlEhUKEVNDT1BFX1VOU1BFQ0lGSUVEEAASEwoPU0NPUEVfU0xPV19NT0RFEAESDwoLU0NPUEVfQlVSU1QQAiJSChJSYXRlTGltaXRFeGVtcHRpb24SFAoMdXNlcl9hZGRyZXNzGAEgASgJEhIKCmNyZWF0ZWRfYnkYAiABKAkSEgoKY3JlYXRlZF9hdBgDIAEoCSIfCh1HZXRSYXRlTGltaXRFeGVtcHRpb25zUmVxdWVzdCJcCh5HZXRSYXRlTGltaXRFeGVtcHRpb25zUmVzcG9uc2USOgoKZXhlbXB0aW9ucxgBIAMoCzImLmNvbW11bml0eXNlcnZlci52MS5SYXRlTGltaXRFeGVtcHRpb24iHgocQWRkUmF0ZUxpbWl0RXhlbXB0aW9uUmVxdWVzdCIfCh1BZGRSYXRlTGltaXRFeGVtcHRpb25SZXNwb25zZSIhCh9SZW1vdmVSYXRlTGltaXRFeGVtcHRpb25SZXF1ZXN0IiIKIFJlbW92ZVJhdGVMaW1pdEV4ZW1wdGlvblJlc3BvbnNlInoKDENvbnZlcnNhdGlvbhIKCgJpZBgBIAEoCRIdChVwYXJ0aWNpcGFudF9hZGRyZXNzZXMYAiADKAkSEgoKY3JlYXRlZF9ieRgDIAEoCRISCgpjcmVhdGVkX2F0GAQgASgJEhcKD2xhc3RfbWVzc2FnZV9hdBgFIAEoCSJyChNDb252ZXJzYXRpb25NZXNzYWdlEgoKAmlkGAEgASgJEhcKD2NvbnZlcnNhdGlvbl9pZBgCIAEoCRIUCgx1c2VyX2FkZHJlc3MYAyABKAkSDAoEYm9keRgEIAEoCRISCgpjcmVhdGVkX2F0GAUgASgJIjoKGUNyZWF0ZUNvbnZlcnNhdGlvblJlcXVlc3QSHQoVcGFydGljaXBhbnRfYWRkcmVzc2VzGAEgAygJIlQKGkNyZWF0ZUNvbnZlcnNhdGlvblJlc3BvbnNlEjYKDGNvbnZlcnNhdGlvbhgBIAEoCzIgLmNvbW11bml0eXNlcnZlci52MS5Db252ZXJzYXRpb24iGQoXR2V0Q29udmVyc2F0aW9uc1JlcXVlc3QiUwoYR2V0Q29udmVyc2F0aW9uc1Jlc3BvbnNlEjcKDWNvbnZlcnNhdGlvbnMYASADKAsyIC5jb21tdW5pdHlzZXJ2ZXIudjEuQ29udmVyc2F0aW9uIiAKHkdldENvbnZlcnNhdGlvbk1lc3NhZ2VzUmVxdWVzdCJuCh9HZXRDb252ZXJzYXRpb25NZXNzYWdlc1Jlc3BvbnNlEjkKCG1lc3NhZ2VzGAEgAygLMicuY29tbXVuaXR5c2VydmVyLnYxLkNvbnZlcnNhdGlvbk1lc3NhZ2USEAoIaGFzX21vcmUYAiABKAgiLgoeU2VuZENvbnZlcnNhdGlvbk1lc3NhZ2VSZXF1ZXN0EgwKBGJvZHkYASABKAkiWwofU2VuZENvbnZlcnNhdGlvbk1lc3NhZ2VSZXNwb25zZRI4CgdtZXNzYWdlGAEgASgLMicuY29tbXVuaXR5c2VydmVyLnYxLkNvbnZlcnNhdGlvbk1lc3NhZ2Uq2QMKClBlcm1pc3Npb24SGgoWUEVSTUlTU0lPTl9VTlNQRUNJRklFRBAAEh4KGlBFUk1JU1NJT05fTUFOQUdFX0NIQU5ORUxTEAESHgoaUEVSTUlTU0lPTl9NQU5BR0VfTUVTU0FHRVMQAhIbChdQRVJNSVNTSU9OX0tJQ0tfTUVNQkVSUxAEEhoKFlBFUk1JU1NJT05fQkFOX01FTUJFUlMQCBIbChdQRVJNSVNTSU9OX01BTkFHRV9ST0xFUxAQEhwKGFBFUk1JU1NJT05fQURNSU5JU1RSQVRPUhAgEhsKF1BFUk1JU1NJT05fVklFV19DSEFOTkVMEEASHQoYUEVSTUlTU0lPTl9TRU5EX01FU1NBR0VTEIABEh4KGVBFUk1JU1NJT05fTUFOQUdFX0lOVklURVMQgAISHAoXUEVSTUlTU0lPTl9NVVRFX01FTUJFUlMQgAQSHgoZUEVSTUlTU0lPTl9WSUVXX0FVRElUX0xPRxCACBIgChtQRVJNSVNTSU9OX01BTkFHRV9DT01NVU5JVFkQgBASHQoYUEVSTUlTU0lPTl9NQU5BR0VfRU1PSklTEIAgEiAKG1BFUk1JU1NJT05fTUVOVElPTl9FVkVSWU9ORRCAQCqoAQoOUHJlc2VuY2VTdGF0dXMSHwobUFJFU0VOQ0VfU1RBVFVTX1VOU1BFQ0lGSUVEEAASGgoWUFJFU0VOQ0VfU1RBVFVTX09OTElORRABEhgKFFBSRVNFTkNFX1NUQVRVU19JRExFEAISIgoeUFJFU0VOQ0VfU1RBVFVTX0RPX05PVF9ESVNUVVJCEAMSGwoXUFJFU0VOQ0VfU1RBVFVTX09GRkxJTkUQBELyAQoWY29tLmNvbW11bml0eXNlcnZlci52MUIUQ29tbXVuaXR5c2VydmVyUHJvdG9QAVpZZ2l0aHViLmNvbS92YXJzby9wcm90Y2hhdC1zZXJ2ZXIvaW50ZXJuYWwvbW9kZWxzL2dlbi9jb21tdW5pdHlzZXJ2ZXIvdjE7Y29tbXVuaXR5c2VydmVydjGiAgNDWFiqAhJDb21tdW5pdHlzZXJ2ZXIuVjHKAhJDb21tdW5pdHlzZXJ2ZXJcVjHiAh5Db21tdW5pdHlzZXJ2ZXJcVjFcR1BCTWV0YWRhdGHqAhNDb21tdW5pdHlzZXJ2ZXI6OlYxYgZwcm90bzM");
 
 /**
  * Describes the message communityserver.v1.GetUserCommunitiesRequest.
@@ -45,3 +45,1186 @@ export const JoinServerRequestSchema = /*@__PURE__*/
 export const JoinServerResponseSchema = /*@__PURE__*/
   messageDesc(file_communityserver_v1_communityserver, 3);
 
+/**
+ * Describes the message communityserver.v1.Channel.
+ * Use `create(ChannelSchema)` to create a new message.
+ */
+export const ChannelSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 4);
+
+/**
+ * Describes the message communityserver.v1.GetChannelsRequest.
+ * Use `create(GetChannelsRequestSchema)` to create a new message.
+ */
+export const GetChannelsRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 5);
+
+/**
+ * Describes the message communityserver.v1.GetChannelsResponse.
+ * Use `create(GetChannelsResponseSchema)` to create a new message.
+ */
+export const GetChannelsResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 6);
+
+/**
+ * Describes the message communityserver.v1.CreateChannelRequest.
+ * Use `create(CreateChannelRequestSchema)` to create a new message.
+ */
+export const CreateChannelRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 7);
+
+/**
+ * Describes the message communityserver.v1.CreateChannelResponse.
+ * Use `create(CreateChannelResponseSchema)` to create a new message.
+ */
+export const CreateChannelResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 8);
+
+/**
+ * Describes the message communityserver.v1.UpdateChannelRequest.
+ * Use `create(UpdateChannelRequestSchema)` to create a new message.
+ */
+export const UpdateChannelRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 9);
+
+/**
+ * Describes the message communityserver.v1.UpdateChannelResponse.
+ * Use `create(UpdateChannelResponseSchema)` to create a new message.
+ */
+export const UpdateChannelResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 10);
+
+/**
+ * Describes the message communityserver.v1.DeleteChannelRequest.
+ * Use `create(DeleteChannelRequestSchema)` to create a new message.
+ */
+export const DeleteChannelRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 11);
+
+/**
+ * Describes the message communityserver.v1.DeleteChannelResponse.
+ * Use `create(DeleteChannelResponseSchema)` to create a new message.
+ */
+export const DeleteChannelResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 12);
+
+/**
+ * Describes the message communityserver.v1.Message.
+ * Use `create(MessageSchema)` to create a new message.
+ */
+export const MessageSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 13);
+
+/**
+ * Describes the message communityserver.v1.Attachment.
+ * Use `create(AttachmentSchema)` to create a new message.
+ */
+export const AttachmentSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 14);
+
+/**
+ * Describes the message communityserver.v1.LinkPreview.
+ * Use `create(LinkPreviewSchema)` to create a new message.
+ */
+export const LinkPreviewSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 15);
+
+/**
+ * Describes the message communityserver.v1.Reaction.
+ * Use `create(ReactionSchema)` to create a new message.
+ */
+export const ReactionSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 16);
+
+/**
+ * Describes the message communityserver.v1.MessageReference.
+ * Use `create(MessageReferenceSchema)` to create a new message.
+ */
+export const MessageReferenceSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 17);
+
+/**
+ * Describes the message communityserver.v1.GetMessagesRequest.
+ * Use `create(GetMessagesRequestSchema)` to create a new message.
+ */
+export const GetMessagesRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 18);
+
+/**
+ * Describes the message communityserver.v1.GetMessagesResponse.
+ * Use `create(GetMessagesResponseSchema)` to create a new message.
+ */
+export const GetMessagesResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 19);
+
+/**
+ * Describes the message communityserver.v1.SendMessageRequest.
+ * Use `create(SendMessageRequestSchema)` to create a new message.
+ */
+export const SendMessageRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 20);
+
+/**
+ * Describes the message communityserver.v1.SendMessageResponse.
+ * Use `create(SendMessageResponseSchema)` to create a new message.
+ */
+export const SendMessageResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 21);
+
+/**
+ * Describes the message communityserver.v1.Event.
+ * Use `create(EventSchema)` to create a new message.
+ */
+export const EventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 22);
+
+/**
+ * Describes the enum communityserver.v1.Event.Type.
+ */
+export const Event_TypeSchema = /*@__PURE__*/
+  enumDesc(file_communityserver_v1_communityserver, 22, 0);
+
+/**
+ * @generated from enum communityserver.v1.Event.Type
+ */
+export const Event_Type = /*@__PURE__*/
+  tsEnum(Event_TypeSchema);
+
+/**
+ * Describes the message communityserver.v1.MessageCreatedEvent.
+ * Use `create(MessageCreatedEventSchema)` to create a new message.
+ */
+export const MessageCreatedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 23);
+
+/**
+ * Describes the message communityserver.v1.MessageUpdatedEvent.
+ * Use `create(MessageUpdatedEventSchema)` to create a new message.
+ */
+export const MessageUpdatedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 24);
+
+/**
+ * Describes the message communityserver.v1.MessageDeletedEvent.
+ * Use `create(MessageDeletedEventSchema)` to create a new message.
+ */
+export const MessageDeletedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 25);
+
+/**
+ * Describes the message communityserver.v1.MemberJoinedEvent.
+ * Use `create(MemberJoinedEventSchema)` to create a new message.
+ */
+export const MemberJoinedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 26);
+
+/**
+ * Describes the message communityserver.v1.ChannelCreatedEvent.
+ * Use `create(ChannelCreatedEventSchema)` to create a new message.
+ */
+export const ChannelCreatedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 27);
+
+/**
+ * Describes the message communityserver.v1.ChannelUpdatedEvent.
+ * Use `create(ChannelUpdatedEventSchema)` to create a new message.
+ */
+export const ChannelUpdatedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 28);
+
+/**
+ * Describes the message communityserver.v1.ChannelDeletedEvent.
+ * Use `create(ChannelDeletedEventSchema)` to create a new message.
+ */
+export const ChannelDeletedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 29);
+
+/**
+ * Describes the message communityserver.v1.Role.
+ * Use `create(RoleSchema)` to create a new message.
+ */
+export const RoleSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 30);
+
+/**
+ * Describes the message communityserver.v1.RoleCreatedEvent.
+ * Use `create(RoleCreatedEventSchema)` to create a new message.
+ */
+export const RoleCreatedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 31);
+
+/**
+ * Describes the message communityserver.v1.RoleUpdatedEvent.
+ * Use `create(RoleUpdatedEventSchema)` to create a new message.
+ */
+export const RoleUpdatedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 32);
+
+/**
+ * Describes the message communityserver.v1.RoleDeletedEvent.
+ * Use `create(RoleDeletedEventSchema)` to create a new message.
+ */
+export const RoleDeletedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 33);
+
+/**
+ * Describes the message communityserver.v1.MemberRoleAddedEvent.
+ * Use `create(MemberRoleAddedEventSchema)` to create a new message.
+ */
+export const MemberRoleAddedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 34);
+
+/**
+ * Describes the message communityserver.v1.MemberRoleRemovedEvent.
+ * Use `create(MemberRoleRemovedEventSchema)` to create a new message.
+ */
+export const MemberRoleRemovedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 35);
+
+/**
+ * Describes the message communityserver.v1.GetRolesRequest.
+ * Use `create(GetRolesRequestSchema)` to create a new message.
+ */
+export const GetRolesRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 36);
+
+/**
+ * Describes the message communityserver.v1.GetRolesResponse.
+ * Use `create(GetRolesResponseSchema)` to create a new message.
+ */
+export const GetRolesResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 37);
+
+/**
+ * Describes the message communityserver.v1.CreateRoleRequest.
+ * Use `create(CreateRoleRequestSchema)` to create a new message.
+ */
+export const CreateRoleRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 38);
+
+/**
+ * Describes the message communityserver.v1.CreateRoleResponse.
+ * Use `create(CreateRoleResponseSchema)` to create a new message.
+ */
+export const CreateRoleResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 39);
+
+/**
+ * Describes the message communityserver.v1.UpdateRoleRequest.
+ * Use `create(UpdateRoleRequestSchema)` to create a new message.
+ */
+export const UpdateRoleRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 40);
+
+/**
+ * Describes the message communityserver.v1.UpdateRoleResponse.
+ * Use `create(UpdateRoleResponseSchema)` to create a new message.
+ */
+export const UpdateRoleResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 41);
+
+/**
+ * Describes the message communityserver.v1.DeleteRoleRequest.
+ * Use `create(DeleteRoleRequestSchema)` to create a new message.
+ */
+export const DeleteRoleRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 42);
+
+/**
+ * Describes the message communityserver.v1.DeleteRoleResponse.
+ * Use `create(DeleteRoleResponseSchema)` to create a new message.
+ */
+export const DeleteRoleResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 43);
+
+/**
+ * Describes the message communityserver.v1.AssignRoleRequest.
+ * Use `create(AssignRoleRequestSchema)` to create a new message.
+ */
+export const AssignRoleRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 44);
+
+/**
+ * Describes the message communityserver.v1.AssignRoleResponse.
+ * Use `create(AssignRoleResponseSchema)` to create a new message.
+ */
+export const AssignRoleResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 45);
+
+/**
+ * Describes the message communityserver.v1.UnassignRoleRequest.
+ * Use `create(UnassignRoleRequestSchema)` to create a new message.
+ */
+export const UnassignRoleRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 46);
+
+/**
+ * Describes the message communityserver.v1.UnassignRoleResponse.
+ * Use `create(UnassignRoleResponseSchema)` to create a new message.
+ */
+export const UnassignRoleResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 47);
+
+/**
+ * Describes the message communityserver.v1.PermissionOverwrite.
+ * Use `create(PermissionOverwriteSchema)` to create a new message.
+ */
+export const PermissionOverwriteSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 48);
+
+/**
+ * Describes the enum communityserver.v1.PermissionOverwrite.TargetType.
+ */
+export const PermissionOverwrite_TargetTypeSchema = /*@__PURE__*/
+  enumDesc(file_communityserver_v1_communityserver, 48, 0);
+
+/**
+ * @generated from enum communityserver.v1.PermissionOverwrite.TargetType
+ */
+export const PermissionOverwrite_TargetType = /*@__PURE__*/
+  tsEnum(PermissionOverwrite_TargetTypeSchema);
+
+/**
+ * Describes the message communityserver.v1.GetChannelOverwritesRequest.
+ * Use `create(GetChannelOverwritesRequestSchema)` to create a new message.
+ */
+export const GetChannelOverwritesRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 49);
+
+/**
+ * Describes the message communityserver.v1.GetChannelOverwritesResponse.
+ * Use `create(GetChannelOverwritesResponseSchema)` to create a new message.
+ */
+export const GetChannelOverwritesResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 50);
+
+/**
+ * Describes the message communityserver.v1.SetChannelOverwriteRequest.
+ * Use `create(SetChannelOverwriteRequestSchema)` to create a new message.
+ */
+export const SetChannelOverwriteRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 51);
+
+/**
+ * Describes the message communityserver.v1.SetChannelOverwriteResponse.
+ * Use `create(SetChannelOverwriteResponseSchema)` to create a new message.
+ */
+export const SetChannelOverwriteResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 52);
+
+/**
+ * Describes the message communityserver.v1.Invite.
+ * Use `create(InviteSchema)` to create a new message.
+ */
+export const InviteSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 53);
+
+/**
+ * Describes the message communityserver.v1.GetInvitesRequest.
+ * Use `create(GetInvitesRequestSchema)` to create a new message.
+ */
+export const GetInvitesRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 54);
+
+/**
+ * Describes the message communityserver.v1.GetInvitesResponse.
+ * Use `create(GetInvitesResponseSchema)` to create a new message.
+ */
+export const GetInvitesResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 55);
+
+/**
+ * Describes the message communityserver.v1.CreateInviteRequest.
+ * Use `create(CreateInviteRequestSchema)` to create a new message.
+ */
+export const CreateInviteRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 56);
+
+/**
+ * Describes the message communityserver.v1.CreateInviteResponse.
+ * Use `create(CreateInviteResponseSchema)` to create a new message.
+ */
+export const CreateInviteResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 57);
+
+/**
+ * Describes the message communityserver.v1.RevokeInviteRequest.
+ * Use `create(RevokeInviteRequestSchema)` to create a new message.
+ */
+export const RevokeInviteRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 58);
+
+/**
+ * Describes the message communityserver.v1.RevokeInviteResponse.
+ * Use `create(RevokeInviteResponseSchema)` to create a new message.
+ */
+export const RevokeInviteResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 59);
+
+/**
+ * Describes the message communityserver.v1.ResolveInviteRequest.
+ * Use `create(ResolveInviteRequestSchema)` to create a new message.
+ */
+export const ResolveInviteRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 60);
+
+/**
+ * Describes the message communityserver.v1.ResolveInviteResponse.
+ * Use `create(ResolveInviteResponseSchema)` to create a new message.
+ */
+export const ResolveInviteResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 61);
+
+/**
+ * Describes the message communityserver.v1.ResolveInviteResponse.Community.
+ * Use `create(ResolveInviteResponse_CommunitySchema)` to create a new message.
+ */
+export const ResolveInviteResponse_CommunitySchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 61, 0);
+
+/**
+ * Describes the message communityserver.v1.MemberRemovedEvent.
+ * Use `create(MemberRemovedEventSchema)` to create a new message.
+ */
+export const MemberRemovedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 62);
+
+/**
+ * Describes the message communityserver.v1.MemberMutedEvent.
+ * Use `create(MemberMutedEventSchema)` to create a new message.
+ */
+export const MemberMutedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 63);
+
+/**
+ * Describes the message communityserver.v1.KickMemberRequest.
+ * Use `create(KickMemberRequestSchema)` to create a new message.
+ */
+export const KickMemberRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 64);
+
+/**
+ * Describes the message communityserver.v1.KickMemberResponse.
+ * Use `create(KickMemberResponseSchema)` to create a new message.
+ */
+export const KickMemberResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 65);
+
+/**
+ * Describes the message communityserver.v1.MuteMemberRequest.
+ * Use `create(MuteMemberRequestSchema)` to create a new message.
+ */
+export const MuteMemberRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 66);
+
+/**
+ * Describes the message communityserver.v1.MuteMemberResponse.
+ * Use `create(MuteMemberResponseSchema)` to create a new message.
+ */
+export const MuteMemberResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 67);
+
+/**
+ * Describes the message communityserver.v1.UnmuteMemberRequest.
+ * Use `create(UnmuteMemberRequestSchema)` to create a new message.
+ */
+export const UnmuteMemberRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 68);
+
+/**
+ * Describes the message communityserver.v1.UnmuteMemberResponse.
+ * Use `create(UnmuteMemberResponseSchema)` to create a new message.
+ */
+export const UnmuteMemberResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 69);
+
+/**
+ * Describes the message communityserver.v1.Ban.
+ * Use `create(BanSchema)` to create a new message.
+ */
+export const BanSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 70);
+
+/**
+ * Describes the message communityserver.v1.GetBansRequest.
+ * Use `create(GetBansRequestSchema)` to create a new message.
+ */
+export const GetBansRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 71);
+
+/**
+ * Describes the message communityserver.v1.GetBansResponse.
+ * Use `create(GetBansResponseSchema)` to create a new message.
+ */
+export const GetBansResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 72);
+
+/**
+ * Describes the message communityserver.v1.CreateBanRequest.
+ * Use `create(CreateBanRequestSchema)` to create a new message.
+ */
+export const CreateBanRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 73);
+
+/**
+ * Describes the message communityserver.v1.CreateBanResponse.
+ * Use `create(CreateBanResponseSchema)` to create a new message.
+ */
+export const CreateBanResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 74);
+
+/**
+ * Describes the message communityserver.v1.DeleteBanRequest.
+ * Use `create(DeleteBanRequestSchema)` to create a new message.
+ */
+export const DeleteBanRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 75);
+
+/**
+ * Describes the message communityserver.v1.DeleteBanResponse.
+ * Use `create(DeleteBanResponseSchema)` to create a new message.
+ */
+export const DeleteBanResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 76);
+
+/**
+ * Describes the message communityserver.v1.AuditLogEntry.
+ * Use `create(AuditLogEntrySchema)` to create a new message.
+ */
+export const AuditLogEntrySchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 77);
+
+/**
+ * Describes the enum communityserver.v1.AuditLogEntry.Action.
+ */
+export const AuditLogEntry_ActionSchema = /*@__PURE__*/
+  enumDesc(file_communityserver_v1_communityserver, 77, 0);
+
+/**
+ * @generated from enum communityserver.v1.AuditLogEntry.Action
+ */
+export const AuditLogEntry_Action = /*@__PURE__*/
+  tsEnum(AuditLogEntry_ActionSchema);
+
+/**
+ * Describes the message communityserver.v1.GetAuditLogRequest.
+ * Use `create(GetAuditLogRequestSchema)` to create a new message.
+ */
+export const GetAuditLogRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 78);
+
+/**
+ * Describes the message communityserver.v1.GetAuditLogResponse.
+ * Use `create(GetAuditLogResponseSchema)` to create a new message.
+ */
+export const GetAuditLogResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 79);
+
+/**
+ * Describes the message communityserver.v1.Community.
+ * Use `create(CommunitySchema)` to create a new message.
+ */
+export const CommunitySchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 80);
+
+/**
+ * Describes the message communityserver.v1.GetCommunityRequest.
+ * Use `create(GetCommunityRequestSchema)` to create a new message.
+ */
+export const GetCommunityRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 81);
+
+/**
+ * Describes the message communityserver.v1.GetCommunityResponse.
+ * Use `create(GetCommunityResponseSchema)` to create a new message.
+ */
+export const GetCommunityResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 82);
+
+/**
+ * Describes the message communityserver.v1.CreateCommunityRequest.
+ * Use `create(CreateCommunityRequestSchema)` to create a new message.
+ */
+export const CreateCommunityRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 83);
+
+/**
+ * Describes the message communityserver.v1.CreateCommunityResponse.
+ * Use `create(CreateCommunityResponseSchema)` to create a new message.
+ */
+export const CreateCommunityResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 84);
+
+/**
+ * Describes the message communityserver.v1.UpdateCommunityRequest.
+ * Use `create(UpdateCommunityRequestSchema)` to create a new message.
+ */
+export const UpdateCommunityRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 85);
+
+/**
+ * Describes the message communityserver.v1.UpdateCommunityResponse.
+ * Use `create(UpdateCommunityResponseSchema)` to create a new message.
+ */
+export const UpdateCommunityResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 86);
+
+/**
+ * Describes the message communityserver.v1.DeleteCommunityRequest.
+ * Use `create(DeleteCommunityRequestSchema)` to create a new message.
+ */
+export const DeleteCommunityRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 87);
+
+/**
+ * Describes the message communityserver.v1.DeleteCommunityResponse.
+ * Use `create(DeleteCommunityResponseSchema)` to create a new message.
+ */
+export const DeleteCommunityResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 88);
+
+/**
+ * Describes the message communityserver.v1.CommunityUpdatedEvent.
+ * Use `create(CommunityUpdatedEventSchema)` to create a new message.
+ */
+export const CommunityUpdatedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 89);
+
+/**
+ * Describes the message communityserver.v1.CommunityDeletedEvent.
+ * Use `create(CommunityDeletedEventSchema)` to create a new message.
+ */
+export const CommunityDeletedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 90);
+
+/**
+ * Describes the message communityserver.v1.LeaveCommunityRequest.
+ * Use `create(LeaveCommunityRequestSchema)` to create a new message.
+ */
+export const LeaveCommunityRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 91);
+
+/**
+ * Describes the message communityserver.v1.LeaveCommunityResponse.
+ * Use `create(LeaveCommunityResponseSchema)` to create a new message.
+ */
+export const LeaveCommunityResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 92);
+
+/**
+ * Describes the message communityserver.v1.LeaveServerRequest.
+ * Use `create(LeaveServerRequestSchema)` to create a new message.
+ */
+export const LeaveServerRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 93);
+
+/**
+ * Describes the message communityserver.v1.LeaveServerResponse.
+ * Use `create(LeaveServerResponseSchema)` to create a new message.
+ */
+export const LeaveServerResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 94);
+
+/**
+ * Describes the message communityserver.v1.Presence.
+ * Use `create(PresenceSchema)` to create a new message.
+ */
+export const PresenceSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 95);
+
+/**
+ * Describes the message communityserver.v1.PresenceUpdatedEvent.
+ * Use `create(PresenceUpdatedEventSchema)` to create a new message.
+ */
+export const PresenceUpdatedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 96);
+
+/**
+ * Describes the message communityserver.v1.GetPresencesRequest.
+ * Use `create(GetPresencesRequestSchema)` to create a new message.
+ */
+export const GetPresencesRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 97);
+
+/**
+ * Describes the message communityserver.v1.GetPresencesResponse.
+ * Use `create(GetPresencesResponseSchema)` to create a new message.
+ */
+export const GetPresencesResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 98);
+
+/**
+ * Describes the message communityserver.v1.GatewayCommand.
+ * Use `create(GatewayCommandSchema)` to create a new message.
+ */
+export const GatewayCommandSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 99);
+
+/**
+ * Describes the enum communityserver.v1.GatewayCommand.Type.
+ */
+export const GatewayCommand_TypeSchema = /*@__PURE__*/
+  enumDesc(file_communityserver_v1_communityserver, 99, 0);
+
+/**
+ * @generated from enum communityserver.v1.GatewayCommand.Type
+ */
+export const GatewayCommand_Type = /*@__PURE__*/
+  tsEnum(GatewayCommand_TypeSchema);
+
+/**
+ * Describes the message communityserver.v1.UpdatePresenceCommand.
+ * Use `create(UpdatePresenceCommandSchema)` to create a new message.
+ */
+export const UpdatePresenceCommandSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 100);
+
+/**
+ * Describes the message communityserver.v1.StartTypingCommand.
+ * Use `create(StartTypingCommandSchema)` to create a new message.
+ */
+export const StartTypingCommandSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 101);
+
+/**
+ * Describes the message communityserver.v1.TypingStartedEvent.
+ * Use `create(TypingStartedEventSchema)` to create a new message.
+ */
+export const TypingStartedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 102);
+
+/**
+ * Describes the message communityserver.v1.ReadState.
+ * Use `create(ReadStateSchema)` to create a new message.
+ */
+export const ReadStateSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 103);
+
+/**
+ * Describes the message communityserver.v1.GetReadStatesRequest.
+ * Use `create(GetReadStatesRequestSchema)` to create a new message.
+ */
+export const GetReadStatesRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 104);
+
+/**
+ * Describes the message communityserver.v1.GetReadStatesResponse.
+ * Use `create(GetReadStatesResponseSchema)` to create a new message.
+ */
+export const GetReadStatesResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 105);
+
+/**
+ * Describes the message communityserver.v1.AckChannelRequest.
+ * Use `create(AckChannelRequestSchema)` to create a new message.
+ */
+export const AckChannelRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 106);
+
+/**
+ * Describes the message communityserver.v1.AckChannelResponse.
+ * Use `create(AckChannelResponseSchema)` to create a new message.
+ */
+export const AckChannelResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 107);
+
+/**
+ * Describes the message communityserver.v1.UpdateMessageRequest.
+ * Use `create(UpdateMessageRequestSchema)` to create a new message.
+ */
+export const UpdateMessageRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 108);
+
+/**
+ * Describes the message communityserver.v1.UpdateMessageResponse.
+ * Use `create(UpdateMessageResponseSchema)` to create a new message.
+ */
+export const UpdateMessageResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 109);
+
+/**
+ * Describes the message communityserver.v1.DeleteMessageRequest.
+ * Use `create(DeleteMessageRequestSchema)` to create a new message.
+ */
+export const DeleteMessageRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 110);
+
+/**
+ * Describes the message communityserver.v1.DeleteMessageResponse.
+ * Use `create(DeleteMessageResponseSchema)` to create a new message.
+ */
+export const DeleteMessageResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 111);
+
+/**
+ * Describes the message communityserver.v1.MessageRevision.
+ * Use `create(MessageRevisionSchema)` to create a new message.
+ */
+export const MessageRevisionSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 112);
+
+/**
+ * Describes the message communityserver.v1.GetMessageRevisionsRequest.
+ * Use `create(GetMessageRevisionsRequestSchema)` to create a new message.
+ */
+export const GetMessageRevisionsRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 113);
+
+/**
+ * Describes the message communityserver.v1.GetMessageRevisionsResponse.
+ * Use `create(GetMessageRevisionsResponseSchema)` to create a new message.
+ */
+export const GetMessageRevisionsResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 114);
+
+/**
+ * Describes the message communityserver.v1.CustomEmoji.
+ * Use `create(CustomEmojiSchema)` to create a new message.
+ */
+export const CustomEmojiSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 115);
+
+/**
+ * Describes the message communityserver.v1.GetCustomEmojisRequest.
+ * Use `create(GetCustomEmojisRequestSchema)` to create a new message.
+ */
+export const GetCustomEmojisRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 116);
+
+/**
+ * Describes the message communityserver.v1.GetCustomEmojisResponse.
+ * Use `create(GetCustomEmojisResponseSchema)` to create a new message.
+ */
+export const GetCustomEmojisResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 117);
+
+/**
+ * Describes the message communityserver.v1.CreateCustomEmojiResponse.
+ * Use `create(CreateCustomEmojiResponseSchema)` to create a new message.
+ */
+export const CreateCustomEmojiResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 118);
+
+/**
+ * Describes the message communityserver.v1.DeleteCustomEmojiRequest.
+ * Use `create(DeleteCustomEmojiRequestSchema)` to create a new message.
+ */
+export const DeleteCustomEmojiRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 119);
+
+/**
+ * Describes the message communityserver.v1.DeleteCustomEmojiResponse.
+ * Use `create(DeleteCustomEmojiResponseSchema)` to create a new message.
+ */
+export const DeleteCustomEmojiResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 120);
+
+/**
+ * Describes the message communityserver.v1.CustomEmojiCreatedEvent.
+ * Use `create(CustomEmojiCreatedEventSchema)` to create a new message.
+ */
+export const CustomEmojiCreatedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 121);
+
+/**
+ * Describes the message communityserver.v1.CustomEmojiDeletedEvent.
+ * Use `create(CustomEmojiDeletedEventSchema)` to create a new message.
+ */
+export const CustomEmojiDeletedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 122);
+
+/**
+ * Describes the message communityserver.v1.AddReactionRequest.
+ * Use `create(AddReactionRequestSchema)` to create a new message.
+ */
+export const AddReactionRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 123);
+
+/**
+ * Describes the message communityserver.v1.AddReactionResponse.
+ * Use `create(AddReactionResponseSchema)` to create a new message.
+ */
+export const AddReactionResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 124);
+
+/**
+ * Describes the message communityserver.v1.RemoveReactionRequest.
+ * Use `create(RemoveReactionRequestSchema)` to create a new message.
+ */
+export const RemoveReactionRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 125);
+
+/**
+ * Describes the message communityserver.v1.RemoveReactionResponse.
+ * Use `create(RemoveReactionResponseSchema)` to create a new message.
+ */
+export const RemoveReactionResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 126);
+
+/**
+ * Describes the message communityserver.v1.ReactionAddedEvent.
+ * Use `create(ReactionAddedEventSchema)` to create a new message.
+ */
+export const ReactionAddedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 127);
+
+/**
+ * Describes the message communityserver.v1.ReactionRemovedEvent.
+ * Use `create(ReactionRemovedEventSchema)` to create a new message.
+ */
+export const ReactionRemovedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 128);
+
+/**
+ * Describes the message communityserver.v1.SearchMessagesResponse.
+ * Use `create(SearchMessagesResponseSchema)` to create a new message.
+ */
+export const SearchMessagesResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 129);
+
+/**
+ * Describes the message communityserver.v1.UploadAttachmentResponse.
+ * Use `create(UploadAttachmentResponseSchema)` to create a new message.
+ */
+export const UploadAttachmentResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 130);
+
+/**
+ * Describes the message communityserver.v1.PinnedMessage.
+ * Use `create(PinnedMessageSchema)` to create a new message.
+ */
+export const PinnedMessageSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 131);
+
+/**
+ * Describes the message communityserver.v1.GetPinnedMessagesRequest.
+ * Use `create(GetPinnedMessagesRequestSchema)` to create a new message.
+ */
+export const GetPinnedMessagesRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 132);
+
+/**
+ * Describes the message communityserver.v1.GetPinnedMessagesResponse.
+ * Use `create(GetPinnedMessagesResponseSchema)` to create a new message.
+ */
+export const GetPinnedMessagesResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 133);
+
+/**
+ * Describes the message communityserver.v1.PinMessageRequest.
+ * Use `create(PinMessageRequestSchema)` to create a new message.
+ */
+export const PinMessageRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 134);
+
+/**
+ * Describes the message communityserver.v1.PinMessageResponse.
+ * Use `create(PinMessageResponseSchema)` to create a new message.
+ */
+export const PinMessageResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 135);
+
+/**
+ * Describes the message communityserver.v1.UnpinMessageRequest.
+ * Use `create(UnpinMessageRequestSchema)` to create a new message.
+ */
+export const UnpinMessageRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 136);
+
+/**
+ * Describes the message communityserver.v1.UnpinMessageResponse.
+ * Use `create(UnpinMessageResponseSchema)` to create a new message.
+ */
+export const UnpinMessageResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 137);
+
+/**
+ * Describes the message communityserver.v1.MessagePinnedEvent.
+ * Use `create(MessagePinnedEventSchema)` to create a new message.
+ */
+export const MessagePinnedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 138);
+
+/**
+ * Describes the message communityserver.v1.MessageUnpinnedEvent.
+ * Use `create(MessageUnpinnedEventSchema)` to create a new message.
+ */
+export const MessageUnpinnedEventSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 139);
+
+/**
+ * Describes the message communityserver.v1.UpdateSlowModeRequest.
+ * Use `create(UpdateSlowModeRequestSchema)` to create a new message.
+ */
+export const UpdateSlowModeRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 140);
+
+/**
+ * Describes the message communityserver.v1.UpdateSlowModeResponse.
+ * Use `create(UpdateSlowModeResponseSchema)` to create a new message.
+ */
+export const UpdateSlowModeResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 141);
+
+/**
+ * Describes the message communityserver.v1.RateLimitedError.
+ * Use `create(RateLimitedErrorSchema)` to create a new message.
+ */
+export const RateLimitedErrorSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 142);
+
+/**
+ * Describes the enum communityserver.v1.RateLimitedError.Scope.
+ */
+export const RateLimitedError_ScopeSchema = /*@__PURE__*/
+  enumDesc(file_communityserver_v1_communityserver, 142, 0);
+
+/**
+ * @generated from enum communityserver.v1.RateLimitedError.Scope
+ */
+export const RateLimitedError_Scope = /*@__PURE__*/
+  tsEnum(RateLimitedError_ScopeSchema);
+
+/**
+ * Describes the message communityserver.v1.RateLimitExemption.
+ * Use `create(RateLimitExemptionSchema)` to create a new message.
+ */
+export const RateLimitExemptionSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 143);
+
+/**
+ * Describes the message communityserver.v1.GetRateLimitExemptionsRequest.
+ * Use `create(GetRateLimitExemptionsRequestSchema)` to create a new message.
+ */
+export const GetRateLimitExemptionsRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 144);
+
+/**
+ * Describes the message communityserver.v1.GetRateLimitExemptionsResponse.
+ * Use `create(GetRateLimitExemptionsResponseSchema)` to create a new message.
+ */
+export const GetRateLimitExemptionsResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 145);
+
+/**
+ * Describes the message communityserver.v1.AddRateLimitExemptionRequest.
+ * Use `create(AddRateLimitExemptionRequestSchema)` to create a new message.
+ */
+export const AddRateLimitExemptionRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 146);
+
+/**
+ * Describes the message communityserver.v1.AddRateLimitExemptionResponse.
+ * Use `create(AddRateLimitExemptionResponseSchema)` to create a new message.
+ */
+export const AddRateLimitExemptionResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 147);
+
+/**
+ * Describes the message communityserver.v1.RemoveRateLimitExemptionRequest.
+ * Use `create(RemoveRateLimitExemptionRequestSchema)` to create a new message.
+ */
+export const RemoveRateLimitExemptionRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 148);
+
+/**
+ * Describes the message communityserver.v1.RemoveRateLimitExemptionResponse.
+ * Use `create(RemoveRateLimitExemptionResponseSchema)` to create a new message.
+ */
+export const RemoveRateLimitExemptionResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 149);
+
+/**
+ * Describes the message communityserver.v1.Conversation.
+ * Use `create(ConversationSchema)` to create a new message.
+ */
+export const ConversationSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 150);
+
+/**
+ * Describes the message communityserver.v1.ConversationMessage.
+ * Use `create(ConversationMessageSchema)` to create a new message.
+ */
+export const ConversationMessageSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 151);
+
+/**
+ * Describes the message communityserver.v1.CreateConversationRequest.
+ * Use `create(CreateConversationRequestSchema)` to create a new message.
+ */
+export const CreateConversationRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 152);
+
+/**
+ * Describes the message communityserver.v1.CreateConversationResponse.
+ * Use `create(CreateConversationResponseSchema)` to create a new message.
+ */
+export const CreateConversationResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 153);
+
+/**
+ * Describes the message communityserver.v1.GetConversationsRequest.
+ * Use `create(GetConversationsRequestSchema)` to create a new message.
+ */
+export const GetConversationsRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 154);
+
+/**
+ * Describes the message communityserver.v1.GetConversationsResponse.
+ * Use `create(GetConversationsResponseSchema)` to create a new message.
+ */
+export const GetConversationsResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 155);
+
+/**
+ * Describes the message communityserver.v1.GetConversationMessagesRequest.
+ * Use `create(GetConversationMessagesRequestSchema)` to create a new message.
+ */
+export const GetConversationMessagesRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 156);
+
+/**
+ * Describes the message communityserver.v1.GetConversationMessagesResponse.
+ * Use `create(GetConversationMessagesResponseSchema)` to create a new message.
+ */
+export const GetConversationMessagesResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 157);
+
+/**
+ * Describes the message communityserver.v1.SendConversationMessageRequest.
+ * Use `create(SendConversationMessageRequestSchema)` to create a new message.
+ */
+export const SendConversationMessageRequestSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 158);
+
+/**
+ * Describes the message communityserver.v1.SendConversationMessageResponse.
+ * Use `create(SendConversationMessageResponseSchema)` to create a new message.
+ */
+export const SendConversationMessageResponseSchema = /*@__PURE__*/
+  messageDesc(file_communityserver_v1_communityserver, 159);
+
+/**
+ * Describes the enum communityserver.v1.Permission.
+ */
+export const PermissionSchema = /*@__PURE__*/
+  enumDesc(file_communityserver_v1_communityserver, 0);
+
+/**
+ * @generated from enum communityserver.v1.Permission
+ */
+export const Permission = /*@__PURE__*/
+  tsEnum(PermissionSchema);
+
+/**
+ * Describes the enum communityserver.v1.PresenceStatus.
+ */
+export const PresenceStatusSchema = /*@__PURE__*/
+  enumDesc(file_communityserver_v1_communityserver, 1);
+
+/**
+ * @generated from enum communityserver.v1.PresenceStatus
+ */
+export const PresenceStatus = /*@__PURE__*/
+  tsEnum(PresenceStatusSchema);
+
@@ -80,6 +80,56 @@ export enum Message_Type {
    * @generated from enum value: TYPE_JOIN_COMMUNITY_SERVER = 4;
    */
   JOIN_COMMUNITY_SERVER = 4,
+
+  /**
+   * @generated from enum value: TYPE_LEAVE_COMMUNITY_SERVER = 5;
+   */
+  LEAVE_COMMUNITY_SERVER = 5,
+
+  /**
+   * @generated from enum value: TYPE_GET_COMMUNITY_GROUPS = 6;
+   */
+  GET_COMMUNITY_GROUPS = 6,
+
+  /**
+   * @generated from enum value: TYPE_SET_COMMUNITY_GROUPS = 7;
+   */
+  SET_COMMUNITY_GROUPS = 7,
+
+  /**
+   * @generated from enum value: TYPE_GET_NOTIFICATIONS = 8;
+   */
+  GET_NOTIFICATIONS = 8,
+
+  /**
+   * @generated from enum value: TYPE_ACK_NOTIFICATIONS = 9;
+   */
+  ACK_NOTIFICATIONS = 9,
+
+  /**
+   * @generated from enum value: TYPE_GET_CONVERSATIONS = 10;
+   */
+  GET_CONVERSATIONS = 10,
+
+  /**
+   * @generated from enum value: TYPE_CREATE_CONVERSATION = 11;
+   */
+  CREATE_CONVERSATION = 11,
+
+  /**
+   * @generated from enum value: TYPE_GET_CONVERSATION_HOSTS = 12;
+   */
+  GET_CONVERSATION_HOSTS = 12,
+
+  /**
+   * @generated from enum value: TYPE_SET_CONVERSATION_HOST_STATUS = 13;
+   */
+  SET_CONVERSATION_HOST_STATUS = 13,
+
+  /**
+   * @generated from enum value: TYPE_REMOVE_CONVERSATION_HOST = 14;
+   */
+  REMOVE_CONVERSATION_HOST = 14,
 }
 
 /**
@@ -135,6 +185,16 @@ export declare type GetUserCommunitiesResponse = Message$1<"homeserver.v1.GetUse
    * @generated from field: repeated homeserver.v1.GetUserCommunitiesResponse.Community communities = 1;
    */
   communities: GetUserCommunitiesResponse_Community[];
+
+  /**
+   * @generated from field: int64 unread_count = 2;
+   */
+  unreadCount: bigint;
+
+  /**
+   * @generated from field: int64 mention_count = 3;
+   */
+  mentionCount: bigint;
 };
 
 /**
@@ -156,6 +216,26 @@ export declare type GetUserCommunitiesResponse_Community = Message$1<"homeserver
    * @generated from field: string name = 2;
    */
   name: string;
+
+  /**
+   * @generated from field: int64 online = 3;
+   */
+  online: bigint;
+
+  /**
+   * @generated from field: string host = 4;
+   */
+  host: string;
+
+  /**
+   * @generated from field: int64 unread_count = 5;
+   */
+  unreadCount: bigint;
+
+  /**
+   * @generated from field: int64 mention_count = 6;
+   */
+  mentionCount: bigint;
 };
 
 /**
@@ -184,6 +264,10 @@ export declare const WellKnownSchema: GenMessage<WellKnown>;
  * @generated from message homeserver.v1.GetIdentityTokenRequest
  */
 export declare type GetIdentityTokenRequest = Message$1<"homeserver.v1.GetIdentityTokenRequest"> & {
+  /**
+   * @generated from field: string host = 1;
+   */
+  host: string;
 };
 
 /**
@@ -221,6 +305,11 @@ export declare type JoinCommunityServerRequest = Message$1<"homeserver.v1.JoinCo
    * @generated from field: bool join_default_community = 2;
    */
   joinDefaultCommunity: boolean;
+
+  /**
+   * @generated from field: string invite_code = 3;
+   */
+  inviteCode: string;
 };
 
 /**
@@ -241,3 +330,499 @@ export declare type JoinCommunityServerResponse = Message$1<"homeserver.v1.JoinC
  */
 export declare const JoinCommunityServerResponseSchema: GenMessage<JoinCommunityServerResponse>;
 
+/**
+ * @generated from message homeserver.v1.LeaveCommunityServerRequest
+ */
+export declare type LeaveCommunityServerRequest = Message$1<"homeserver.v1.LeaveCommunityServerRequest"> & {
+  /**
+   * @generated from field: string host = 1;
+   */
+  host: string;
+};
+
+/**
+ * Describes the message homeserver.v1.LeaveCommunityServerRequest.
+ * Use `create(LeaveCommunityServerRequestSchema)` to create a new message.
+ */
+export declare const LeaveCommunityServerRequestSchema: GenMessage<LeaveCommunityServerRequest>;
+
+/**
+ * @generated from message homeserver.v1.LeaveCommunityServerResponse
+ */
+export declare type LeaveCommunityServerResponse = Message$1<"homeserver.v1.LeaveCommunityServerResponse"> & {
+};
+
+/**
+ * Describes the message homeserver.v1.LeaveCommunityServerResponse.
+ * Use `create(LeaveCommunityServerResponseSchema)` to create a new message.
+ */
+export declare const LeaveCommunityServerResponseSchema: GenMessage<LeaveCommunityServerResponse>;
+
+/**
+ * @generated from message homeserver.v1.Notification
+ */
+export declare type Notification = Message$1<"homeserver.v1.Notification"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: homeserver.v1.Notification.Type type = 2;
+   */
+  type: Notification_Type;
+
+  /**
+   * @generated from field: string host = 3;
+   */
+  host: string;
+
+  /**
+   * @generated from field: string community_id = 4;
+   */
+  communityId: string;
+
+  /**
+   * @generated from field: string community_name = 5;
+   */
+  communityName: string;
+
+  /**
+   * @generated from field: string channel_id = 6;
+   */
+  channelId: string;
+
+  /**
+   * @generated from field: string channel_name = 7;
+   */
+  channelName: string;
+
+  /**
+   * @generated from field: string message_id = 8;
+   */
+  messageId: string;
+
+  /**
+   * @generated from field: string author_address = 9;
+   */
+  authorAddress: string;
+
+  /**
+   * @generated from field: string body = 10;
+   */
+  body: string;
+
+  /**
+   * @generated from field: string created_at = 11;
+   */
+  createdAt: string;
+
+  /**
+   * @generated from field: bool read = 12;
+   */
+  read: boolean;
+
+  /**
+   * @generated from field: string conversation_id = 13;
+   */
+  conversationId: string;
+};
+
+/**
+ * Describes the message homeserver.v1.Notification.
+ * Use `create(NotificationSchema)` to create a new message.
+ */
+export declare const NotificationSchema: GenMessage<Notification>;
+
+/**
+ * @generated from enum homeserver.v1.Notification.Type
+ */
+export enum Notification_Type {
+  /**
+   * @generated from enum value: TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TYPE_USER_MENTION = 1;
+   */
+  USER_MENTION = 1,
+
+  /**
+   * @generated from enum value: TYPE_ROLE_MENTION = 2;
+   */
+  ROLE_MENTION = 2,
+
+  /**
+   * @generated from enum value: TYPE_EVERYONE_MENTION = 3;
+   */
+  EVERYONE_MENTION = 3,
+
+  /**
+   * @generated from enum value: TYPE_DIRECT_MESSAGE = 4;
+   */
+  DIRECT_MESSAGE = 4,
+}
+
+/**
+ * Describes the enum homeserver.v1.Notification.Type.
+ */
+export declare const Notification_TypeSchema: GenEnum<Notification_Type>;
+
+/**
+ * @generated from message homeserver.v1.DeliverNotificationsRequest
+ */
+export declare type DeliverNotificationsRequest = Message$1<"homeserver.v1.DeliverNotificationsRequest"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message homeserver.v1.DeliverNotificationsRequest.
+ * Use `create(DeliverNotificationsRequestSchema)` to create a new message.
+ */
+export declare const DeliverNotificationsRequestSchema: GenMessage<DeliverNotificationsRequest>;
+
+/**
+ * @generated from message homeserver.v1.DeliverNotificationsResponse
+ */
+export declare type DeliverNotificationsResponse = Message$1<"homeserver.v1.DeliverNotificationsResponse"> & {
+  /**
+   * @generated from field: int64 delivered = 1;
+   */
+  delivered: bigint;
+};
+
+/**
+ * Describes the message homeserver.v1.DeliverNotificationsResponse.
+ * Use `create(DeliverNotificationsResponseSchema)` to create a new message.
+ */
+export declare const DeliverNotificationsResponseSchema: GenMessage<DeliverNotificationsResponse>;
+
+/**
+ * @generated from message homeserver.v1.GetNotificationsRequest
+ */
+export declare type GetNotificationsRequest = Message$1<"homeserver.v1.GetNotificationsRequest"> & {
+  /**
+   * @generated from field: string before = 1;
+   */
+  before: string;
+
+  /**
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message homeserver.v1.GetNotificationsRequest.
+ * Use `create(GetNotificationsRequestSchema)` to create a new message.
+ */
+export declare const GetNotificationsRequestSchema: GenMessage<GetNotificationsRequest>;
+
+/**
+ * @generated from message homeserver.v1.GetNotificationsResponse
+ */
+export declare type GetNotificationsResponse = Message$1<"homeserver.v1.GetNotificationsResponse"> & {
+  /**
+   * @generated from field: repeated homeserver.v1.Notification notifications = 1;
+   */
+  notifications: Notification[];
+
+  /**
+   * @generated from field: int64 unread_count = 2;
+   */
+  unreadCount: bigint;
+};
+
+/**
+ * Describes the message homeserver.v1.GetNotificationsResponse.
+ * Use `create(GetNotificationsResponseSchema)` to create a new message.
+ */
+export declare const GetNotificationsResponseSchema: GenMessage<GetNotificationsResponse>;
+
+/**
+ * @generated from message homeserver.v1.AckNotificationsRequest
+ */
+export declare type AckNotificationsRequest = Message$1<"homeserver.v1.AckNotificationsRequest"> & {
+  /**
+   * @generated from field: repeated string notification_ids = 1;
+   */
+  notificationIds: string[];
+
+  /**
+   * @generated from field: bool all = 2;
+   */
+  all: boolean;
+};
+
+/**
+ * Describes the message homeserver.v1.AckNotificationsRequest.
+ * Use `create(AckNotificationsRequestSchema)` to create a new message.
+ */
+export declare const AckNotificationsRequestSchema: GenMessage<AckNotificationsRequest>;
+
+/**
+ * @generated from message homeserver.v1.AckNotificationsResponse
+ */
+export declare type AckNotificationsResponse = Message$1<"homeserver.v1.AckNotificationsResponse"> & {
+  /**
+   * @generated from field: int64 unread_count = 1;
+   */
+  unreadCount: bigint;
+};
+
+/**
+ * Describes the message homeserver.v1.AckNotificationsResponse.
+ * Use `create(AckNotificationsResponseSchema)` to create a new message.
+ */
+export declare const AckNotificationsResponseSchema: GenMessage<AckNotificationsResponse>;
+
+/**
+ * @generated from message homeserver.v1.Conversation
+ */
+export declare type Conversation = Message$1<"homeserver.v1.Conversation"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string host = 2;
+   */
+  host: string;
+
+  /**
+   * @generated from field: repeated string participant_addresses = 3;
+   */
+  participantAddresses: string[];
+
+  /**
+   * @generated from field: string created_by = 4;
+   */
+  createdBy: string;
+
+  /**
+   * @generated from field: string created_at = 5;
+   */
+  createdAt: string;
+
+  /**
+   * @generated from field: string last_message_at = 6;
+   */
+  lastMessageAt: string;
+};
+
+/**
+ * Describes the message homeserver.v1.Conversation.
+ * Use `create(ConversationSchema)` to create a new message.
+ */
+export declare const ConversationSchema: GenMessage<Conversation>;
+
+/**
+ * @generated from message homeserver.v1.GetConversationsRequest
+ */
+export declare type GetConversationsRequest = Message$1<"homeserver.v1.GetConversationsRequest"> & {
+};
+
+/**
+ * Describes the message homeserver.v1.GetConversationsRequest.
+ * Use `create(GetConversationsRequestSchema)` to create a new message.
+ */
+export declare const GetConversationsRequestSchema: GenMessage<GetConversationsRequest>;
+
+/**
+ * @generated from message homeserver.v1.GetConversationsResponse
+ */
+export declare type GetConversationsResponse = Message$1<"homeserver.v1.GetConversationsResponse"> & {
+  /**
+   * @generated from field: repeated homeserver.v1.Conversation conversations = 1;
+   */
+  conversations: Conversation[];
+};
+
+/**
+ * Describes the message homeserver.v1.GetConversationsResponse.
+ * Use `create(GetConversationsResponseSchema)` to create a new message.
+ */
+export declare const GetConversationsResponseSchema: GenMessage<GetConversationsResponse>;
+
+/**
+ * @generated from message homeserver.v1.CreateConversationRequest
+ */
+export declare type CreateConversationRequest = Message$1<"homeserver.v1.CreateConversationRequest"> & {
+  /**
+   * @generated from field: repeated string participant_addresses = 1;
+   */
+  participantAddresses: string[];
+};
+
+/**
+ * Describes the message homeserver.v1.CreateConversationRequest.
+ * Use `create(CreateConversationRequestSchema)` to create a new message.
+ */
+export declare const CreateConversationRequestSchema: GenMessage<CreateConversationRequest>;
+
+/**
+ * @generated from message homeserver.v1.CreateConversationResponse
+ */
+export declare type CreateConversationResponse = Message$1<"homeserver.v1.CreateConversationResponse"> & {
+  /**
+   * @generated from field: homeserver.v1.Conversation conversation = 1;
+   */
+  conversation?: Conversation;
+};
+
+/**
+ * Describes the message homeserver.v1.CreateConversationResponse.
+ * Use `create(CreateConversationResponseSchema)` to create a new message.
+ */
+export declare const CreateConversationResponseSchema: GenMessage<CreateConversationResponse>;
+
+/**
+ * @generated from message homeserver.v1.ConversationHost
+ */
+export declare type ConversationHost = Message$1<"homeserver.v1.ConversationHost"> & {
+  /**
+   * @generated from field: string host = 1;
+   */
+  host: string;
+
+  /**
+   * @generated from field: homeserver.v1.ConversationHost.Status status = 2;
+   */
+  status: ConversationHost_Status;
+
+  /**
+   * @generated from field: string created_at = 3;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message homeserver.v1.ConversationHost.
+ * Use `create(ConversationHostSchema)` to create a new message.
+ */
+export declare const ConversationHostSchema: GenMessage<ConversationHost>;
+
+/**
+ * @generated from enum homeserver.v1.ConversationHost.Status
+ */
+export enum ConversationHost_Status {
+  /**
+   * @generated from enum value: STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: STATUS_ACCEPTED = 2;
+   */
+  ACCEPTED = 2,
+
+  /**
+   * @generated from enum value: STATUS_BLOCKED = 3;
+   */
+  BLOCKED = 3,
+}
+
+/**
+ * Describes the enum homeserver.v1.ConversationHost.Status.
+ */
+export declare const ConversationHost_StatusSchema: GenEnum<ConversationHost_Status>;
+
+/**
+ * @generated from message homeserver.v1.GetConversationHostsRequest
+ */
+export declare type GetConversationHostsRequest = Message$1<"homeserver.v1.GetConversationHostsRequest"> & {
+};
+
+/**
+ * Describes the message homeserver.v1.GetConversationHostsRequest.
+ * Use `create(GetConversationHostsRequestSchema)` to create a new message.
+ */
+export declare const GetConversationHostsRequestSchema: GenMessage<GetConversationHostsRequest>;
+
+/**
+ * @generated from message homeserver.v1.GetConversationHostsResponse
+ */
+export declare type GetConversationHostsResponse = Message$1<"homeserver.v1.GetConversationHostsResponse"> & {
+  /**
+   * @generated from field: repeated homeserver.v1.ConversationHost hosts = 1;
+   */
+  hosts: ConversationHost[];
+};
+
+/**
+ * Describes the message homeserver.v1.GetConversationHostsResponse.
+ * Use `create(GetConversationHostsResponseSchema)` to create a new message.
+ */
+export declare const GetConversationHostsResponseSchema: GenMessage<GetConversationHostsResponse>;
+
+/**
+ * @generated from message homeserver.v1.SetConversationHostStatusRequest
+ */
+export declare type SetConversationHostStatusRequest = Message$1<"homeserver.v1.SetConversationHostStatusRequest"> & {
+  /**
+   * @generated from field: string host = 1;
+   */
+  host: string;
+
+  /**
+   * @generated from field: homeserver.v1.ConversationHost.Status status = 2;
+   */
+  status: ConversationHost_Status;
+};
+
+/**
+ * Describes the message homeserver.v1.SetConversationHostStatusRequest.
+ * Use `create(SetConversationHostStatusRequestSchema)` to create a new message.
+ */
+export declare const SetConversationHostStatusRequestSchema: GenMessage<SetConversationHostStatusRequest>;
+
+/**
+ * @generated from message homeserver.v1.SetConversationHostStatusResponse
+ */
+export declare type SetConversationHostStatusResponse = Message$1<"homeserver.v1.SetConversationHostStatusResponse"> & {
+};
+
+/**
+ * Describes the message homeserver.v1.SetConversationHostStatusResponse.
+ * Use `create(SetConversationHostStatusResponseSchema)` to create a new message.
+ */
+export declare const SetConversationHostStatusResponseSchema: GenMessage<SetConversationHostStatusResponse>;
+
+/**
+ * @generated from message homeserver.v1.RemoveConversationHostRequest
+ */
+export declare type RemoveConversationHostRequest = Message$1<"homeserver.v1.RemoveConversationHostRequest"> & {
+  /**
+   * @generated from field: string host = 1;
+   */
+  host: string;
+};
+
+/**
+ * Describes the message homeserver.v1.RemoveConversationHostRequest.
+ * Use `create(RemoveConversationHostRequestSchema)` to create a new message.
+ */
+export declare const RemoveConversationHostRequestSchema: GenMessage<RemoveConversationHostRequest>;
+
+/**
+ * @generated from message homeserver.v1.RemoveConversationHostResponse
+ */
+export declare type RemoveConversationHostResponse = Message$1<"homeserver.v1.RemoveConversationHostResponse"> & {
+};
+
+/**
+ * Describes the message homeserver.v1.RemoveConversationHostResponse.
+ * Use `create(RemoveConversationHostResponseSchema)` to create a new message.
+ */
+export declare const RemoveConversationHostResponseSchema: GenMessage<RemoveConversationHostResponse>;
+
@@ -8,7 +8,7 @@ import { enumDesc, fileDesc, messageDesc, tsEnum } from "@bufbuild/protobuf/code
  * Describes the file homeserver/v1/homeserver.proto.
  */
 export const file_homeserver_v1_homeserver = /*@__PURE__*/
-  fileDesc("Ch5ob21lc2VydmVyL3YxL2hvbWVzZXJ2ZXIucHJvdG8SDWhvbWVzZXJ2ZXIudjEioQIKB01lc3NhZ2USKQoEdHlwZRgBIAEoDjIbLmhvbWVzZXJ2ZXIudjEuTWVzc2FnZS5UeXBlEg8KB3BheWxvYWQYAiABKAwSKwoFZXJyb3IYAyABKAsyHC5ob21lc2VydmVyLnYxLk1lc3NhZ2UuRXJyb3IaGAoFRXJyb3ISDwoHbWVzc2FnZRgBIAEoCSKSAQoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASGAoUVFlQRV9BRERfVVNFUl9TRVJWRVIQARIdChlUWVBFX0dFVF9VU0VSX0NPTU1VTklUSUVTEAISGwoXVFlQRV9HRVRfSURFTlRJVFlfVE9LRU4QAxIeChpUWVBFX0pPSU5fQ09NTVVOSVRZX1NFUlZFUhAEIiQKFEFkZFVzZXJTZXJ2ZXJSZXF1ZXN0EgwKBGhvc3QYASABKAkiFwoVQWRkVXNlclNlcnZlclJlc3BvbnNlIhsKGUdldFVzZXJDb21tdW5pdGllc1JlcXVlc3QijQEKGkdldFVzZXJDb21tdW5pdGllc1Jlc3BvbnNlEkgKC2NvbW11bml0aWVzGAEgAygLMjMuaG9tZXNlcnZlci52MS5HZXRVc2VyQ29tbXVuaXRpZXNSZXNwb25zZS5Db21tdW5pdHkaJQoJQ29tbXVuaXR5EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkiHwoJV2VsbEtub3duEhIKCnB1YmxpY19rZXkYASABKAkiGQoXR2V0SWRlbnRpdHlUb2tlblJlcXVlc3QiKQoYR2V0SWRlbnRpdHlUb2tlblJlc3BvbnNlEg0KBXRva2VuGAEgASgJIkoKGkpvaW5Db21tdW5pdHlTZXJ2ZXJSZXF1ZXN0EgwKBGhvc3QYASABKAkSHgoWam9pbl9kZWZhdWx0X2NvbW11bml0eRgCIAEoCCIdChtKb2luQ29tbXVuaXR5U2VydmVyUmVzcG9uc2VCygEKEWNvbS5ob21lc2VydmVyLnYxQg9Ib21lc2VydmVyUHJvdG9QAVpPZ2l0aHViLmNvbS92YXJzby9wcm90Y2hhdC1zZXJ2ZXIvaW50ZXJuYWwvbW9kZWxzL2dlbi9ob21lc2VydmVyL3YxO2hvbWVzZXJ2ZXJ2MaICA0hYWKoCDUhvbWVzZXJ2ZXIuVjHKAg1Ib21lc2VydmVyXFYx4gIZSG9tZXNlcnZlclxWMVxHUEJNZXRhZGF0YeoCDkhvbWVzZXJ2ZXI6OlYxYgZwcm90bzM");
+  fileDesc("Ch5ob21lc2VydmVyL3YxL2hvbWVzZXJ2ZXIucHJvdG8SDWhvbWVzZXJ2ZXIudjEi3QQKB01lc3NhZ2USKQoEdHlwZRgBIAEoDjIbLmhvbWVzZXJ2ZXIudjEuTWVzc2FnZS5UeXBlEg8KB3BheWxvYWQYAiABKAwSKwoFZXJyb3IYAyABKAsyHC5ob21lc2VydmVyLnYxLk1lc3NhZ2UuRXJyb3IaGAoFRXJyb3ISDwoHbWVzc2FnZRgBIAEoCSLOAwoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASGAoUVFlQRV9BRERfVVNFUl9TRVJWRVIQARIdChlUWVBFX0dFVF9VU0VSX0NPTU1VTklUSUVTEAISGwoXVFlQRV9HRVRfSURFTlRJVFlfVE9LRU4QAxIeChpUWVBFX0pPSU5fQ09NTVVOSVRZX1NFUlZFUhAEEh8KG1RZUEVfTEVBVkVfQ09NTVVOSVRZX1NFUlZFUhAFEh0KGVRZUEVfR0VUX0NPTU1VTklUWV9HUk9VUFMQBhIdChlUWVBFX1NFVF9DT01NVU5JVFlfR1JPVVBTEAcSGgoWVFlQRV9HRVRfTk9USUZJQ0FUSU9OUxAIEhoKFlRZUEVfQUNLX05PVElGSUNBVElPTlMQCRIaChZUWVBFX0dFVF9DT05WRVJTQVRJT05TEAoSHAoYVFlQRV9DUkVBVEVfQ09OVkVSU0FUSU9OEAsSHwobVFlQRV9HRVRfQ09OVkVSU0FUSU9OX0hPU1RTEAwSJQohVFlQRV9TRVRfQ09OVkVSU0FUSU9OX0hPU1RfU1RBVFVTEA0SIQodVFlQRV9SRU1PVkVfQ09OVkVSU0FUSU9OX0hPU1QQDiIkChRBZGRVc2VyU2VydmVyUmVxdWVzdBIMCgRob3N0GAEgASgJIhcKFUFkZFVzZXJTZXJ2ZXJSZXNwb25zZSIbChlHZXRVc2VyQ29tbXVuaXRpZXNSZXF1ZXN0IoUCChpHZXRVc2VyQ29tbXVuaXRpZXNSZXNwb25zZRJICgtjb21tdW5pdGllcxgBIAMoCzIzLmhvbWVzZXJ2ZXIudjEuR2V0VXNlckNvbW11bml0aWVzUmVzcG9uc2UuQ29tbXVuaXR5EhQKDHVucmVhZF9jb3VudBgCIAEoAxIVCg1tZW50aW9uX2NvdW50GAMgASgDGnAKCUNvbW11bml0eRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEg4KBm9ubGluZRgDIAEoAxIMCgRob3N0GAQgASgJEhQKDHVucmVhZF9jb3VudBgFIAEoAxIVCg1tZW50aW9uX2NvdW50GAYgASgDIh8KCVdlbGxLbm93bhISCgpwdWJsaWNfa2V5GAEgASgJIicKF0dldElkZW50aXR5VG9rZW5SZXF1ZXN0EgwKBGhvc3QYASABKAkiKQoYR2V0SWRlbnRpdHlUb2tlblJlc3BvbnNlEg0KBXRva2VuGAEgASgJIl8KGkpvaW5Db21tdW5pdHlTZXJ2ZXJSZXF1ZXN0EgwKBGhvc3QYASABKAkSHgoWam9pbl9kZWZhdWx0X2NvbW11bml0eRgCIAEoCBITCgtpbnZpdGVfY29kZRgDIAEoCSIdChtKb2luQ29tbXVuaXR5U2VydmVyUmVzcG9uc2UiKwobTGVhdmVDb21tdW5pdHlTZXJ2ZXJSZXF1ZXN0EgwKBGhvc3QYASABKAkiHgocTGVhdmVDb21tdW5pdHlTZXJ2ZXJSZXNwb25zZSKlAwoMTm90aWZpY2F0aW9uEgoKAmlkGAEgASgJEi4KBHR5cGUYAiABKA4yIC5ob21lc2VydmVyLnYxLk5vdGlmaWNhdGlvbi5UeXBlEgwKBGhvc3QYAyABKAkSFAoMY29tbXVuaXR5X2lkGAQgASgJEhYKDmNvbW11bml0eV9uYW1lGAUgASgJEhIKCmNoYW5uZWxfaWQYBiABKAkSFAoMY2hhbm5lbF9uYW1lGAcgASgJEhIKCm1lc3NhZ2VfaWQYCCABKAkSFgoOYXV0aG9yX2FkZHJlc3MYCSABKAkSDAoEYm9keRgKIAEoCRISCgpjcmVhdGVkX2F0GAsgASgJEgwKBHJlYWQYDCABKAgSFwoPY29udmVyc2F0aW9uX2lkGA0gASgJIn4KBFR5cGUSFAoQVFlQRV9VTlNQRUNJRklFRBAAEhUKEVRZUEVfVVNFUl9NRU5USU9OEAESFQoRVFlQRV9ST0xFX01FTlRJT04QAhIZChVUWVBFX0VWRVJZT05FX01FTlRJT04QAxIXChNUWVBFX0RJUkVDVF9NRVNTQUdFEAQiLAobRGVsaXZlck5vdGlmaWNhdGlvbnNSZXF1ZXN0Eg0KBXRva2VuGAEgASgJIjEKHERlbGl2ZXJOb3RpZmljYXRpb25zUmVzcG9uc2USEQoJZGVsaXZlcmVkGAEgASgDIjgKF0dldE5vdGlmaWNhdGlvbnNSZXF1ZXN0Eg4KBmJlZm9yZRgBIAEoCRINCgVsaW1pdBgCIAEoBSJkChhHZXROb3RpZmljYXRpb25zUmVzcG9uc2USMgoNbm90aWZpY2F0aW9ucxgBIAMoCzIbLmhvbWVzZXJ2ZXIudjEuTm90aWZpY2F0aW9uEhQKDHVucmVhZF9jb3VudBgCIAEoAyJAChdBY2tOb3RpZmljYXRpb25zUmVxdWVzdBIYChBub3RpZmljYXRpb25faWRzGAEgAygJEgsKA2FsbBgCIAEoCCIwChhBY2tOb3RpZmljYXRpb25zUmVzcG9uc2USFAoMdW5yZWFkX2NvdW50GAEgASgDIogBCgxDb252ZXJzYXRpb24SCgoCaWQYASABKAkSDAoEaG9zdBgCIAEoCRIdChVwYXJ0aWNpcGFudF9hZGRyZXNzZXMYAyADKAkSEgoKY3JlYXRlZF9ieRgEIAEoCRISCgpjcmVhdGVkX2F0GAUgASgJEhcKD2xhc3RfbWVzc2FnZV9hdBgGIAEoCSIZChdHZXRDb252ZXJzYXRpb25zUmVxdWVzdCJOChhHZXRDb252ZXJzYXRpb25zUmVzcG9uc2USMgoNY29udmVyc2F0aW9ucxgBIAMoCzIbLmhvbWVzZXJ2ZXIudjEuQ29udmVyc2F0aW9uIjoKGUNyZWF0ZUNvbnZlcnNhdGlvblJlcXVlc3QSHQoVcGFydGljaXBhbnRfYWRkcmVzc2VzGAEgAygJIk8KGkNyZWF0ZUNvbnZlcnNhdGlvblJlc3BvbnNlEjEKDGNvbnZlcnNhdGlvbhgBIAEoCzIbLmhvbWVzZXJ2ZXIudjEuQ29udmVyc2F0aW9uIssBChBDb252ZXJzYXRpb25Ib3N0EgwKBGhvc3QYASABKAkSNgoGc3RhdHVzGAIgASgOMiYuaG9tZXNlcnZlci52MS5Db252ZXJzYXRpb25Ib3N0LlN0YXR1cxISCgpjcmVhdGVkX2F0GAMgASgJIl0KBlN0YXR1cxIWChJTVEFUVVNfVU5TUEVDSUZJRUQQABISCg5TVEFUVVNfUEVORElORxABEhMKD1NUQVRVU19BQ0NFUFRFRBACEhIKDlNUQVRVU19CTE9DS0VEEAMiHQobR2V0Q29udmVyc2F0aW9uSG9zdHNSZXF1ZXN0Ik4KHEdldENvbnZlcnNhdGlvbkhvc3RzUmVzcG9uc2USLgoFaG9zdHMYASADKAsyHy5ob21lc2VydmVyLnYxLkNvbnZlcnNhdGlvbkhvc3QiaAogU2V0Q29udmVyc2F0aW9uSG9zdFN0YXR1c1JlcXVlc3QSDAoEaG9zdBgBIAEoCRI2CgZzdGF0dXMYAiABKA4yJi5ob21lc2VydmVyLnYxLkNvbnZlcnNhdGlvbkhvc3QuU3RhdHVzIiMKIVNldENvbnZlcnNhdGlvbkhvc3RTdGF0dXNSZXNwb25zZSItCh1SZW1vdmVDb252ZXJzYXRpb25Ib3N0UmVxdWVzdBIMCgRob3N0GAEgASgJIiAKHlJlbW92ZUNvbnZlcnNhdGlvbkhvc3RSZXNwb25zZULKAQoRY29tLmhvbWVzZXJ2ZXIudjFCD0hvbWVzZXJ2ZXJQcm90b1ABWk9naXRodWIuY29tL3ZhcnNvL3Byb3RjaGF0LXNlcnZlci9pbnRlcm5hbC9tb2RlbHMvZ2VuL2hvbWVzZXJ2ZXIvdjE7aG9tZXNlcnZlcnYxogIDSFhYqgINSG9tZXNlcnZlci5WMcoCDUhvbWVzZXJ2ZXJcVjHiAhlIb21lc2VydmVyXFYxXEdQQk1ldGFkYXRh6gIOSG9tZXNlcnZlcjo6VjFiBnByb3RvMw");
 
 /**
  * Describes the message homeserver.v1.Message.
@@ -106,3 +106,174 @@ export const JoinCommunityServerRequestSchema = /*@__PURE__*/
 export const JoinCommunityServerResponseSchema = /*@__PURE__*/
   messageDesc(file_homeserver_v1_homeserver, 9);
 
+/**
+ * Describes the message homeserver.v1.LeaveCommunityServerRequest.
+ * Use `create(LeaveCommunityServerRequestSchema)` to create a new message.
+ */
+export const LeaveCommunityServerRequestSchema = /*@__PURE__*/
+  messageDesc(file_homeserver_v1_homeserver, 10);
+
+/**
+ * Describes the message homeserver.v1.LeaveCommunityServerResponse.
+ * Use `create(LeaveCommunityServerResponseSchema)` to create a new message.
+ */
+export const LeaveCommunityServerResponseSchema = /*@__PURE__*/
+  messageDesc(file_homeserver_v1_homeserver, 11);
+
+/**
+ * Describes the message homeserver.v1.Notification.
+ * Use `create(NotificationSchema)` to create a new message.
+ */
+export const NotificationSchema = /*@__PURE__*/
+  messageDesc(file_homeserver_v1_homeserver, 12);
+
+/**
+ * Describes the enum homeserver.v1.Notification.Type.
+ */
+export const Notification_TypeSchema = /*@__PURE__*/
+  enumDesc(file_homeserver_v1_homeserver, 12, 0);
+
+/**
+ * @generated from enum homeserver.v1.Notification.Type
+ */
+export const Notification_Type = /*@__PURE__*/
+  tsEnum(Notification_TypeSchema);
+
+/**
+ * Describes the message homeserver.v1.DeliverNotificationsRequest.
+ * Use `create(DeliverNotificationsRequestSchema)` to create a new message.
+ */
+export const DeliverNotificationsRequestSchema = /*@__PURE__*/
+  messageDesc(file_homeserver_v1_homeserver, 13);
+
+/**
+ * Describes the message homeserver.v1.DeliverNotificationsResponse.
+ * Use `create(DeliverNotificationsResponseSchema)` to create a new message.
+ */
+export const DeliverNotificationsResponseSchema = /*@__PURE__*/
+  messageDesc(file_homeserver_v1_homeserver, 14);
+
+/**
+ * Describes the message homeserver.v1.GetNotificationsRequest.
+ * Use `create(GetNotificationsRequestSchema)` to create a new message.
+ */
+export const GetNotificationsRequestSchema = /*@__PURE__*/
+  messageDesc(file_homeserver_v1_homeserver, 15);
+
+/**
+ * Describes the message homeserver.v1.GetNotificationsResponse.
+ * Use `create(GetNotificationsResponseSchema)` to create a new message.
+ */
+export const GetNotificationsResponseSchema = /*@__PURE__*/
+  messageDesc(file_homeserver_v1_homeserver, 16);
+
+/**
+ * Describes the message homeserver.v1.AckNotificationsRequest.
+ * Use `create(AckNotificationsRequestSchema)` to create a new message.
+ */
+export const AckNotificationsRequestSchema = /*@__PURE__*/
+  messageDesc(file_homeserver_v1_homeserver, 17);
+
+/**
+ * Describes the message homeserver.v1.AckNotificationsResponse.
+ * Use `create(AckNotificationsResponseSchema)` to create a new message.
+ */
+export const AckNotificationsResponseSchema = /*@__PURE__*/
+  messageDesc(file_homeserver_v1_homeserver, 18);
+
+/**
+ * Describes the message homeserver.v1.Conversation.
+ * Use `create(ConversationSchema)` to create a new message.
+ */
+export const ConversationSchema = /*@__PURE__*/
+  messageDesc(file_homeserver_v1_homeserver, 19);
+
+/**
+ * Describes the message homeserver.v1.GetConversationsRequest.
+ * Use `create(GetConversationsRequestSchema)` to create a new message.
+ */
+export const GetConversationsRequestSchema = /*@__PURE__*/
+  messageDesc(file_homeserver_v1_homeserver, 20);
+
+/**
+ * Describes the message homeserver.v1.GetConversationsResponse.
+ * Use `create(GetConversationsResponseSchema)` to create a new message.
+ */
+export const GetConversationsResponseSchema = /*@__PURE__*/
+  messageDesc(file_homeserver_v1_homeserver, 21);
+
+/**
+ * Describes the message homeserver.v1.CreateConversationRequest.
+ * Use `create(CreateConversationRequestSchema)` to create a new message.
+ */
+export const CreateConversationRequestSchema = /*@__PURE__*/
+  messageDesc(file_homeserver_v1_homeserver, 22);
+
+/**
+ * Describes the message homeserver.v1.CreateConversationResponse.
+ * Use `create(CreateConversationResponseSchema)` to create a new message.
+ */
+export const CreateConversationResponseSchema = /*@__PURE__*/
+  messageDesc(file_homeserver_v1_homeserver, 23);
+
+/**
+ * Describes the message homeserver.v1.ConversationHost.
+ * Use `create(ConversationHostSchema)` to create a new message.
+ */
+export const ConversationHostSchema = /*@__PURE__*/
+  messageDesc(file_homeserver_v1_homeserver, 24);
+
+/**
+ * Describes the enum homeserver.v1.ConversationHost.Status.
+ */
+export const ConversationHost_StatusSchema = /*@__PURE__*/
+  enumDesc(file_homeserver_v1_homeserver, 24, 0);
+
+/**
+ * @generated from enum homeserver.v1.ConversationHost.Status
+ */
+export const ConversationHost_Status = /*@__PURE__*/
+  tsEnum(ConversationHost_StatusSchema);
+
+/**
+ * Describes the message homeserver.v1.GetConversationHostsRequest.
+ * Use `create(GetConversationHostsRequestSchema)` to create a new message.
+ */
+export const GetConversationHostsRequestSchema = /*@__PURE__*/
+  messageDesc(file_homeserver_v1_homeserver, 25);
+
+/**
+ * Describes the message homeserver.v1.GetConversationHostsResponse.
+ * Use `create(GetConversationHostsResponseSchema)` to create a new message.
+ */
+export const GetConversationHostsResponseSchema = /*@__PURE__*/
+  messageDesc(file_homeserver_v1_homeserver, 26);
+
+/**
+ * Describes the message homeserver.v1.SetConversationHostStatusRequest.
+ * Use `create(SetConversationHostStatusRequestSchema)` to create a new message.
+ */
+export const SetConversationHostStatusRequestSchema = /*@__PURE__*/
+  messageDesc(file_homeserver_v1_homeserver, 27);
+
+/**
+ * Describes the message homeserver.v1.SetConversationHostStatusResponse.
+ * Use `create(SetConversationHostStatusResponseSchema)` to create a new message.
+ */
+export const SetConversationHostStatusResponseSchema = /*@__PURE__*/
+  messageDesc(file_homeserver_v1_homeserver, 28);
+
+/**
+ * Describes the message homeserver.v1.RemoveConversationHostRequest.
+ * Use `create(RemoveConversationHostRequestSchema)` to create a new message.
+ */
+export const RemoveConversationHostRequestSchema = /*@__PURE__*/
+  messageDesc(file_homeserver_v1_homeserver, 29);
+
+/**
+ * Describes the message homeserver.v1.RemoveConversationHostResponse.
+ * Use `create(RemoveConversationHostResponseSchema)` to create a new message.
+ */
+export const RemoveConversationHostResponseSchema = /*@__PURE__*/
+  messageDesc(file_homeserver_v1_homeserver, 30);
+
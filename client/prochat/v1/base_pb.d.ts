@@ -52,6 +52,66 @@ export declare type CommunityGroup = Message<"prochat.v1.CommunityGroup"> & {
  */
 export declare const CommunityGroupSchema: GenMessage<CommunityGroup>;
 
+/**
+ * @generated from message prochat.v1.GetCommunityGroupsRequest
+ */
+export declare type GetCommunityGroupsRequest = Message<"prochat.v1.GetCommunityGroupsRequest"> & {
+};
+
+/**
+ * Describes the message prochat.v1.GetCommunityGroupsRequest.
+ * Use `create(GetCommunityGroupsRequestSchema)` to create a new message.
+ */
+export declare const GetCommunityGroupsRequestSchema: GenMessage<GetCommunityGroupsRequest>;
+
+/**
+ * @generated from message prochat.v1.GetCommunityGroupsResponse
+ */
+export declare type GetCommunityGroupsResponse = Message<"prochat.v1.GetCommunityGroupsResponse"> & {
+  /**
+   * @generated from field: repeated prochat.v1.CommunityGroup groups = 1;
+   */
+  groups: CommunityGroup[];
+};
+
+/**
+ * Describes the message prochat.v1.GetCommunityGroupsResponse.
+ * Use `create(GetCommunityGroupsResponseSchema)` to create a new message.
+ */
+export declare const GetCommunityGroupsResponseSchema: GenMessage<GetCommunityGroupsResponse>;
+
+/**
+ * @generated from message prochat.v1.SetCommunityGroupsRequest
+ */
+export declare type SetCommunityGroupsRequest = Message<"prochat.v1.SetCommunityGroupsRequest"> & {
+  /**
+   * @generated from field: repeated prochat.v1.CommunityGroup groups = 1;
+   */
+  groups: CommunityGroup[];
+};
+
+/**
+ * Describes the message prochat.v1.SetCommunityGroupsRequest.
+ * Use `create(SetCommunityGroupsRequestSchema)` to create a new message.
+ */
+export declare const SetCommunityGroupsRequestSchema: GenMessage<SetCommunityGroupsRequest>;
+
+/**
+ * @generated from message prochat.v1.SetCommunityGroupsResponse
+ */
+export declare type SetCommunityGroupsResponse = Message<"prochat.v1.SetCommunityGroupsResponse"> & {
+  /**
+   * @generated from field: repeated prochat.v1.CommunityGroup groups = 1;
+   */
+  groups: CommunityGroup[];
+};
+
+/**
+ * Describes the message prochat.v1.SetCommunityGroupsResponse.
+ * Use `create(SetCommunityGroupsResponseSchema)` to create a new message.
+ */
+export declare const SetCommunityGroupsResponseSchema: GenMessage<SetCommunityGroupsResponse>;
+
 /**
  * @generated from message prochat.v1.Community
  */
@@ -85,6 +145,11 @@ export declare type Community = Message<"prochat.v1.Community"> & {
    * @generated from field: repeated prochat.v1.Channel channels = 6;
    */
   channels: Channel[];
+
+  /**
+   * @generated from field: string host = 7;
+   */
+  host: string;
 };
 
 /**
@@ -8,7 +8,7 @@ import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
  * Describes the file prochat/v1/base.proto.
  */
 export const file_prochat_v1_base = /*@__PURE__*/
-  fileDesc("ChVwcm9jaGF0L3YxL2Jhc2UucHJvdG8SCnByb2NoYXQudjEiTQoaR2V0VXNlckNvbW11bml0aWVzUmVzcG9uc2USLwoLY29tbXVuaXRpZXMYASADKAsyGi5wcm9jaGF0LnYxLkNvbW11bml0eUdyb3VwIlYKDkNvbW11bml0eUdyb3VwEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSKgoLY29tbXVuaXRpZXMYAyADKAsyFS5wcm9jaGF0LnYxLkNvbW11bml0eSKTAQoJQ29tbXVuaXR5EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEAoIaWNvbl91cmwYAyABKAkSDgoGb25saW5lGAQgASgDEiMKB21lbWJlcnMYBSADKAsyEi5wcm9jaGF0LnYxLk1lbWJlchIlCghjaGFubmVscxgGIAMoCzITLnByb2NoYXQudjEuQ2hhbm5lbCIjCgdDaGFubmVsEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkiIgoGTWVtYmVyEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAlCrwEKDmNvbS5wcm9jaGF0LnYxQglCYXNlUHJvdG9QAVpJZ2l0aHViLmNvbS92YXJzby9wcm90Y2hhdC1zZXJ2ZXIvaW50ZXJuYWwvbW9kZWxzL2dlbi9wcm9jaGF0L3YxO3Byb2NoYXR2MaICA1BYWKoCClByb2NoYXQuVjHKAgpQcm9jaGF0XFYx4gIWUHJvY2hhdFxWMVxHUEJNZXRhZGF0YeoCC1Byb2NoYXQ6OlYxYgZwcm90bzM");
+  fileDesc("ChVwcm9jaGF0L3YxL2Jhc2UucHJvdG8SCnByb2NoYXQudjEiTQoaR2V0VXNlckNvbW11bml0aWVzUmVzcG9uc2USLwoLY29tbXVuaXRpZXMYASADKAsyGi5wcm9jaGF0LnYxLkNvbW11bml0eUdyb3VwIlYKDkNvbW11bml0eUdyb3VwEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSKgoLY29tbXVuaXRpZXMYAyADKAsyFS5wcm9jaGF0LnYxLkNvbW11bml0eSIbChlHZXRDb21tdW5pdHlHcm91cHNSZXF1ZXN0IkgKGkdldENvbW11bml0eUdyb3Vwc1Jlc3BvbnNlEioKBmdyb3VwcxgBIAMoCzIaLnByb2NoYXQudjEuQ29tbXVuaXR5R3JvdXAiRwoZU2V0Q29tbXVuaXR5R3JvdXBzUmVxdWVzdBIqCgZncm91cHMYASADKAsyGi5wcm9jaGF0LnYxLkNvbW11bml0eUdyb3VwIkgKGlNldENvbW11bml0eUdyb3Vwc1Jlc3BvbnNlEioKBmdyb3VwcxgBIAMoCzIaLnByb2NoYXQudjEuQ29tbXVuaXR5R3JvdXAioQEKCUNvbW11bml0eRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhAKCGljb25fdXJsGAMgASgJEg4KBm9ubGluZRgEIAEoAxIjCgdtZW1iZXJzGAUgAygLMhIucHJvY2hhdC52MS5NZW1iZXISJQoIY2hhbm5lbHMYBiADKAsyEy5wcm9jaGF0LnYxLkNoYW5uZWwSDAoEaG9zdBgHIAEoCSIjCgdDaGFubmVsEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkiIgoGTWVtYmVyEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAlCrwEKDmNvbS5wcm9jaGF0LnYxQglCYXNlUHJvdG9QAVpJZ2l0aHViLmNvbS92YXJzby9wcm90Y2hhdC1zZXJ2ZXIvaW50ZXJuYWwvbW9kZWxzL2dlbi9wcm9jaGF0L3YxO3Byb2NoYXR2MaICA1BYWKoCClByb2NoYXQuVjHKAgpQcm9jaGF0XFYx4gIWUHJvY2hhdFxWMVxHUEJNZXRhZGF0YeoCC1Byb2NoYXQ6OlYxYgZwcm90bzM");
 
 /**
  * Describes the message prochat.v1.GetUserCommunitiesResponse.
@@ -24,24 +24,52 @@ export const GetUserCommunitiesResponseSchema = /*@__PURE__*/
 export const CommunityGroupSchema = /*@__PURE__*/
   messageDesc(file_prochat_v1_base, 1);
 
+/**
+ * Describes the message prochat.v1.GetCommunityGroupsRequest.
+ * Use `create(GetCommunityGroupsRequestSchema)` to create a new message.
+ */
+export const GetCommunityGroupsRequestSchema = /*@__PURE__*/
+  messageDesc(file_prochat_v1_base, 2);
+
+/**
+ * Describes the message prochat.v1.GetCommunityGroupsResponse.
+ * Use `create(GetCommunityGroupsResponseSchema)` to create a new message.
+ */
+export const GetCommunityGroupsResponseSchema = /*@__PURE__*/
+  messageDesc(file_prochat_v1_base, 3);
+
+/**
+ * Describes the message prochat.v1.SetCommunityGroupsRequest.
+ * Use `create(SetCommunityGroupsRequestSchema)` to create a new message.
+ */
+export const SetCommunityGroupsRequestSchema = /*@__PURE__*/
+  messageDesc(file_prochat_v1_base, 4);
+
+/**
+ * Describes the message prochat.v1.SetCommunityGroupsResponse.
+ * Use `create(SetCommunityGroupsResponseSchema)` to create a new message.
+ */
+export const SetCommunityGroupsResponseSchema = /*@__PURE__*/
+  messageDesc(file_prochat_v1_base, 5);
+
 /**
  * Describes the message prochat.v1.Community.
  * Use `create(CommunitySchema)` to create a new message.
  */
 export const CommunitySchema = /*@__PURE__*/
-  messageDesc(file_prochat_v1_base, 2);
+  messageDesc(file_prochat_v1_base, 6);
 
 /**
  * Describes the message prochat.v1.Channel.
  * Use `create(ChannelSchema)` to create a new message.
  */
 export const ChannelSchema = /*@__PURE__*/
-  messageDesc(file_prochat_v1_base, 3);
+  messageDesc(file_prochat_v1_base, 7);
 
 /**
  * Describes the message prochat.v1.Member.
  * Use `create(MemberSchema)` to create a new message.
  */
 export const MemberSchema = /*@__PURE__*/
-  messageDesc(file_prochat_v1_base, 4);
+  messageDesc(file_prochat_v1_base, 8);
 
@@ -0,0 +1,486 @@
+package community
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+	"github.com/varsotech/prochat-server/internal/pkg/filestore"
+)
+
+const (
+	maxAttachmentSize           = 25 * 1024 * 1024
+	maxAttachmentsPerMessage    = 10
+	maxAttachmentFilenameLength = 255
+
+	// maxPendingAttachments caps the attachments a member uploaded to the channels of a community that aren't
+	// part of a message yet, so uploads can't be used as storage
+	maxPendingAttachments = 20
+
+	// pendingAttachmentTTL is how long uploaded attachments can wait to be sent before they are deleted
+	pendingAttachmentTTL = 24 * time.Hour
+
+	pendingAttachmentsCleanupInterval = time.Hour
+	maxExpiredAttachmentsPerCleanup   = 100
+)
+
+// attachmentContentTypes are the content types attachments may have, as detected from their content. Inline
+// content types are displayed by clients, while other attachments are only downloaded.
+var attachmentContentTypes = map[string]bool{
+	"image/png":       true,
+	"image/gif":       true,
+	"image/jpeg":      true,
+	"image/webp":      true,
+	"video/mp4":       true,
+	"video/webm":      true,
+	"audio/mpeg":      true,
+	"audio/ogg":       true,
+	"audio/wave":      true,
+	"application/ogg": true,
+	"application/pdf": false,
+	"application/zip": false,
+	"text/plain":      false,
+}
+
+var (
+	errInvalidAttachments        = errors.New("invalid attachments")
+	errTooManyPendingAttachments = errors.New("too many pending attachments")
+)
+
+// uploadAttachmentHandler uploads a file to a channel, to be attached to a message the caller sends to it.
+// The request body is the content of the file, and its name is given in the "filename" query parameter.
+// The content type of the file is detected from its content, and must be one of attachmentContentTypes.
+// Attachments that aren't sent within pendingAttachmentTTL are deleted.
+func (o *Routes) uploadAttachmentHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	channel, _, ok := o.getChannel(w, r, caller, PermissionSendMessages)
+	if !ok {
+		return
+	}
+
+	if isMuted(caller.Membership, time.Now()) {
+		http.Error(w, "Muted", http.StatusForbidden)
+		return
+	}
+
+	filename, ok := sanitizeAttachmentFilename(r.URL.Query().Get("filename"))
+	if !ok {
+		http.Error(w, "Invalid filename", http.StatusBadRequest)
+		return
+	}
+
+	// The limit is checked again when the attachment is inserted, but checking it first avoids reading uploads
+	// that would be rejected anyway
+	pending, err := o.communityDb.CountPendingMessageAttachments(r.Context(), communitydb.CountPendingMessageAttachmentsParams{
+		CommunityID: caller.CommunityID,
+		UploadedBy:  caller.Auth.UserAddress,
+	})
+	if err != nil {
+		slog.Error("could not count pending attachments", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	if pending >= maxPendingAttachments {
+		http.Error(w, "Too many pending attachments", http.StatusConflict)
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxAttachmentSize))
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err != nil {
+		slog.Error("failed to read attachment", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	if len(data) == 0 {
+		http.Error(w, "Empty attachment", http.StatusBadRequest)
+		return
+	}
+
+	contentType, ok := detectAttachmentContentType(data)
+	if !ok {
+		http.Error(w, http.StatusText(http.StatusUnsupportedMediaType), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	var width, height pgtype.Int4
+	if strings.HasPrefix(contentType, "image/") {
+		// Dimensions are best effort, since not every image format can be decoded
+		config, _, err := image.DecodeConfig(bytes.NewReader(data))
+		if err == nil {
+			width = pgtype.Int4{Int32: int32(config.Width), Valid: true}
+			height = pgtype.Int4{Int32: int32(config.Height), Valid: true}
+		}
+	}
+
+	attachment, err := o.insertAttachment(r.Context(), communitydb.InsertMessageAttachmentParams{
+		ID:          uuid.New(),
+		CommunityID: caller.CommunityID,
+		ChannelID:   channel.ID,
+		UploadedBy:  caller.Auth.UserAddress,
+		Filename:    filename,
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		Width:       width,
+		Height:      height,
+	}, data)
+	if errors.Is(err, errTooManyPendingAttachments) {
+		http.Error(w, "Too many pending attachments", http.StatusConflict)
+		return
+	}
+	if err != nil {
+		slog.Error("failed to insert attachment", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.writeProtoJson(w, &communityserverv1.UploadAttachmentResponse{
+		Attachment: attachmentToProto(attachment),
+	})
+}
+
+// insertAttachment stores the content of an attachment and inserts it, unless the uploader already has
+// maxPendingAttachments pending attachments in the community. The content is stored first so the community
+// isn't locked while it uploads, and is deleted again if the attachment isn't inserted.
+func (o *Routes) insertAttachment(ctx context.Context, params communitydb.InsertMessageAttachmentParams, data []byte) (communitydb.MessageAttachment, error) {
+	store := o.attachmentStore(params.CommunityID, params.ChannelID)
+	_, err := store.PutObject(ctx, params.ID.String(), bytes.NewReader(data))
+	if err != nil {
+		return communitydb.MessageAttachment{}, fmt.Errorf("failed to store attachment: %w", err)
+	}
+
+	attachment, err := o.insertAttachmentRow(ctx, params)
+	if err != nil {
+		deleteErr := store.DeleteObject(ctx, params.ID.String())
+		if deleteErr != nil {
+			slog.Error("failed to delete content of attachment that wasn't inserted", "error", deleteErr, "attachmentId", params.ID)
+		}
+
+		return communitydb.MessageAttachment{}, err
+	}
+
+	return attachment, nil
+}
+
+// insertAttachmentRow inserts an attachment under a lock of its community, so concurrent uploads can't exceed
+// maxPendingAttachments.
+func (o *Routes) insertAttachmentRow(ctx context.Context, params communitydb.InsertMessageAttachmentParams) (communitydb.MessageAttachment, error) {
+	tx, err := o.postgresClient.Begin(ctx)
+	if err != nil {
+		return communitydb.MessageAttachment{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := communitydb.New(tx)
+
+	_, err = queries.GetCommunityForUpdate(ctx, params.CommunityID)
+	if err != nil {
+		return communitydb.MessageAttachment{}, fmt.Errorf("failed to lock community: %w", err)
+	}
+
+	pending, err := queries.CountPendingMessageAttachments(ctx, communitydb.CountPendingMessageAttachmentsParams{
+		CommunityID: params.CommunityID,
+		UploadedBy:  params.UploadedBy,
+	})
+	if err != nil {
+		return communitydb.MessageAttachment{}, fmt.Errorf("failed to count pending attachments: %w", err)
+	}
+
+	if pending >= maxPendingAttachments {
+		return communitydb.MessageAttachment{}, errTooManyPendingAttachments
+	}
+
+	attachment, err := queries.InsertMessageAttachment(ctx, params)
+	if err != nil {
+		return communitydb.MessageAttachment{}, fmt.Errorf("failed to insert attachment: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return communitydb.MessageAttachment{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return attachment, nil
+}
+
+// getAttachmentHandler downloads an attachment. Attachments are only served to members that can view their
+// channel, and attachments that aren't part of a message yet are only served to the member that uploaded them.
+func (o *Routes) getAttachmentHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	channel, _, ok := o.getChannel(w, r, caller, PermissionViewChannel)
+	if !ok {
+		return
+	}
+
+	attachmentId, ok := pathUUID(w, r, "attachmentId")
+	if !ok {
+		return
+	}
+
+	attachment, err := o.communityDb.GetMessageAttachment(r.Context(), communitydb.GetMessageAttachmentParams{
+		ID:        attachmentId,
+		ChannelID: channel.ID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		slog.Error("could not get attachment", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	if !attachment.MessageID.Valid && attachment.UploadedBy != caller.Auth.UserAddress {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	// Attachments are deleted alongside their message, but messages deleted before that was the case may
+	// still have attachments
+	if attachment.MessageID.Valid {
+		message, err := o.communityDb.GetMessage(r.Context(), communitydb.GetMessageParams{
+			ID:        attachment.MessageID.Bytes,
+			ChannelID: channel.ID,
+		})
+		if err != nil {
+			slog.Error("could not get attachment message", "error", err)
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+			return
+		}
+
+		if message.DeletedAt.Valid {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+	}
+
+	object, err := o.attachmentStore(attachment.CommunityID, attachment.ChannelID).GetObject(r.Context(), attachment.ID.String())
+	if err != nil {
+		slog.Error("could not get attachment content", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+	defer object.Close()
+
+	disposition := "attachment"
+	if attachmentContentTypes[attachment.ContentType] {
+		disposition = "inline"
+	}
+
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": attachment.Filename}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Content-Security-Policy", "sandbox")
+	w.Header().Set("Cache-Control", "private, max-age=3600")
+	_, err = io.Copy(w, object)
+	if err != nil {
+		slog.Error("failed to write attachment", "error", err)
+	}
+}
+
+// attachMessageAttachments attaches attachments the author of a message uploaded to its channel to the
+// message, in the given order. It fails with errInvalidAttachments if any of the attachments isn't a pending
+// attachment of the author in the channel.
+func attachMessageAttachments(ctx context.Context, queries *communitydb.Queries, message communitydb.Message, attachmentIds []uuid.UUID) error {
+	if len(attachmentIds) == 0 {
+		return nil
+	}
+
+	attachments, err := queries.AttachMessageAttachments(ctx, communitydb.AttachMessageAttachmentsParams{
+		MessageID:  pgtype.UUID{Bytes: message.ID, Valid: true},
+		Ids:        attachmentIds,
+		ChannelID:  message.ChannelID,
+		UploadedBy: message.UserAddress,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to attach attachments: %w", err)
+	}
+
+	if len(attachments) != len(attachmentIds) {
+		return errInvalidAttachments
+	}
+
+	return nil
+}
+
+// RunAttachmentCleanup periodically deletes the pending attachments that weren't sent within
+// pendingAttachmentTTL, until the context is cancelled.
+func (o *Routes) RunAttachmentCleanup(ctx context.Context) error {
+	ticker := time.NewTicker(pendingAttachmentsCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			err := o.deleteExpiredPendingAttachments(ctx)
+			if err != nil {
+				slog.Error("failed to delete expired pending attachments", "error", err)
+			}
+		}
+	}
+}
+
+// deleteExpiredPendingAttachments deletes the pending attachments that weren't sent within
+// pendingAttachmentTTL, in batches of maxExpiredAttachmentsPerCleanup.
+func (o *Routes) deleteExpiredPendingAttachments(ctx context.Context) error {
+	expiredBefore := pgtype.Timestamptz{Time: time.Now().Add(-pendingAttachmentTTL), Valid: true}
+	for {
+		attachments, err := o.communityDb.DeleteExpiredPendingAttachments(ctx, communitydb.DeleteExpiredPendingAttachmentsParams{
+			ExpiredBefore: expiredBefore,
+			MaxResults:    maxExpiredAttachmentsPerCleanup,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to delete expired pending attachments: %w", err)
+		}
+
+		o.deleteAttachmentObjects(ctx, attachments)
+
+		if len(attachments) < maxExpiredAttachmentsPerCleanup {
+			return nil
+		}
+	}
+}
+
+// deleteAttachmentObjects deletes the content of attachments whose rows were deleted. Failures are logged,
+// since the attachments can no longer be served either way.
+func (o *Routes) deleteAttachmentObjects(ctx context.Context, attachments []communitydb.MessageAttachment) {
+	for _, attachment := range attachments {
+		err := o.attachmentStore(attachment.CommunityID, attachment.ChannelID).DeleteObject(ctx, attachment.ID.String())
+		if err != nil {
+			slog.Error("failed to delete attachment content", "error", err, "attachmentId", attachment.ID)
+		}
+	}
+}
+
+// parseAttachmentIds parses the IDs of the attachments of a message being sent, dropping duplicates.
+func parseAttachmentIds(ids []string) ([]uuid.UUID, error) {
+	if len(ids) > maxAttachmentsPerMessage {
+		return nil, errInvalidAttachments
+	}
+
+	var attachmentIds []uuid.UUID
+	seen := map[uuid.UUID]struct{}{}
+	for _, id := range ids {
+		attachmentId, err := uuid.Parse(id)
+		if err != nil {
+			return nil, errInvalidAttachments
+		}
+
+		if _, ok := seen[attachmentId]; ok {
+			continue
+		}
+		seen[attachmentId] = struct{}{}
+
+		attachmentIds = append(attachmentIds, attachmentId)
+	}
+
+	return attachmentIds, nil
+}
+
+// getMessagesAttachments returns the attachments of messages, by message ID.
+func (o *Routes) getMessagesAttachments(ctx context.Context, messages []communitydb.Message) (map[uuid.UUID][]*communityserverv1.Attachment, error) {
+	var messageIds []uuid.UUID
+	for _, message := range messages {
+		if message.HasAttachments && !message.DeletedAt.Valid {
+			messageIds = append(messageIds, message.ID)
+		}
+	}
+
+	attachmentsProto := map[uuid.UUID][]*communityserverv1.Attachment{}
+	if len(messageIds) == 0 {
+		return attachmentsProto, nil
+	}
+
+	attachments, err := o.communityDb.GetMessagesAttachments(ctx, messageIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message attachments: %w", err)
+	}
+
+	for _, attachment := range attachments {
+		attachmentsProto[attachment.MessageID.Bytes] = append(attachmentsProto[attachment.MessageID.Bytes], attachmentToProto(attachment))
+	}
+
+	return attachmentsProto, nil
+}
+
+// attachmentStore returns the file store of the attachments of a channel.
+func (o *Routes) attachmentStore(communityId uuid.UUID, channelId uuid.UUID) *filestore.Scope {
+	return filestore.NewScope(o.fileStore, path.Join(communityId.String(), "channels", channelId.String(), "attachments"))
+}
+
+// detectAttachmentContentType returns the content type of an attachment, without parameters, and reports
+// whether attachments may have it.
+func detectAttachmentContentType(data []byte) (string, bool) {
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(data))
+	if err != nil {
+		return "", false
+	}
+
+	_, ok := attachmentContentTypes[contentType]
+	return contentType, ok
+}
+
+// sanitizeAttachmentFilename strips the directories and control characters from the filename of an
+// attachment, and reports whether anything is left of it.
+func sanitizeAttachmentFilename(filename string) (string, bool) {
+	filename = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, filename)
+
+	filename = strings.TrimSpace(path.Base(strings.ReplaceAll(filename, "\\", "/")))
+	if filename == "" || filename == "." || filename == ".." || filename == "/" || utf8.RuneCountInString(filename) > maxAttachmentFilenameLength {
+		return "", false
+	}
+
+	return filename, true
+}
+
+func attachmentToProto(attachment communitydb.MessageAttachment) *communityserverv1.Attachment {
+	return &communityserverv1.Attachment{
+		Id:          attachment.ID.String(),
+		Filename:    attachment.Filename,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		Width:       attachment.Width.Int32,
+		Height:      attachment.Height.Int32,
+		Url:         fmt.Sprintf("/api/v1/community/%s/channels/%s/attachments/%s", attachment.CommunityID, attachment.ChannelID, attachment.ID),
+	}
+}
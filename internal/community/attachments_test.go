@@ -0,0 +1,32 @@
+package community
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSanitizeAttachmentFilename(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		want     string
+		wantOk   bool
+	}{
+		{name: "plain", filename: "report.pdf", want: "report.pdf", wantOk: true},
+		{name: "directories", filename: "../../etc/passwd", want: "passwd", wantOk: true},
+		{name: "windows directories", filename: "C:\\Users\\me\\photo.png", want: "photo.png", wantOk: true},
+		{name: "control characters", filename: "evil\r\n.txt", want: "evil.txt", wantOk: true},
+		{name: "empty", filename: "", wantOk: false},
+		{name: "only directories", filename: "../", wantOk: false},
+		{name: "too long", filename: strings.Repeat("a", maxAttachmentFilenameLength+1), wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := sanitizeAttachmentFilename(tt.filename)
+			if ok != tt.wantOk || got != tt.want {
+				t.Errorf("sanitizeAttachmentFilename(%q) = %q, %v, want %q, %v", tt.filename, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package community
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// auditLogReasonHeader lets privileged requests attach a reason to their audit log entry
+	auditLogReasonHeader    = "X-Audit-Log-Reason"
+	maxAuditLogReasonLength = 500
+)
+
+// auditLogEntry is a privileged action to record in the audit log of a community. Before and After are
+// snapshots of the target before and after the action, and are left nil when there is nothing to snapshot.
+type auditLogEntry struct {
+	Action   communityserverv1.AuditLogEntry_Action
+	TargetID string
+	Reason   string
+	Before   proto.Message
+	After    proto.Message
+}
+
+// recordAuditLog appends an entry to the audit log of the caller's community. The action has already been
+// performed when it is recorded, so failures are logged rather than failing the request.
+func (o *Routes) recordAuditLog(ctx context.Context, caller *communityMember, entry auditLogEntry) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		slog.Error("failed to generate audit log entry id", "error", err)
+		return
+	}
+
+	before, err := marshalAuditLogSnapshot(entry.Before)
+	if err != nil {
+		slog.Error("failed to marshal audit log before snapshot", "error", err)
+		return
+	}
+
+	after, err := marshalAuditLogSnapshot(entry.After)
+	if err != nil {
+		slog.Error("failed to marshal audit log after snapshot", "error", err)
+		return
+	}
+
+	err = o.communityDb.InsertAuditLogEntry(ctx, communitydb.InsertAuditLogEntryParams{
+		ID:               id,
+		CommunityID:      caller.CommunityID,
+		ActorUserAddress: caller.Member.UserAddress,
+		Action:           entry.Action.String(),
+		TargetID:         entry.TargetID,
+		Reason:           entry.Reason,
+		Before:           before,
+		After:            after,
+	})
+	if err != nil {
+		slog.Error("failed to insert audit log entry", "error", err, "action", entry.Action)
+	}
+}
+
+// getAuditLogHandler returns a page of the audit log of a community, ordered from newest to oldest. Pages
+// are selected using the "before" entry ID cursor, and entries can be filtered by action, actor and target.
+func (o *Routes) getAuditLogHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	_, ok = o.requirePermission(w, r, caller, PermissionViewAuditLog)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+
+	limit, err := parsePageSize(query.Get("limit"))
+	if err != nil {
+		http.Error(w, "Invalid limit", http.StatusBadRequest)
+		return
+	}
+
+	params := communitydb.GetAuditLogEntriesParams{
+		CommunityID: caller.CommunityID,
+		// Fetch one extra entry to know whether there are more entries to page through
+		MaxResults: limit + 1,
+	}
+
+	if query.Has("before") {
+		before, err := uuid.Parse(query.Get("before"))
+		if err != nil {
+			http.Error(w, "Invalid before cursor", http.StatusBadRequest)
+			return
+		}
+
+		params.Before = pgtype.UUID{Bytes: before, Valid: true}
+	}
+
+	if query.Has("action") {
+		action, ok := communityserverv1.AuditLogEntry_Action_value[query.Get("action")]
+		if !ok || action == int32(communityserverv1.AuditLogEntry_ACTION_UNSPECIFIED) {
+			http.Error(w, "Invalid action", http.StatusBadRequest)
+			return
+		}
+
+		params.Action = pgtype.Text{String: query.Get("action"), Valid: true}
+	}
+
+	if query.Has("actor") {
+		params.ActorUserAddress = pgtype.Text{String: query.Get("actor"), Valid: true}
+	}
+
+	if query.Has("target") {
+		params.TargetID = pgtype.Text{String: query.Get("target"), Valid: true}
+	}
+
+	entries, err := o.communityDb.GetAuditLogEntries(r.Context(), params)
+	if err != nil {
+		slog.Error("could not get audit log entries", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	hasMore := len(entries) > int(limit)
+	if hasMore {
+		entries = entries[:limit]
+	}
+
+	entriesProto := []*communityserverv1.AuditLogEntry{}
+	for _, entry := range entries {
+		entriesProto = append(entriesProto, auditLogEntryToProto(entry))
+	}
+
+	o.writeProtoJson(w, &communityserverv1.GetAuditLogResponse{
+		Entries: entriesProto,
+		HasMore: hasMore,
+	})
+}
+
+// auditReason returns the reason attached to a privileged request, if any.
+func auditReason(r *http.Request) string {
+	reason := strings.TrimSpace(r.Header.Get(auditLogReasonHeader))
+	if utf8.RuneCountInString(reason) > maxAuditLogReasonLength {
+		reason = string([]rune(reason)[:maxAuditLogReasonLength])
+	}
+
+	return reason
+}
+
+func marshalAuditLogSnapshot(snapshot proto.Message) ([]byte, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+
+	return protojson.Marshal(snapshot)
+}
+
+func auditLogEntryToProto(entry communitydb.AuditLogEntry) *communityserverv1.AuditLogEntry {
+	return &communityserverv1.AuditLogEntry{
+		Id:               entry.ID.String(),
+		ActorUserAddress: entry.ActorUserAddress,
+		Action:           communityserverv1.AuditLogEntry_Action(communityserverv1.AuditLogEntry_Action_value[entry.Action]),
+		TargetId:         entry.TargetID,
+		Reason:           entry.Reason,
+		Before:           string(entry.Before),
+		After:            string(entry.After),
+		CreatedAt:        formatTimestamp(entry.CreatedAt),
+	}
+}
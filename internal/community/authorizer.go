@@ -4,16 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
-	"net/http"
 	"net/url"
 	"strings"
 
 	"github.com/google/uuid"
 	"github.com/varsotech/prochat-server/internal/homeserver/identity"
-	homeserverv1 "github.com/varsotech/prochat-server/internal/models/gen/homeserver/v1"
 	"github.com/varsotech/prochat-server/internal/pkg/httputil"
-	"google.golang.org/protobuf/encoding/protojson"
 )
 
 var UnauthenticatedError = errors.New("request is unauthenticated")
@@ -23,12 +19,19 @@ type AuthenticationResult struct {
 }
 
 type IdentityAuthenticator struct {
-	httpClient *httputil.Client
+	httpClient           *httputil.Client
+	host                 string
+	allowMissingAudience bool
 }
 
-func NewIdentityAuthenticator() *IdentityAuthenticator {
+// NewIdentityAuthenticator authenticates identity tokens addressed to the server at host. Tokens without an
+// audience are accepted if allowMissingAudience is set, while homeservers that don't address their tokens yet
+// are upgraded.
+func NewIdentityAuthenticator(host string, allowMissingAudience bool) *IdentityAuthenticator {
 	return &IdentityAuthenticator{
-		httpClient: httputil.NewClient(),
+		httpClient:           httputil.NewClient(),
+		host:                 host,
+		allowMissingAudience: allowMissingAudience,
 	}
 }
 
@@ -50,23 +53,24 @@ func (a *IdentityAuthenticator) Authenticate(ctx context.Context, authorizationH
 	}
 
 	// 2. Parse issuer
-	if !strings.HasPrefix(unverifiedIssuer, "http://") && !strings.HasPrefix(unverifiedIssuer, "https://") {
-		unverifiedIssuer = "https://" + unverifiedIssuer
-	}
-
-	unverifiedIssuerUrl, err := url.Parse(unverifiedIssuer)
+	unverifiedIssuerUrl, err := identity.ParseIssuer(unverifiedIssuer)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse issuer url: %w", err)
+		return nil, err
 	}
 
 	// 3. Get public key from well known path
-	wellKnown, err := a.getWellKnown(ctx, unverifiedIssuerUrl)
+	wellKnown, err := identity.FetchWellKnown(ctx, a.httpClient, unverifiedIssuerUrl)
 	if err != nil {
 		return &AuthenticationResult{}, fmt.Errorf("failed to get well known issuer: %w", err)
 	}
 
-	// 4. Validate JWT
-	claims, err := identity.Parse(authToken, wellKnown.PublicKey)
+	// 4. Validate JWT, which must be addressed to this server
+	audience, err := identity.Audience(a.host)
+	if err != nil {
+		return &AuthenticationResult{}, fmt.Errorf("failed to get audience: %w", err)
+	}
+
+	claims, err := identity.Parse(authToken, wellKnown.PublicKey, audience, a.allowMissingAudience)
 	if err != nil {
 		return &AuthenticationResult{}, fmt.Errorf("failed to parse claims: %w", err)
 	}
@@ -103,35 +107,3 @@ func (a *IdentityAuthenticator) Authenticate(ctx context.Context, authorizationH
 		UserAddress: userAddress,
 	}, nil
 }
-
-func (a *IdentityAuthenticator) getWellKnown(ctx context.Context, u *url.URL) (*homeserverv1.WellKnown, error) {
-	wellKnownUrl := u.JoinPath("/.well-known/prochat.json").String()
-
-	req, err := http.NewRequestWithContext(ctx, "GET", wellKnownUrl, nil)
-	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
-	}
-
-	resp, err := a.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("error executing request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error executing request: %d %s", resp.StatusCode, resp.Status)
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error reading response body: %w", err)
-	}
-
-	var wellKnown homeserverv1.WellKnown
-	err = protojson.Unmarshal(body, &wellKnown)
-	if err != nil {
-		return nil, fmt.Errorf("error unmarshalling well known response: %w", err)
-	}
-
-	return &wellKnown, nil
-}
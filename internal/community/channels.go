@@ -0,0 +1,248 @@
+package community
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+)
+
+const (
+	defaultChannelName   = "general"
+	maxChannelNameLength = 100
+)
+
+func (o *Routes) getChannelsHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	channels, err := o.getVisibleChannels(r.Context(), caller)
+	if err != nil {
+		slog.Error("could not get visible channels", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	channelsProto := []*communityserverv1.Channel{}
+	for _, channel := range channels {
+		channelsProto = append(channelsProto, channelToProto(channel))
+	}
+
+	o.writeProtoJson(w, &communityserverv1.GetChannelsResponse{
+		Channels: channelsProto,
+	})
+}
+
+func (o *Routes) createChannelHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	_, ok = o.requirePermission(w, r, caller, PermissionManageChannels)
+	if !ok {
+		return
+	}
+
+	var req communityserverv1.CreateChannelRequest
+	if !o.readProtoJson(w, r, &req) {
+		return
+	}
+
+	name, ok := normalizeChannelName(req.Name)
+	if !ok {
+		http.Error(w, "Invalid channel name", http.StatusBadRequest)
+		return
+	}
+
+	channel, err := o.communityDb.InsertChannel(r.Context(), communitydb.InsertChannelParams{
+		ID:          uuid.New(),
+		CommunityID: caller.CommunityID,
+		Name:        name,
+	})
+	if err != nil {
+		slog.Error("failed to insert channel", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.publishChannelEvent(r.Context(), caller.CommunityID, channel.ID, communityserverv1.Event_TYPE_CHANNEL_CREATED, &communityserverv1.ChannelCreatedEvent{
+		Channel: channelToProto(channel),
+	})
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_CHANNEL_CREATE,
+		TargetID: channel.ID.String(),
+		Reason:   auditReason(r),
+		After:    channelToProto(channel),
+	})
+
+	o.writeProtoJson(w, &communityserverv1.CreateChannelResponse{
+		Channel: channelToProto(channel),
+	})
+}
+
+func (o *Routes) updateChannelHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	oldChannel, _, ok := o.getChannel(w, r, caller, PermissionManageChannels)
+	if !ok {
+		return
+	}
+
+	var req communityserverv1.UpdateChannelRequest
+	if !o.readProtoJson(w, r, &req) {
+		return
+	}
+
+	name, ok := normalizeChannelName(req.Name)
+	if !ok {
+		http.Error(w, "Invalid channel name", http.StatusBadRequest)
+		return
+	}
+
+	channel, err := o.communityDb.UpdateChannelName(r.Context(), communitydb.UpdateChannelNameParams{
+		ID:          oldChannel.ID,
+		CommunityID: caller.CommunityID,
+		Name:        name,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		slog.Error("failed to update channel name", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.publishChannelEvent(r.Context(), caller.CommunityID, channel.ID, communityserverv1.Event_TYPE_CHANNEL_UPDATED, &communityserverv1.ChannelUpdatedEvent{
+		Channel: channelToProto(channel),
+	})
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_CHANNEL_UPDATE,
+		TargetID: channel.ID.String(),
+		Reason:   auditReason(r),
+		Before:   channelToProto(oldChannel),
+		After:    channelToProto(channel),
+	})
+
+	o.writeProtoJson(w, &communityserverv1.UpdateChannelResponse{
+		Channel: channelToProto(channel),
+	})
+}
+
+func (o *Routes) deleteChannelHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	channelId, ok := pathUUID(w, r, "channelId")
+	if !ok {
+		return
+	}
+
+	_, ok = o.requireChannelPermission(w, r, caller, channelId, PermissionManageChannels)
+	if !ok {
+		return
+	}
+
+	channel, err := o.deleteChannel(r.Context(), caller.CommunityID, channelId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		slog.Error("failed to delete channel", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.publishEvent(r.Context(), caller.CommunityID, communityserverv1.Event_TYPE_CHANNEL_DELETED, &communityserverv1.ChannelDeletedEvent{
+		ChannelId: channel.ID.String(),
+	})
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_CHANNEL_DELETE,
+		TargetID: channel.ID.String(),
+		Reason:   auditReason(r),
+		Before:   channelToProto(channel),
+	})
+
+	o.writeProtoJson(w, &communityserverv1.DeleteChannelResponse{})
+}
+
+// deleteChannel deletes a channel alongside the content of its attachments. The channel is locked first, so
+// attachments uploaded while it is deleted either fail to insert or are deleted with it.
+func (o *Routes) deleteChannel(ctx context.Context, communityId uuid.UUID, channelId uuid.UUID) (communitydb.Channel, error) {
+	tx, err := o.postgresClient.Begin(ctx)
+	if err != nil {
+		return communitydb.Channel{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := communitydb.New(tx)
+
+	_, err = queries.GetChannelForUpdate(ctx, communitydb.GetChannelForUpdateParams{
+		ID:          channelId,
+		CommunityID: communityId,
+	})
+	if err != nil {
+		return communitydb.Channel{}, fmt.Errorf("failed to lock channel: %w", err)
+	}
+
+	attachments, err := queries.DeleteChannelAttachments(ctx, channelId)
+	if err != nil {
+		return communitydb.Channel{}, fmt.Errorf("failed to delete channel attachments: %w", err)
+	}
+
+	channel, err := queries.DeleteChannel(ctx, communitydb.DeleteChannelParams{
+		ID:          channelId,
+		CommunityID: communityId,
+	})
+	if err != nil {
+		return communitydb.Channel{}, fmt.Errorf("failed to delete channel: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return communitydb.Channel{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	o.deleteAttachmentObjects(ctx, attachments)
+
+	return channel, nil
+}
+
+// normalizeChannelName trims the channel name, and reports whether it is valid.
+func normalizeChannelName(name string) (string, bool) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxChannelNameLength {
+		return "", false
+	}
+
+	return name, true
+}
+
+func channelToProto(channel communitydb.Channel) *communityserverv1.Channel {
+	return &communityserverv1.Channel{
+		Id:              channel.ID.String(),
+		Name:            channel.Name,
+		SlowModeSeconds: channel.SlowModeSeconds,
+	}
+}
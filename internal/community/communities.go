@@ -0,0 +1,331 @@
+package community
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+)
+
+const (
+	maxCommunityNameLength = 100
+	maxIconUrlLength       = 2048
+)
+
+func (o *Routes) getCommunityHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	community, err := o.communityDb.GetCommunity(r.Context(), caller.CommunityID)
+	if err != nil {
+		slog.Error("could not get community", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.writeProtoJson(w, &communityserverv1.GetCommunityResponse{
+		Community: o.communityToProto(community),
+	})
+}
+
+// createCommunityHandler creates a community owned by the caller, if the creation policy of the server
+// allows it. New communities come with a default channel, like the default community of the server.
+func (o *Routes) createCommunityHandler(w http.ResponseWriter, r *http.Request) {
+	auth, ok := o.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	if !o.creationPolicy.Allows(auth.UserAddress) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	var req communityserverv1.CreateCommunityRequest
+	if !o.readProtoJson(w, r, &req) {
+		return
+	}
+
+	name, iconUrl, ok := validateCommunity(w, req.Name, req.IconUrl)
+	if !ok {
+		return
+	}
+
+	member, err := o.upsertMember(r.Context(), auth.UserAddress)
+	if err != nil {
+		slog.Error("failed to upsert member", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	community, err := o.insertCommunity(r.Context(), member, name, iconUrl)
+	if err != nil {
+		slog.Error("failed to create community", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Subscribes the gateway clients of the owner to the new community
+	o.publishEvent(r.Context(), community.ID, communityserverv1.Event_TYPE_MEMBER_JOINED, &communityserverv1.MemberJoinedEvent{
+		UserAddress: member.UserAddress,
+	})
+
+	o.writeProtoJson(w, &communityserverv1.CreateCommunityResponse{
+		Community: o.communityToProto(community),
+	})
+}
+
+func (o *Routes) updateCommunityHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	_, ok = o.requirePermission(w, r, caller, PermissionManageCommunity)
+	if !ok {
+		return
+	}
+
+	var req communityserverv1.UpdateCommunityRequest
+	if !o.readProtoJson(w, r, &req) {
+		return
+	}
+
+	name, iconUrl, ok := validateCommunity(w, req.Name, req.IconUrl)
+	if !ok {
+		return
+	}
+
+	oldCommunity, err := o.communityDb.GetCommunity(r.Context(), caller.CommunityID)
+	if err != nil {
+		slog.Error("could not get community", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	community, err := o.communityDb.UpdateCommunity(r.Context(), communitydb.UpdateCommunityParams{
+		ID:      caller.CommunityID,
+		Name:    name,
+		IconUrl: iconUrl,
+	})
+	if err != nil {
+		slog.Error("failed to update community", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.publishEvent(r.Context(), caller.CommunityID, communityserverv1.Event_TYPE_COMMUNITY_UPDATED, &communityserverv1.CommunityUpdatedEvent{
+		Community: o.communityToProto(community),
+	})
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_COMMUNITY_UPDATE,
+		TargetID: community.ID.String(),
+		Reason:   auditReason(r),
+		Before:   o.communityToProto(oldCommunity),
+		After:    o.communityToProto(community),
+	})
+
+	o.writeProtoJson(w, &communityserverv1.UpdateCommunityResponse{
+		Community: o.communityToProto(community),
+	})
+}
+
+// deleteCommunityHandler deletes a community alongside everything in it. Only the owner can delete a
+// community, and the default community of the server can't be deleted.
+func (o *Routes) deleteCommunityHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	community, err := o.communityDb.GetCommunity(r.Context(), caller.CommunityID)
+	if err != nil {
+		slog.Error("could not get community", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	if !isOwner(community, caller.Member) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	if community.IsDefault {
+		http.Error(w, "The default community can't be deleted", http.StatusBadRequest)
+		return
+	}
+
+	deleted, err := o.deleteCommunity(r.Context(), community.ID)
+	if err != nil {
+		slog.Error("failed to delete community", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	if deleted == 0 {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	o.publishEvent(r.Context(), community.ID, communityserverv1.Event_TYPE_COMMUNITY_DELETED, &communityserverv1.CommunityDeletedEvent{
+		CommunityId: community.ID.String(),
+	})
+
+	o.writeProtoJson(w, &communityserverv1.DeleteCommunityResponse{})
+}
+
+// deleteCommunity deletes a community alongside the content of its attachments and custom emoji images. The
+// community is locked first, so attachments and custom emojis added while it is deleted either fail to insert
+// or are deleted with it.
+func (o *Routes) deleteCommunity(ctx context.Context, communityId uuid.UUID) (int64, error) {
+	tx, err := o.postgresClient.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := communitydb.New(tx)
+
+	_, err = queries.GetCommunityForUpdate(ctx, communityId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to lock community: %w", err)
+	}
+
+	attachments, err := queries.DeleteCommunityAttachments(ctx, communityId)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete community attachments: %w", err)
+	}
+
+	emojis, err := queries.DeleteCommunityCustomEmojis(ctx, communityId)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete community custom emojis: %w", err)
+	}
+
+	deleted, err := queries.DeleteCommunity(ctx, communityId)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete community: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	o.deleteAttachmentObjects(ctx, attachments)
+	o.deleteCustomEmojiObjects(ctx, emojis)
+
+	return deleted, nil
+}
+
+// insertCommunity creates a community with a default channel, and makes the member its owner.
+func (o *Routes) insertCommunity(ctx context.Context, member communitydb.Member, name string, iconUrl string) (communitydb.Community, error) {
+	tx, err := o.postgresClient.Begin(ctx)
+	if err != nil {
+		return communitydb.Community{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := communitydb.New(tx)
+
+	community, err := queries.InsertCommunity(ctx, communitydb.InsertCommunityParams{
+		ID:      uuid.New(),
+		Name:    name,
+		IconUrl: iconUrl,
+	})
+	if err != nil {
+		return communitydb.Community{}, fmt.Errorf("failed to insert community: %w", err)
+	}
+
+	_, err = queries.InsertChannel(ctx, communitydb.InsertChannelParams{
+		ID:          uuid.New(),
+		CommunityID: community.ID,
+		Name:        defaultChannelName,
+	})
+	if err != nil {
+		return communitydb.Community{}, fmt.Errorf("failed to insert default channel: %w", err)
+	}
+
+	_, err = queries.UpsertCommunityMember(ctx, communitydb.UpsertCommunityMemberParams{
+		ID:          uuid.New(),
+		MemberID:    member.ID,
+		CommunityID: community.ID,
+	})
+	if err != nil {
+		return communitydb.Community{}, fmt.Errorf("failed to insert owner community member: %w", err)
+	}
+
+	err = queries.SetCommunityOwner(ctx, communitydb.SetCommunityOwnerParams{
+		ID:            community.ID,
+		OwnerMemberID: pgtype.UUID{Bytes: member.ID, Valid: true},
+	})
+	if err != nil {
+		return communitydb.Community{}, fmt.Errorf("failed to set community owner: %w", err)
+	}
+
+	community.OwnerMemberID = pgtype.UUID{Bytes: member.ID, Valid: true}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return communitydb.Community{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return community, nil
+}
+
+// validateCommunity validates the name and icon URL of a community, and writes the error response if they
+// are invalid. An empty icon URL means the community has no icon.
+func validateCommunity(w http.ResponseWriter, name string, iconUrl string) (string, string, bool) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxCommunityNameLength {
+		http.Error(w, "Invalid community name", http.StatusBadRequest)
+		return "", "", false
+	}
+
+	iconUrl = strings.TrimSpace(iconUrl)
+	if iconUrl == "" {
+		return name, "", true
+	}
+
+	parsedUrl, err := url.Parse(iconUrl)
+	if err != nil || len(iconUrl) > maxIconUrlLength || (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") || parsedUrl.Host == "" {
+		http.Error(w, "Invalid icon url", http.StatusBadRequest)
+		return "", "", false
+	}
+
+	return name, iconUrl, true
+}
+
+// communityToProto converts a community to its proto. Icons are served through the image proxy, so clients
+// don't fetch them from arbitrary hosts.
+func (o *Routes) communityToProto(community communitydb.Community) *communityserverv1.Community {
+	return &communityserverv1.Community{
+		Id:        community.ID.String(),
+		Name:      community.Name,
+		IconUrl:   o.iconUrl(community),
+		IsDefault: community.IsDefault,
+	}
+}
+
+func (o *Routes) iconUrl(community communitydb.Community) string {
+	if community.IconUrl == "" {
+		return ""
+	}
+
+	return o.urlSigner.GenerateSignedURL(community.IconUrl)
+}
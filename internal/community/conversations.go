@@ -0,0 +1,435 @@
+package community
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/varsotech/prochat-server/internal/homeserver/identity"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	homeserverv1 "github.com/varsotech/prochat-server/internal/models/gen/homeserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+)
+
+const (
+	// maxConversationParticipants is the amount of users in a conversation, including its creator
+	maxConversationParticipants = 10
+
+	// maxConversations is the amount of conversations returned to a user, most recently active first
+	maxConversations = 100
+)
+
+var errInvalidParticipants = errors.New("invalid conversation participants")
+
+// createConversationHandler creates a direct message conversation between the caller and the given users,
+// who may be on any homeserver. Conversations are hosted by the server of the homeserver of their creator, so
+// only its local users can create them. Creating a 1:1 conversation that exists returns the existing one.
+func (o *Routes) createConversationHandler(w http.ResponseWriter, r *http.Request) {
+	auth, ok := o.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	if userAddressHost(auth.UserAddress) != strings.ToLower(o.host) {
+		http.Error(w, "Conversations can only be created by local users", http.StatusForbidden)
+		return
+	}
+
+	var req communityserverv1.CreateConversationRequest
+	if !o.readProtoJson(w, r, &req) {
+		return
+	}
+
+	participants, err := parseConversationParticipants(auth.UserAddress, req.ParticipantAddresses)
+	if err != nil {
+		http.Error(w, "Invalid participants", http.StatusBadRequest)
+		return
+	}
+
+	conversation, err := o.createConversation(r.Context(), strings.ToLower(auth.UserAddress), participants)
+	if err != nil {
+		slog.Error("failed to create conversation", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	conversationsProto, err := o.conversationsToProto(r.Context(), []communitydb.Conversation{conversation})
+	if err != nil {
+		slog.Error("could not convert conversation", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.writeProtoJson(w, &communityserverv1.CreateConversationResponse{
+		Conversation: conversationsProto[0],
+	})
+}
+
+// createConversation inserts a conversation with its participants. 1:1 conversations are keyed by their
+// participants, so concurrent requests for the same pair of users return the same conversation.
+func (o *Routes) createConversation(ctx context.Context, createdBy string, participants []string) (communitydb.Conversation, error) {
+	var directKey pgtype.Text
+	if len(participants) == 2 {
+		directKey = pgtype.Text{String: strings.Join(participants, ","), Valid: true}
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return communitydb.Conversation{}, fmt.Errorf("failed to generate conversation id: %w", err)
+	}
+
+	tx, err := o.postgresClient.Begin(ctx)
+	if err != nil {
+		return communitydb.Conversation{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := communitydb.New(tx)
+
+	conversation, err := queries.InsertConversation(ctx, communitydb.InsertConversationParams{
+		ID:        id,
+		DirectKey: directKey,
+		CreatedBy: createdBy,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		conversation, err = o.communityDb.GetConversationByDirectKey(ctx, directKey)
+		if err != nil {
+			return communitydb.Conversation{}, fmt.Errorf("failed to get direct conversation: %w", err)
+		}
+
+		return conversation, nil
+	}
+	if err != nil {
+		return communitydb.Conversation{}, fmt.Errorf("failed to insert conversation: %w", err)
+	}
+
+	err = queries.InsertConversationParticipants(ctx, communitydb.InsertConversationParticipantsParams{
+		ConversationID: conversation.ID,
+		UserAddresses:  participants,
+	})
+	if err != nil {
+		return communitydb.Conversation{}, fmt.Errorf("failed to insert conversation participants: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return communitydb.Conversation{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return conversation, nil
+}
+
+// getConversationsHandler returns the conversations hosted by this server that the caller participates in,
+// most recently active first.
+func (o *Routes) getConversationsHandler(w http.ResponseWriter, r *http.Request) {
+	auth, ok := o.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	conversations, err := o.communityDb.GetUserConversations(r.Context(), communitydb.GetUserConversationsParams{
+		UserAddress: strings.ToLower(auth.UserAddress),
+		MaxResults:  maxConversations,
+	})
+	if err != nil {
+		slog.Error("could not get conversations", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	conversationsProto, err := o.conversationsToProto(r.Context(), conversations)
+	if err != nil {
+		slog.Error("could not convert conversations", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.writeProtoJson(w, &communityserverv1.GetConversationsResponse{
+		Conversations: conversationsProto,
+	})
+}
+
+// getConversationMessagesHandler returns a page of the messages of a conversation, ordered from oldest to
+// newest. The "before" cursor pages backwards from a message, and no cursor returns the latest messages.
+func (o *Routes) getConversationMessagesHandler(w http.ResponseWriter, r *http.Request) {
+	auth, ok := o.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	conversation, ok := o.getConversation(w, r, auth)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+
+	limit, err := parsePageSize(query.Get("limit"))
+	if err != nil {
+		http.Error(w, "Invalid limit", http.StatusBadRequest)
+		return
+	}
+
+	var before pgtype.UUID
+	if query.Has("before") {
+		beforeId, err := uuid.Parse(query.Get("before"))
+		if err != nil {
+			http.Error(w, "Invalid before cursor", http.StatusBadRequest)
+			return
+		}
+
+		before = pgtype.UUID{Bytes: beforeId, Valid: true}
+	}
+
+	// Fetch one extra message to know whether there are more messages to page through
+	messages, err := o.communityDb.GetConversationMessages(r.Context(), communitydb.GetConversationMessagesParams{
+		ConversationID: conversation.ID,
+		Before:         before,
+		MaxResults:     limit + 1,
+	})
+	if err != nil {
+		slog.Error("could not get conversation messages", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	hasMore := len(messages) > int(limit)
+	if hasMore {
+		messages = messages[:limit]
+	}
+
+	slices.Reverse(messages)
+
+	messagesProto := []*communityserverv1.ConversationMessage{}
+	for _, message := range messages {
+		messagesProto = append(messagesProto, conversationMessageToProto(message))
+	}
+
+	o.writeProtoJson(w, &communityserverv1.GetConversationMessagesResponse{
+		Messages: messagesProto,
+		HasMore:  hasMore,
+	})
+}
+
+// sendConversationMessageHandler sends a message to a conversation, and notifies the other participants
+// through their homeservers. Messages count against the send burst limit of the caller.
+func (o *Routes) sendConversationMessageHandler(w http.ResponseWriter, r *http.Request) {
+	auth, ok := o.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	conversation, ok := o.getConversation(w, r, auth)
+	if !ok {
+		return
+	}
+
+	// Participants are stored with lowercase addresses, so authors are too
+	userAddress := strings.ToLower(auth.UserAddress)
+
+	var req communityserverv1.SendConversationMessageRequest
+	if !o.readProtoJson(w, r, &req) {
+		return
+	}
+
+	if strings.TrimSpace(req.Body) == "" || utf8.RuneCountInString(req.Body) > maxMessageBodyLength {
+		http.Error(w, "Invalid message body", http.StatusBadRequest)
+		return
+	}
+
+	scope, retryAfter, err := o.sendLimits.allow(r.Context(), conversation.ID, 0, userAddress)
+	if err != nil {
+		slog.Error("failed to check send limits", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	if scope != communityserverv1.RateLimitedError_SCOPE_UNSPECIFIED {
+		writeRateLimited(w, scope, retryAfter)
+		return
+	}
+
+	message, err := o.insertConversationMessage(r.Context(), conversation.ID, userAddress, req.Body)
+	if err != nil {
+		slog.Error("failed to insert conversation message", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+
+		// Failed messages don't count against the rate limits
+		err = o.sendLimits.refund(r.Context(), conversation.ID, 0, userAddress)
+		if err != nil {
+			slog.Error("failed to refund send limits", "error", err)
+		}
+		return
+	}
+
+	participants, err := o.communityDb.GetConversationsParticipants(r.Context(), []uuid.UUID{conversation.ID})
+	if err != nil {
+		slog.Error("could not get conversation participants", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	var recipients []string
+	for _, participant := range participants {
+		if participant.UserAddress != userAddress {
+			recipients = append(recipients, participant.UserAddress)
+		}
+	}
+
+	o.notifier.notify(&homeserverv1.Notification{
+		Type:           homeserverv1.Notification_TYPE_DIRECT_MESSAGE,
+		ConversationId: conversation.ID.String(),
+		MessageId:      message.ID.String(),
+		AuthorAddress:  message.UserAddress,
+		Body:           truncateRunes(message.Body, identity.MaxNotificationBodyLength),
+	}, recipients)
+
+	o.writeProtoJson(w, &communityserverv1.SendConversationMessageResponse{
+		Message: conversationMessageToProto(message),
+	})
+}
+
+// insertConversationMessage inserts a message to a conversation, and bumps the last activity of the
+// conversation.
+func (o *Routes) insertConversationMessage(ctx context.Context, conversationId uuid.UUID, userAddress string, body string) (communitydb.ConversationMessage, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return communitydb.ConversationMessage{}, fmt.Errorf("failed to generate message id: %w", err)
+	}
+
+	tx, err := o.postgresClient.Begin(ctx)
+	if err != nil {
+		return communitydb.ConversationMessage{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := communitydb.New(tx)
+
+	message, err := queries.InsertConversationMessage(ctx, communitydb.InsertConversationMessageParams{
+		ID:             id,
+		ConversationID: conversationId,
+		UserAddress:    userAddress,
+		Body:           body,
+	})
+	if err != nil {
+		return communitydb.ConversationMessage{}, fmt.Errorf("failed to insert message: %w", err)
+	}
+
+	err = queries.UpdateConversationLastMessage(ctx, communitydb.UpdateConversationLastMessageParams{
+		ID:            conversationId,
+		LastMessageAt: message.CreatedAt,
+	})
+	if err != nil {
+		return communitydb.ConversationMessage{}, fmt.Errorf("failed to update conversation last message: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return communitydb.ConversationMessage{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return message, nil
+}
+
+// getConversation gets the conversation in the {conversationId} path parameter. Conversations the caller
+// doesn't participate in are reported as not found, so their existence isn't leaked.
+func (o *Routes) getConversation(w http.ResponseWriter, r *http.Request, auth *AuthenticationResult) (communitydb.Conversation, bool) {
+	conversationId, ok := pathUUID(w, r, "conversationId")
+	if !ok {
+		return communitydb.Conversation{}, false
+	}
+
+	conversation, err := o.communityDb.GetConversation(r.Context(), communitydb.GetConversationParams{
+		ID:          conversationId,
+		UserAddress: strings.ToLower(auth.UserAddress),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return communitydb.Conversation{}, false
+	}
+	if err != nil {
+		slog.Error("could not get conversation", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return communitydb.Conversation{}, false
+	}
+
+	return conversation, true
+}
+
+// parseConversationParticipants validates the addresses of the participants of a new conversation, and
+// returns them lowercased and sorted with the creator included. Addresses are compared case-insensitively, so
+// participants are stored lowercase.
+func parseConversationParticipants(createdBy string, userAddresses []string) ([]string, error) {
+	participants := []string{strings.ToLower(createdBy)}
+	for _, userAddress := range userAddresses {
+		if !userAddressRegexp.MatchString(userAddress) {
+			return nil, errInvalidParticipants
+		}
+
+		userAddress = strings.ToLower(userAddress)
+
+		if !slices.Contains(participants, userAddress) {
+			participants = append(participants, userAddress)
+		}
+	}
+
+	if len(participants) < 2 || len(participants) > maxConversationParticipants {
+		return nil, errInvalidParticipants
+	}
+
+	slices.Sort(participants)
+	return participants, nil
+}
+
+// conversationsToProto converts conversations to their protos, alongside their participants.
+func (o *Routes) conversationsToProto(ctx context.Context, conversations []communitydb.Conversation) ([]*communityserverv1.Conversation, error) {
+	conversationsProto := []*communityserverv1.Conversation{}
+	if len(conversations) == 0 {
+		return conversationsProto, nil
+	}
+
+	var conversationIds []uuid.UUID
+	for _, conversation := range conversations {
+		conversationIds = append(conversationIds, conversation.ID)
+	}
+
+	participants, err := o.communityDb.GetConversationsParticipants(ctx, conversationIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get conversation participants: %w", err)
+	}
+
+	participantsByConversation := map[uuid.UUID][]string{}
+	for _, participant := range participants {
+		participantsByConversation[participant.ConversationID] = append(participantsByConversation[participant.ConversationID], participant.UserAddress)
+	}
+
+	for _, conversation := range conversations {
+		conversationsProto = append(conversationsProto, &communityserverv1.Conversation{
+			Id:                   conversation.ID.String(),
+			ParticipantAddresses: participantsByConversation[conversation.ID],
+			CreatedBy:            conversation.CreatedBy,
+			CreatedAt:            formatTimestamp(conversation.CreatedAt),
+			LastMessageAt:        formatTimestamp(conversation.LastMessageAt),
+		})
+	}
+
+	return conversationsProto, nil
+}
+
+func conversationMessageToProto(message communitydb.ConversationMessage) *communityserverv1.ConversationMessage {
+	return &communityserverv1.ConversationMessage{
+		Id:             message.ID.String(),
+		ConversationId: message.ConversationID.String(),
+		UserAddress:    message.UserAddress,
+		Body:           message.Body,
+		CreatedAt:      formatTimestamp(message.CreatedAt),
+	}
+}
@@ -0,0 +1,82 @@
+package community
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseConversationParticipants(t *testing.T) {
+	const (
+		caller = "0b7c2a4e-3f1d-4c9a-8e2b-1a2b3c4d5e6f@a.example.com"
+		alice  = "1c8d3b5f-4a2e-4dab-9f3c-2b3c4d5e6f70@b.example.com:8443"
+		bob    = "0a6b1939-2e0c-4b89-7d1a-09a1b2c3d4e5@a.example.com"
+	)
+
+	tests := []struct {
+		name    string
+		users   []string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "direct",
+			users: []string{alice},
+			want:  []string{caller, alice},
+		},
+		{
+			name:  "group sorted and deduplicated",
+			users: []string{alice, bob, alice, caller},
+			want:  []string{bob, caller, alice},
+		},
+		{
+			name:  "addresses lowercased",
+			users: []string{strings.ToUpper(alice), strings.ToUpper(caller)},
+			want:  []string{caller, alice},
+		},
+		{
+			name:    "only the caller",
+			users:   []string{caller},
+			wantErr: true,
+		},
+		{
+			name:    "invalid address",
+			users:   []string{"alice@b.example.com"},
+			wantErr: true,
+		},
+		{
+			name:    "unanchored address",
+			users:   []string{alice + "/path"},
+			wantErr: true,
+		},
+		{
+			name: "too many participants",
+			users: []string{
+				"00000000-0000-4000-8000-000000000001@b.example.com",
+				"00000000-0000-4000-8000-000000000002@b.example.com",
+				"00000000-0000-4000-8000-000000000003@b.example.com",
+				"00000000-0000-4000-8000-000000000004@b.example.com",
+				"00000000-0000-4000-8000-000000000005@b.example.com",
+				"00000000-0000-4000-8000-000000000006@b.example.com",
+				"00000000-0000-4000-8000-000000000007@b.example.com",
+				"00000000-0000-4000-8000-000000000008@b.example.com",
+				"00000000-0000-4000-8000-000000000009@b.example.com",
+				"00000000-0000-4000-8000-000000000010@b.example.com",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseConversationParticipants(caller, tt.users)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseConversationParticipants() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("parseConversationParticipants() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package community
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Community creation policies, as configured by the server operator.
+const (
+	CreationPolicyAnyone    = "anyone"
+	CreationPolicyAllowlist = "allowlist"
+	CreationPolicyNobody    = "nobody"
+)
+
+// CreationPolicy decides which users may create communities on the server.
+type CreationPolicy struct {
+	policy    string
+	allowlist map[string]struct{}
+}
+
+// ParseCreationPolicy parses the community creation policy of the server. The allowlist is only used by the
+// allowlist policy, and is a comma separated list of user addresses, or of homeserver hosts to allow every
+// user of the host. Servers that don't configure a policy don't allow creating communities.
+func ParseCreationPolicy(policy string, allowlist string) (*CreationPolicy, error) {
+	if policy == "" {
+		policy = CreationPolicyNobody
+	}
+
+	switch policy {
+	case CreationPolicyAnyone, CreationPolicyAllowlist, CreationPolicyNobody:
+	default:
+		return nil, fmt.Errorf("unknown community creation policy %q", policy)
+	}
+
+	creationPolicy := &CreationPolicy{
+		policy:    policy,
+		allowlist: map[string]struct{}{},
+	}
+
+	for _, entry := range strings.Split(allowlist, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry != "" {
+			creationPolicy.allowlist[entry] = struct{}{}
+		}
+	}
+
+	return creationPolicy, nil
+}
+
+// Allows reports whether the user may create communities.
+func (p *CreationPolicy) Allows(userAddress string) bool {
+	switch p.policy {
+	case CreationPolicyAnyone:
+		return true
+	case CreationPolicyAllowlist:
+		if _, ok := p.allowlist[strings.ToLower(userAddress)]; ok {
+			return true
+		}
+
+		_, ok := p.allowlist[userAddressHost(userAddress)]
+		return ok
+	default:
+		return false
+	}
+}
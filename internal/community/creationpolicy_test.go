@@ -0,0 +1,74 @@
+package community
+
+import "testing"
+
+func TestCreationPolicyAllows(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      string
+		allowlist   string
+		userAddress string
+		want        bool
+	}{
+		{
+			name:        "unconfigured",
+			userAddress: "user@example.com",
+			want:        false,
+		},
+		{
+			name:        "anyone",
+			policy:      CreationPolicyAnyone,
+			userAddress: "user@example.com",
+			want:        true,
+		},
+		{
+			name:        "nobody",
+			policy:      CreationPolicyNobody,
+			allowlist:   "user@example.com",
+			userAddress: "user@example.com",
+			want:        false,
+		},
+		{
+			name:        "allowlisted user address",
+			policy:      CreationPolicyAllowlist,
+			allowlist:   "other@example.com, User@Example.com",
+			userAddress: "user@example.com",
+			want:        true,
+		},
+		{
+			name:        "allowlisted host",
+			policy:      CreationPolicyAllowlist,
+			allowlist:   "example.com",
+			userAddress: "user@EXAMPLE.com",
+			want:        true,
+		},
+		{
+			name:        "not allowlisted",
+			policy:      CreationPolicyAllowlist,
+			allowlist:   "other@example.com,example.org",
+			userAddress: "user@example.com",
+			want:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := ParseCreationPolicy(tt.policy, tt.allowlist)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := policy.Allows(tt.userAddress)
+			if got != tt.want {
+				t.Errorf("Allows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCreationPolicyUnknown(t *testing.T) {
+	_, err := ParseCreationPolicy("everyone", "")
+	if err == nil {
+		t.Error("ParseCreationPolicy() succeeded for an unknown policy")
+	}
+}
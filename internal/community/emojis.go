@@ -0,0 +1,310 @@
+package community
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"log/slog"
+	"net/http"
+	"path"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+	"github.com/varsotech/prochat-server/internal/pkg/filestore"
+)
+
+const (
+	maxCustomEmojis         = 100
+	maxCustomEmojiSize      = 256 * 1024
+	maxCustomEmojiDimension = 256
+)
+
+var errTooManyCustomEmojis = errors.New("too many custom emojis")
+
+var customEmojiNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_]{2,32}$`)
+
+func (o *Routes) getCustomEmojisHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	emojis, err := o.communityDb.GetCommunityCustomEmojis(r.Context(), caller.CommunityID)
+	if err != nil {
+		slog.Error("could not get custom emojis", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	emojisProto := []*communityserverv1.CustomEmoji{}
+	for _, emoji := range emojis {
+		emojisProto = append(emojisProto, customEmojiToProto(emoji))
+	}
+
+	o.writeProtoJson(w, &communityserverv1.GetCustomEmojisResponse{
+		Emojis: emojisProto,
+	})
+}
+
+// createCustomEmojiHandler uploads a custom emoji to the community. The request body is the PNG, GIF or JPEG
+// image of the emoji, and its name is given in the "name" query parameter.
+func (o *Routes) createCustomEmojiHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	_, ok = o.requirePermission(w, r, caller, PermissionManageEmojis)
+	if !ok {
+		return
+	}
+
+	name := r.URL.Query().Get("name")
+	if !customEmojiNameRegexp.MatchString(name) {
+		http.Error(w, "Invalid custom emoji name", http.StatusBadRequest)
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxCustomEmojiSize))
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err != nil {
+		slog.Error("failed to read custom emoji image", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	contentType, ok := validateCustomEmojiImage(data)
+	if !ok {
+		http.Error(w, "Invalid custom emoji image", http.StatusBadRequest)
+		return
+	}
+
+	emoji, err := o.insertCustomEmoji(r.Context(), communitydb.InsertCustomEmojiParams{
+		ID:          uuid.New(),
+		CommunityID: caller.CommunityID,
+		Name:        name,
+		ContentType: contentType,
+		CreatedBy:   caller.Auth.UserAddress,
+	}, data)
+	if errors.Is(err, errTooManyCustomEmojis) {
+		http.Error(w, "Too many custom emojis", http.StatusConflict)
+		return
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		http.Error(w, "Custom emoji name already taken", http.StatusConflict)
+		return
+	}
+	if err != nil {
+		slog.Error("failed to insert custom emoji", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	emojiProto := customEmojiToProto(emoji)
+
+	o.publishEvent(r.Context(), caller.CommunityID, communityserverv1.Event_TYPE_CUSTOM_EMOJI_CREATED, &communityserverv1.CustomEmojiCreatedEvent{
+		Emoji: emojiProto,
+	})
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_CUSTOM_EMOJI_CREATE,
+		TargetID: emoji.ID.String(),
+		Reason:   auditReason(r),
+		After:    emojiProto,
+	})
+
+	o.writeProtoJson(w, &communityserverv1.CreateCustomEmojiResponse{
+		Emoji: emojiProto,
+	})
+}
+
+// insertCustomEmoji inserts a custom emoji and stores its image. The community is locked while the custom
+// emojis are counted, so concurrent uploads can't exceed maxCustomEmojis. It returns errTooManyCustomEmojis
+// if the community has no room for another custom emoji.
+func (o *Routes) insertCustomEmoji(ctx context.Context, params communitydb.InsertCustomEmojiParams, data []byte) (communitydb.CustomEmoji, error) {
+	tx, err := o.postgresClient.Begin(ctx)
+	if err != nil {
+		return communitydb.CustomEmoji{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := communitydb.New(tx)
+
+	_, err = queries.GetCommunityForUpdate(ctx, params.CommunityID)
+	if err != nil {
+		return communitydb.CustomEmoji{}, fmt.Errorf("failed to lock community: %w", err)
+	}
+
+	count, err := queries.CountCommunityCustomEmojis(ctx, params.CommunityID)
+	if err != nil {
+		return communitydb.CustomEmoji{}, fmt.Errorf("failed to count custom emojis: %w", err)
+	}
+
+	if count >= maxCustomEmojis {
+		return communitydb.CustomEmoji{}, errTooManyCustomEmojis
+	}
+
+	emoji, err := queries.InsertCustomEmoji(ctx, params)
+	if err != nil {
+		return communitydb.CustomEmoji{}, fmt.Errorf("failed to insert custom emoji: %w", err)
+	}
+
+	// The image is stored before committing, so custom emojis never exist without their image
+	_, err = o.customEmojiStore(params.CommunityID).PutObject(ctx, emoji.ID.String(), bytes.NewReader(data))
+	if err != nil {
+		return communitydb.CustomEmoji{}, fmt.Errorf("failed to store custom emoji image: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return communitydb.CustomEmoji{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return emoji, nil
+}
+
+// deleteCustomEmojiObjects deletes the images of custom emojis whose rows were deleted. Failures are logged,
+// since the images can no longer be served either way.
+func (o *Routes) deleteCustomEmojiObjects(ctx context.Context, emojis []communitydb.CustomEmoji) {
+	for _, emoji := range emojis {
+		err := o.customEmojiStore(emoji.CommunityID).DeleteObject(ctx, emoji.ID.String())
+		if err != nil {
+			slog.Error("failed to delete custom emoji image", "error", err, "emojiId", emoji.ID)
+		}
+	}
+}
+
+// deleteCustomEmojiHandler deletes a custom emoji of the community and its image, alongside the reactions
+// using it.
+func (o *Routes) deleteCustomEmojiHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	_, ok = o.requirePermission(w, r, caller, PermissionManageEmojis)
+	if !ok {
+		return
+	}
+
+	emojiId, ok := pathUUID(w, r, "emojiId")
+	if !ok {
+		return
+	}
+
+	emoji, err := o.communityDb.DeleteCustomEmoji(r.Context(), communitydb.DeleteCustomEmojiParams{
+		ID:          emojiId,
+		CommunityID: caller.CommunityID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		slog.Error("failed to delete custom emoji", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.deleteCustomEmojiObjects(r.Context(), []communitydb.CustomEmoji{emoji})
+
+	o.publishEvent(r.Context(), caller.CommunityID, communityserverv1.Event_TYPE_CUSTOM_EMOJI_DELETED, &communityserverv1.CustomEmojiDeletedEvent{
+		EmojiId: emoji.ID.String(),
+	})
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_CUSTOM_EMOJI_DELETE,
+		TargetID: emoji.ID.String(),
+		Reason:   auditReason(r),
+		Before:   customEmojiToProto(emoji),
+	})
+
+	o.writeProtoJson(w, &communityserverv1.DeleteCustomEmojiResponse{})
+}
+
+// getCustomEmojiImageHandler serves the image of a custom emoji. Images are public, so clients can render
+// custom emojis without authenticating, and never change, so they can be cached indefinitely.
+func (o *Routes) getCustomEmojiImageHandler(w http.ResponseWriter, r *http.Request) {
+	emojiId, ok := pathUUID(w, r, "emojiId")
+	if !ok {
+		return
+	}
+
+	emoji, err := o.communityDb.GetCustomEmoji(r.Context(), emojiId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		slog.Error("could not get custom emoji", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	object, err := o.customEmojiStore(emoji.CommunityID).GetObject(r.Context(), emoji.ID.String())
+	if err != nil {
+		slog.Error("could not get custom emoji image", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+	defer object.Close()
+
+	w.Header().Set("Content-Type", emoji.ContentType)
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	_, err = io.Copy(w, object)
+	if err != nil {
+		slog.Error("failed to write custom emoji image", "error", err)
+	}
+}
+
+// customEmojiStore returns the file store of the custom emoji images of a community.
+func (o *Routes) customEmojiStore(communityId uuid.UUID) *filestore.Scope {
+	return filestore.NewScope(o.fileStore, path.Join(communityId.String(), "emojis"))
+}
+
+// validateCustomEmojiImage returns the content type of a custom emoji image, and reports whether it is a
+// PNG, GIF or JPEG image no larger than maxCustomEmojiDimension in either dimension.
+func validateCustomEmojiImage(data []byte) (string, bool) {
+	contentType := http.DetectContentType(data)
+	switch contentType {
+	case "image/png", "image/gif", "image/jpeg":
+	default:
+		return "", false
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return "", false
+	}
+
+	if config.Width < 1 || config.Height < 1 || config.Width > maxCustomEmojiDimension || config.Height > maxCustomEmojiDimension {
+		return "", false
+	}
+
+	return contentType, true
+}
+
+func customEmojiToProto(emoji communitydb.CustomEmoji) *communityserverv1.CustomEmoji {
+	return &communityserverv1.CustomEmoji{
+		Id:        emoji.ID.String(),
+		Name:      emoji.Name,
+		ImageUrl:  "/api/v1/community/server/emojis/" + emoji.ID.String(),
+		CreatedBy: emoji.CreatedBy,
+		CreatedAt: formatTimestamp(emoji.CreatedAt),
+	}
+}
@@ -0,0 +1,48 @@
+package community
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+type EventPublisher interface {
+	Publish(ctx context.Context, event *communityserverv1.Event) error
+}
+
+// publishEvent publishes an event to the gateway clients of a community.
+// Events are best-effort, so failures are logged rather than failing the request that caused them.
+func (o *Routes) publishEvent(ctx context.Context, communityId uuid.UUID, eventType communityserverv1.Event_Type, payload proto.Message) {
+	o.publish(ctx, communityId, uuid.Nil, eventType, payload)
+}
+
+// publishChannelEvent publishes an event of a channel, which is only delivered to the gateway clients of
+// members that can view the channel.
+func (o *Routes) publishChannelEvent(ctx context.Context, communityId uuid.UUID, channelId uuid.UUID, eventType communityserverv1.Event_Type, payload proto.Message) {
+	o.publish(ctx, communityId, channelId, eventType, payload)
+}
+
+func (o *Routes) publish(ctx context.Context, communityId uuid.UUID, channelId uuid.UUID, eventType communityserverv1.Event_Type, payload proto.Message) {
+	payloadBytes, err := proto.Marshal(payload)
+	if err != nil {
+		slog.Error("failed to marshal event payload", "error", err, "type", eventType.String())
+		return
+	}
+
+	event := &communityserverv1.Event{
+		Type:        eventType,
+		CommunityId: communityId.String(),
+		Payload:     payloadBytes,
+	}
+	if channelId != uuid.Nil {
+		event.ChannelId = channelId.String()
+	}
+
+	err = o.events.Publish(ctx, event)
+	if err != nil {
+		slog.Error("failed to publish event", "error", err, "type", eventType.String())
+	}
+}
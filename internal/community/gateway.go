@@ -0,0 +1,390 @@
+package community
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/jackc/pgx/v5"
+	"github.com/varsotech/prochat-server/internal/community/gateway"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	gatewayWriteTimeout = 10 * time.Second
+	gatewayPongTimeout  = 60 * time.Second
+	gatewayPingInterval = gatewayPongTimeout * 9 / 10
+
+	// channelViewsTTL bounds how long cached channel visibility is trusted, in case an event that changes
+	// permissions is missed
+	channelViewsTTL = 5 * time.Minute
+)
+
+// gatewayConnection is a single authenticated gateway connection.
+type gatewayConnection struct {
+	id          string
+	userAddress string
+	client      *gateway.Client
+
+	// channelViews caches whether the user can view channels, so channel events don't query permissions for
+	// every connection. It is only accessed by the writer loop of the connection.
+	channelViews *channelViewCache
+}
+
+// channelViewCache caches whether a user can view channels, by community. Communities are invalidated by the
+// events that change permissions, and the whole cache expires after channelViewsTTL.
+type channelViewCache struct {
+	views     map[uuid.UUID]map[uuid.UUID]bool
+	expiresAt time.Time
+}
+
+func newChannelViewCache() *channelViewCache {
+	return &channelViewCache{
+		views: map[uuid.UUID]map[uuid.UUID]bool{},
+	}
+}
+
+func (c *channelViewCache) get(communityId uuid.UUID, channelId uuid.UUID, now time.Time) (bool, bool) {
+	if now.After(c.expiresAt) {
+		clear(c.views)
+		c.expiresAt = now.Add(channelViewsTTL)
+		return false, false
+	}
+
+	canView, ok := c.views[communityId][channelId]
+	return canView, ok
+}
+
+func (c *channelViewCache) set(communityId uuid.UUID, channelId uuid.UUID, canView bool) {
+	if c.views[communityId] == nil {
+		c.views[communityId] = map[uuid.UUID]bool{}
+	}
+
+	c.views[communityId][channelId] = canView
+}
+
+func (c *channelViewCache) invalidate(communityId uuid.UUID) {
+	delete(c.views, communityId)
+}
+
+// changesChannelViews reports whether events of the type may change which channels members can view.
+func changesChannelViews(eventType communityserverv1.Event_Type) bool {
+	switch eventType {
+	case communityserverv1.Event_TYPE_MEMBER_JOINED,
+		communityserverv1.Event_TYPE_MEMBER_REMOVED,
+		communityserverv1.Event_TYPE_CHANNEL_CREATED,
+		communityserverv1.Event_TYPE_CHANNEL_UPDATED,
+		communityserverv1.Event_TYPE_CHANNEL_DELETED,
+		communityserverv1.Event_TYPE_COMMUNITY_UPDATED,
+		communityserverv1.Event_TYPE_ROLE_CREATED,
+		communityserverv1.Event_TYPE_ROLE_UPDATED,
+		communityserverv1.Event_TYPE_ROLE_DELETED,
+		communityserverv1.Event_TYPE_MEMBER_ROLE_ADDED,
+		communityserverv1.Event_TYPE_MEMBER_ROLE_REMOVED:
+		return true
+	default:
+		return false
+	}
+}
+
+// upgrader is used to upgrade HTTP connections to WebSocket connections.
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// gatewayHandler streams community events to a member over a WebSocket connection.
+// The first message sent by the client must be the authorization header containing the identity token.
+// Afterward, the server sends a binary communityserverv1.Event message for every event of the communities
+// the member belongs to, except for events of channels the member can't view. The client may send binary
+// communityserverv1.GatewayCommand messages, and is online while it is connected.
+func (o *Routes) gatewayHandler(w http.ResponseWriter, r *http.Request) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		slog.Info("unable to upgrade gateway websocket", "error", err)
+		return
+	}
+	defer conn.Close()
+
+	_, authorizationHeader, err := conn.ReadMessage()
+	if err != nil {
+		slog.Info("unable to read gateway authorization header", "error", err)
+		return
+	}
+
+	auth, err := o.authenticator.Authenticate(r.Context(), string(authorizationHeader))
+	if err != nil {
+		slog.Info("failed to authenticate gateway connection", "error", err)
+		closeMessage := websocket.FormatCloseMessage(websocket.ClosePolicyViolation, http.StatusText(http.StatusUnauthorized))
+		_ = conn.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(gatewayWriteTimeout))
+		return
+	}
+
+	communityIds, err := o.getMemberCommunityIds(r.Context(), auth.UserAddress)
+	if err != nil {
+		slog.Error("failed to get gateway member communities", "error", err)
+		closeMessage := websocket.FormatCloseMessage(websocket.CloseInternalServerErr, "Internal error")
+		_ = conn.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(gatewayWriteTimeout))
+		return
+	}
+
+	client := gateway.NewClient(auth.UserAddress)
+	o.hub.Register(client, communityIds)
+	defer o.hub.Unregister(client)
+
+	slog.Info("gateway connection is authenticated", "user_address", auth.UserAddress)
+
+	connection := &gatewayConnection{
+		id:           uuid.NewString(),
+		userAddress:  auth.UserAddress,
+		client:       client,
+		channelViews: newChannelViewCache(),
+	}
+
+	o.connectPresence(r.Context(), connection)
+	defer o.disconnectPresence(connection)
+
+	go o.readGateway(r.Context(), conn, connection)
+
+	ticker := time.NewTicker(gatewayPingInterval)
+	defer ticker.Stop()
+
+	presenceTicker := time.NewTicker(presenceHeartbeatInterval)
+	defer presenceTicker.Stop()
+
+	for {
+		select {
+		case data := <-client.Send():
+			if !o.canReceiveEvent(r.Context(), connection, data) {
+				continue
+			}
+
+			_ = conn.SetWriteDeadline(time.Now().Add(gatewayWriteTimeout))
+			err = conn.WriteMessage(websocket.BinaryMessage, data)
+			if err != nil {
+				slog.Info("failed to write gateway event", "error", err)
+				return
+			}
+		case <-ticker.C:
+			err = conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(gatewayWriteTimeout))
+			if err != nil {
+				slog.Info("failed to write gateway ping", "error", err)
+				return
+			}
+		case <-presenceTicker.C:
+			_, err = o.presence.heartbeat(r.Context(), connection.userAddress, connection.id, o.hub.ClientCommunities(client))
+			if err != nil {
+				slog.Error("failed to refresh gateway presence", "error", err)
+			}
+		case <-client.Done():
+			return
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// readGateway reads commands from the connection until it is closed. Control messages are processed while
+// reading as well.
+func (o *Routes) readGateway(ctx context.Context, conn *websocket.Conn, connection *gatewayConnection) {
+	defer connection.client.Close()
+
+	_ = conn.SetReadDeadline(time.Now().Add(gatewayPongTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(gatewayPongTimeout))
+	})
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		err = o.handleGatewayCommand(ctx, connection, data)
+		if err != nil {
+			slog.Info("failed to handle gateway command", "error", err, "user_address", connection.userAddress)
+		}
+	}
+}
+
+// handleGatewayCommand handles a binary communityserverv1.GatewayCommand message sent by the client.
+func (o *Routes) handleGatewayCommand(ctx context.Context, connection *gatewayConnection, data []byte) error {
+	var command communityserverv1.GatewayCommand
+	err := proto.Unmarshal(data, &command)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal gateway command: %w", err)
+	}
+
+	switch command.Type {
+	case communityserverv1.GatewayCommand_TYPE_UPDATE_PRESENCE:
+		var updatePresence communityserverv1.UpdatePresenceCommand
+		err = proto.Unmarshal(command.Payload, &updatePresence)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal update presence command: %w", err)
+		}
+
+		return o.updatePresence(ctx, connection, &updatePresence)
+	case communityserverv1.GatewayCommand_TYPE_START_TYPING:
+		var startTyping communityserverv1.StartTypingCommand
+		err = proto.Unmarshal(command.Payload, &startTyping)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal start typing command: %w", err)
+		}
+
+		return o.startTyping(ctx, connection, &startTyping)
+	default:
+		return fmt.Errorf("unknown gateway command type: %s", command.Type)
+	}
+}
+
+// connectPresence marks a new connection online, and announces the presence of the user to its communities.
+func (o *Routes) connectPresence(ctx context.Context, connection *gatewayConnection) {
+	communityIds := o.hub.ClientCommunities(connection.client)
+
+	presence, err := o.presence.heartbeat(ctx, connection.userAddress, connection.id, communityIds)
+	if err != nil {
+		slog.Error("failed to connect gateway presence", "error", err)
+		return
+	}
+
+	o.publishPresence(ctx, communityIds, presence)
+}
+
+// disconnectPresence removes a closed connection, and announces the user as offline if it was the last
+// connection of the user.
+func (o *Routes) disconnectPresence(connection *gatewayConnection) {
+	// The request context is usually cancelled by the time the connection is closed
+	ctx, cancel := context.WithTimeout(context.Background(), gatewayWriteTimeout)
+	defer cancel()
+
+	communityIds := o.hub.ClientCommunities(connection.client)
+
+	offline, err := o.presence.disconnect(ctx, connection.userAddress, connection.id, communityIds)
+	if err != nil {
+		slog.Error("failed to disconnect gateway presence", "error", err)
+		return
+	}
+
+	if offline {
+		o.publishPresence(ctx, communityIds, &communityserverv1.Presence{
+			UserAddress: connection.userAddress,
+			Status:      communityserverv1.PresenceStatus_PRESENCE_STATUS_OFFLINE,
+		})
+	}
+}
+
+// canReceiveEvent reports whether the user of the connection may receive the marshalled event. Events of
+// channels the user can't view are withheld, and so are events that could not be checked. Whether the user
+// can view a channel is cached until an event changes permissions in its community.
+func (o *Routes) canReceiveEvent(ctx context.Context, connection *gatewayConnection, data []byte) bool {
+	var event communityserverv1.Event
+	err := proto.Unmarshal(data, &event)
+	if err != nil {
+		slog.Error("failed to unmarshal gateway event", "error", err)
+		return false
+	}
+
+	communityId, err := uuid.Parse(event.CommunityId)
+	if err != nil {
+		slog.Error("invalid gateway event community id", "error", err)
+		return false
+	}
+
+	// Events are delivered in order, so later events are checked against the updated permissions
+	if changesChannelViews(event.Type) {
+		connection.channelViews.invalidate(communityId)
+	}
+
+	if event.ChannelId == "" {
+		return true
+	}
+
+	channelId, err := uuid.Parse(event.ChannelId)
+	if err != nil {
+		slog.Error("invalid gateway event channel id", "error", err)
+		return false
+	}
+
+	canView, ok := connection.channelViews.get(communityId, channelId, time.Now())
+	if ok {
+		return canView
+	}
+
+	canView, err = o.canViewChannel(ctx, connection.userAddress, communityId, channelId)
+	if err != nil {
+		slog.Error("failed to check gateway channel visibility", "error", err)
+		return false
+	}
+
+	connection.channelViews.set(communityId, channelId, canView)
+	return canView
+}
+
+// canViewChannel reports whether the user can view a channel of a community. Users that aren't members of the
+// community can't view its channels.
+func (o *Routes) canViewChannel(ctx context.Context, userAddress string, communityId uuid.UUID, channelId uuid.UUID) (bool, error) {
+	// The member may have been removed from the community before its clients were unsubscribed
+	caller, err := o.getCommunityMemberByAddress(ctx, userAddress, communityId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	permissions, err := o.getChannelPermissions(ctx, caller, channelId)
+	if err != nil {
+		return false, err
+	}
+
+	return permissions.Has(PermissionViewChannel), nil
+}
+
+func (o *Routes) getMemberCommunityIds(ctx context.Context, userAddress string) ([]uuid.UUID, error) {
+	member, err := o.communityDb.GetMemberByUserAddress(ctx, userAddress)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member by user address: %w", err)
+	}
+
+	communities, err := o.communityDb.GetMemberCommunities(ctx, member.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member communities: %w", err)
+	}
+
+	var communityIds []uuid.UUID
+	for _, community := range communities {
+		communityIds = append(communityIds, community.ID)
+	}
+
+	return communityIds, nil
+}
+
+// getCommunityMemberByAddress gets a member of a community by its user address. It returns pgx.ErrNoRows if
+// the user isn't a member of the community.
+func (o *Routes) getCommunityMemberByAddress(ctx context.Context, userAddress string, communityId uuid.UUID) (*communityMember, error) {
+	member, err := o.communityDb.GetMemberByUserAddress(ctx, userAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member: %w", err)
+	}
+
+	membership, err := o.communityDb.GetCommunityMember(ctx, communitydb.GetCommunityMemberParams{
+		MemberID:    member.ID,
+		CommunityID: communityId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get community member: %w", err)
+	}
+
+	return &communityMember{Member: member, Membership: membership, CommunityID: communityId}, nil
+}
@@ -0,0 +1,93 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const eventsChannelPrefix = "community:events:"
+
+// Bus fans out community events across every instance of the server using Redis pub/sub.
+// Events are published to a Redis channel per community, and every instance dispatches every event it
+// receives to its locally connected clients.
+//
+// Ordering is preserved per community, and therefore per channel: Redis delivers the messages of a
+// channel to a subscription in the order they were published, and Run dispatches them sequentially into
+// each client's FIFO send buffer.
+//
+// It is safe for concurrent use by multiple Go routines.
+type Bus struct {
+	redisClient *redis.Client
+	hub         *Hub
+}
+
+func NewBus(redisClient *redis.Client, hub *Hub) *Bus {
+	return &Bus{
+		redisClient: redisClient,
+		hub:         hub,
+	}
+}
+
+// Publish publishes an event to every instance, including this one.
+func (b *Bus) Publish(ctx context.Context, event *communityserverv1.Event) error {
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	_, err = b.redisClient.Publish(ctx, eventsChannelPrefix+event.CommunityId, data).Result()
+	if err != nil {
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+
+	return nil
+}
+
+// Run dispatches published events to the local clients until the context is cancelled.
+func (b *Bus) Run(ctx context.Context) error {
+	pubsub := b.redisClient.PSubscribe(ctx, eventsChannelPrefix+"*")
+	defer pubsub.Close()
+
+	// Wait for the subscription to be confirmed before events are expected to be delivered
+	_, err := pubsub.Receive(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to community events: %w", err)
+	}
+
+	slog.Info("community event bus is subscribed")
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case message, ok := <-messages:
+			if !ok {
+				return nil
+			}
+
+			var event communityserverv1.Event
+			err = proto.Unmarshal([]byte(message.Payload), &event)
+			if err != nil {
+				slog.Error("failed to unmarshal community event", "error", err, "channel", message.Channel)
+				continue
+			}
+
+			if event.CommunityId != strings.TrimPrefix(message.Channel, eventsChannelPrefix) {
+				slog.Error("community event published to the wrong channel", "channel", message.Channel)
+				continue
+			}
+
+			err = b.hub.Dispatch(&event)
+			if err != nil {
+				slog.Error("failed to dispatch community event", "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package gateway
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// sendBufferSize is the amount of events buffered for a client before it is considered too slow and is
+// disconnected.
+const sendBufferSize = 256
+
+// Client is a single gateway connection of a member.
+type Client struct {
+	userAddress string
+	send        chan []byte
+	done        chan struct{}
+	closeOnce   sync.Once
+
+	// communities is guarded by the mutex of the Hub the client is registered to
+	communities map[uuid.UUID]struct{}
+}
+
+func NewClient(userAddress string) *Client {
+	return &Client{
+		userAddress: userAddress,
+		send:        make(chan []byte, sendBufferSize),
+		done:        make(chan struct{}),
+		communities: map[uuid.UUID]struct{}{},
+	}
+}
+
+func (c *Client) UserAddress() string {
+	return c.userAddress
+}
+
+// Send returns the channel of marshalled events that should be written to the connection.
+func (c *Client) Send() <-chan []byte {
+	return c.send
+}
+
+// Done is closed when the client is closed, and the connection should be closed.
+func (c *Client) Done() <-chan struct{} {
+	return c.done
+}
+
+// Close closes the client. It is safe to call Close multiple times.
+func (c *Client) Close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+}
+
+// deliver queues an event to the client without blocking, and closes the client if its buffer is full.
+func (c *Client) deliver(data []byte) {
+	select {
+	case c.send <- data:
+	default:
+		c.Close()
+	}
+}
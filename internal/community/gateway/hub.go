@@ -0,0 +1,177 @@
+package gateway
+
+import (
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/google/uuid"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Hub delivers community events to the gateway clients connected to this instance.
+// It is safe for concurrent use by multiple Go routines.
+type Hub struct {
+	mu          sync.RWMutex
+	communities map[uuid.UUID]map[*Client]struct{}
+	users       map[string]map[*Client]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		communities: map[uuid.UUID]map[*Client]struct{}{},
+		users:       map[string]map[*Client]struct{}{},
+	}
+}
+
+// Register starts delivering events of the given communities to the client.
+func (h *Hub) Register(client *Client, communityIds []uuid.UUID) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	addToSet(h.users, client.userAddress, client)
+	for _, communityId := range communityIds {
+		h.subscribe(client, communityId)
+	}
+}
+
+// Unregister stops delivering events to the client.
+func (h *Hub) Unregister(client *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	removeFromSet(h.users, client.userAddress, client)
+	for communityId := range client.communities {
+		removeFromSet(h.communities, communityId, client)
+	}
+	client.communities = map[uuid.UUID]struct{}{}
+}
+
+// Subscribe starts delivering events of a community to every client of the user.
+func (h *Hub) Subscribe(userAddress string, communityId uuid.UUID) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for client := range h.users[userAddress] {
+		h.subscribe(client, communityId)
+	}
+}
+
+// Unsubscribe stops delivering events of a community to every client of the user.
+func (h *Hub) Unsubscribe(userAddress string, communityId uuid.UUID) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for client := range h.users[userAddress] {
+		delete(client.communities, communityId)
+		removeFromSet(h.communities, communityId, client)
+	}
+}
+
+// ClientCommunities returns the communities the client receives the events of.
+func (h *Hub) ClientCommunities(client *Client) []uuid.UUID {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	communityIds := make([]uuid.UUID, 0, len(client.communities))
+	for communityId := range client.communities {
+		communityIds = append(communityIds, communityId)
+	}
+
+	return communityIds
+}
+
+// Dispatch delivers the event to the local clients subscribed to its community. Clients of a member that
+// joined the community are subscribed to it before the event is delivered, so they receive their own join,
+// and clients of a member that was removed from the community are unsubscribed after it is delivered. Every
+// client is unsubscribed from a deleted community after its deletion is delivered.
+func (h *Hub) Dispatch(event *communityserverv1.Event) error {
+	communityId, err := uuid.Parse(event.CommunityId)
+	if err != nil {
+		return fmt.Errorf("invalid event community id: %w", err)
+	}
+
+	if event.Type == communityserverv1.Event_TYPE_MEMBER_JOINED {
+		var memberJoined communityserverv1.MemberJoinedEvent
+		err = proto.Unmarshal(event.Payload, &memberJoined)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal member joined event: %w", err)
+		}
+
+		h.Subscribe(memberJoined.UserAddress, communityId)
+	}
+
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	h.deliver(communityId, data)
+
+	slog.Debug("dispatched community event", "type", event.Type.String(), "community_id", communityId)
+
+	// Clients of a removed member receive their own removal before they are unsubscribed
+	if event.Type == communityserverv1.Event_TYPE_MEMBER_REMOVED {
+		var memberRemoved communityserverv1.MemberRemovedEvent
+		err = proto.Unmarshal(event.Payload, &memberRemoved)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal member removed event: %w", err)
+		}
+
+		h.Unsubscribe(memberRemoved.UserAddress, communityId)
+	}
+
+	if event.Type == communityserverv1.Event_TYPE_COMMUNITY_DELETED {
+		h.unsubscribeAll(communityId)
+	}
+
+	return nil
+}
+
+// unsubscribeAll stops delivering events of a community to every client.
+func (h *Hub) unsubscribeAll(communityId uuid.UUID) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for client := range h.communities[communityId] {
+		delete(client.communities, communityId)
+	}
+	delete(h.communities, communityId)
+}
+
+func (h *Hub) deliver(communityId uuid.UUID, data []byte) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for client := range h.communities[communityId] {
+		client.deliver(data)
+	}
+}
+
+// subscribe must be called while holding the write lock.
+func (h *Hub) subscribe(client *Client, communityId uuid.UUID) {
+	client.communities[communityId] = struct{}{}
+	addToSet(h.communities, communityId, client)
+}
+
+func addToSet[K comparable](sets map[K]map[*Client]struct{}, key K, client *Client) {
+	set, ok := sets[key]
+	if !ok {
+		set = map[*Client]struct{}{}
+		sets[key] = set
+	}
+	set[client] = struct{}{}
+}
+
+func removeFromSet[K comparable](sets map[K]map[*Client]struct{}, key K, client *Client) {
+	set, ok := sets[key]
+	if !ok {
+		return
+	}
+
+	delete(set, client)
+	if len(set) == 0 {
+		delete(sets, key)
+	}
+}
@@ -0,0 +1,146 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestHubDispatch(t *testing.T) {
+	communityId := uuid.New()
+	otherCommunityId := uuid.New()
+
+	hub := NewHub()
+
+	member := NewClient("member@example.com")
+	hub.Register(member, []uuid.UUID{communityId})
+
+	joiner := NewClient("joiner@example.com")
+	hub.Register(joiner, []uuid.UUID{otherCommunityId})
+
+	payload, err := proto.Marshal(&communityserverv1.MemberJoinedEvent{UserAddress: joiner.UserAddress()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = hub.Dispatch(&communityserverv1.Event{
+		Type:        communityserverv1.Event_TYPE_MEMBER_JOINED,
+		CommunityId: communityId.String(),
+		Payload:     payload,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(member.Send()) != 1 {
+		t.Errorf("member got %d events, want 1", len(member.Send()))
+	}
+
+	// The joining member must be subscribed before their own join event is delivered
+	if len(joiner.Send()) != 1 {
+		t.Errorf("joiner got %d events, want 1", len(joiner.Send()))
+	}
+
+	hub.Unregister(member)
+
+	err = hub.Dispatch(&communityserverv1.Event{
+		Type:        communityserverv1.Event_TYPE_CHANNEL_CREATED,
+		CommunityId: communityId.String(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(member.Send()) != 1 {
+		t.Errorf("unregistered member got %d events, want 1", len(member.Send()))
+	}
+
+	if len(joiner.Send()) != 2 {
+		t.Errorf("joiner got %d events, want 2", len(joiner.Send()))
+	}
+}
+
+func TestHubDispatchMemberRemoved(t *testing.T) {
+	communityId := uuid.New()
+
+	hub := NewHub()
+
+	removed := NewClient("removed@example.com")
+	hub.Register(removed, []uuid.UUID{communityId})
+
+	payload, err := proto.Marshal(&communityserverv1.MemberRemovedEvent{UserAddress: removed.UserAddress()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = hub.Dispatch(&communityserverv1.Event{
+		Type:        communityserverv1.Event_TYPE_MEMBER_REMOVED,
+		CommunityId: communityId.String(),
+		Payload:     payload,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The removed member must receive their own removal before they are unsubscribed
+	if len(removed.Send()) != 1 {
+		t.Errorf("removed member got %d events, want 1", len(removed.Send()))
+	}
+
+	err = hub.Dispatch(&communityserverv1.Event{
+		Type:        communityserverv1.Event_TYPE_CHANNEL_CREATED,
+		CommunityId: communityId.String(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(removed.Send()) != 1 {
+		t.Errorf("removed member got %d events, want 1", len(removed.Send()))
+	}
+}
+
+func TestHubDispatchCommunityDeleted(t *testing.T) {
+	communityId := uuid.New()
+	otherCommunityId := uuid.New()
+
+	hub := NewHub()
+
+	member := NewClient("member@example.com")
+	hub.Register(member, []uuid.UUID{communityId, otherCommunityId})
+
+	err := hub.Dispatch(&communityserverv1.Event{
+		Type:        communityserverv1.Event_TYPE_COMMUNITY_DELETED,
+		CommunityId: communityId.String(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(member.Send()) != 1 {
+		t.Errorf("member got %d events, want 1", len(member.Send()))
+	}
+
+	err = hub.Dispatch(&communityserverv1.Event{
+		Type:        communityserverv1.Event_TYPE_CHANNEL_CREATED,
+		CommunityId: communityId.String(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = hub.Dispatch(&communityserverv1.Event{
+		Type:        communityserverv1.Event_TYPE_CHANNEL_CREATED,
+		CommunityId: otherCommunityId.String(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only events of the communities that weren't deleted are still delivered
+	if len(member.Send()) != 2 {
+		t.Errorf("member got %d events, want 2", len(member.Send()))
+	}
+}
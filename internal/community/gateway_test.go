@@ -0,0 +1,39 @@
+package community
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestChannelViewCache(t *testing.T) {
+	communityId, otherCommunityId := uuid.New(), uuid.New()
+	channelId, otherChannelId := uuid.New(), uuid.New()
+	now := time.Now()
+
+	cache := newChannelViewCache()
+	if _, ok := cache.get(communityId, channelId, now); ok {
+		t.Fatal("empty cache returned a channel view")
+	}
+
+	cache.set(communityId, channelId, true)
+	cache.set(otherCommunityId, otherChannelId, false)
+
+	if canView, ok := cache.get(communityId, channelId, now); !ok || !canView {
+		t.Errorf("get() = %v, %v, want true, true", canView, ok)
+	}
+
+	cache.invalidate(communityId)
+	if _, ok := cache.get(communityId, channelId, now); ok {
+		t.Error("invalidated community returned a channel view")
+	}
+
+	if canView, ok := cache.get(otherCommunityId, otherChannelId, now); !ok || canView {
+		t.Errorf("get() of other community = %v, %v, want false, true", canView, ok)
+	}
+
+	if _, ok := cache.get(otherCommunityId, otherChannelId, now.Add(channelViewsTTL+time.Second)); ok {
+		t.Error("expired cache returned a channel view")
+	}
+}
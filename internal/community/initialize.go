@@ -3,23 +3,98 @@ package community
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
 )
 
-func Initialize(ctx context.Context, postgresClient *pgxpool.Pool, host string) error {
-	queries := communitydb.New(postgresClient)
+// Initialize creates the default community of the server, alongside a default channel so new servers are
+// usable out of the box. A default community left without channels gets the default channel again. If an owner address is given and the default community has no owner, that user is
+// made a member and the owner of the default community. Ownership set later isn't overridden on boot.
+func Initialize(ctx context.Context, postgresClient *pgxpool.Pool, host string, ownerAddress string) error {
+	tx, err := postgresClient.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := communitydb.New(tx)
 
-	_, err := queries.UpsertDefaultCommunity(ctx, communitydb.UpsertDefaultCommunityParams{
+	community, err := queries.UpsertDefaultCommunity(ctx, communitydb.UpsertDefaultCommunityParams{
 		ID:   uuid.New(),
 		Name: host,
 	})
-	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+	if errors.Is(err, pgx.ErrNoRows) {
+		// The default community already exists
+		community, err = queries.GetDefaultCommunity(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get the default community: %w", err)
+		}
+	} else if err != nil {
 		return err
 	}
 
+	// Servers created before default channels existed may have a default community without channels
+	channels, err := queries.GetCommunityChannels(ctx, community.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get default community channels: %w", err)
+	}
+
+	if len(channels) == 0 {
+		_, err = queries.InsertChannel(ctx, communitydb.InsertChannelParams{
+			ID:          uuid.New(),
+			CommunityID: community.ID,
+			Name:        defaultChannelName,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to insert default channel: %w", err)
+		}
+	}
+
+	if ownerAddress != "" && !community.OwnerMemberID.Valid {
+		err = setDefaultCommunityOwner(ctx, queries, community, ownerAddress)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func setDefaultCommunityOwner(ctx context.Context, queries *communitydb.Queries, community communitydb.Community, ownerAddress string) error {
+	_, err := queries.UpsertMember(ctx, communitydb.UpsertMemberParams{
+		ID:          uuid.New(),
+		UserAddress: ownerAddress,
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to upsert owner member: %w", err)
+	}
+
+	member, err := queries.GetMemberByUserAddress(ctx, ownerAddress)
+	if err != nil {
+		return fmt.Errorf("failed to get owner member: %w", err)
+	}
+
+	_, err = queries.UpsertCommunityMember(ctx, communitydb.UpsertCommunityMemberParams{
+		ID:          uuid.New(),
+		MemberID:    member.ID,
+		CommunityID: community.ID,
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to upsert owner community member: %w", err)
+	}
+
+	err = queries.SetCommunityOwner(ctx, communitydb.SetCommunityOwnerParams{
+		ID:            community.ID,
+		OwnerMemberID: pgtype.UUID{Bytes: member.ID, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set community owner: %w", err)
+	}
+
 	return nil
 }
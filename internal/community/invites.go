@@ -0,0 +1,365 @@
+package community
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+)
+
+const (
+	// inviteCodeLength is the amount of random bytes in an invite code, which are encoded to 8 characters
+	inviteCodeLength = 6
+	maxInviteAge     = 365 * 24 * time.Hour
+)
+
+var errInvalidInvite = errors.New("invite does not exist, expired, or has no uses left")
+
+func (o *Routes) getInvitesHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	permissions, err := o.getPermissions(r.Context(), caller)
+	if err != nil {
+		slog.Error("failed to get permissions", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Members that can't manage invites only see the invites they created
+	var invites []communitydb.Invite
+	if permissions.Has(PermissionManageInvites) {
+		invites, err = o.communityDb.GetCommunityInvites(r.Context(), caller.CommunityID)
+	} else {
+		invites, err = o.communityDb.GetCreatorInvites(r.Context(), communitydb.GetCreatorInvitesParams{
+			CommunityID:        caller.CommunityID,
+			CreatorUserAddress: caller.Member.UserAddress,
+		})
+	}
+	if err != nil {
+		slog.Error("could not get invites", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	invitesProto := []*communityserverv1.Invite{}
+	for _, invite := range invites {
+		invitesProto = append(invitesProto, inviteToProto(invite))
+	}
+
+	o.writeProtoJson(w, &communityserverv1.GetInvitesResponse{
+		Invites: invitesProto,
+	})
+}
+
+func (o *Routes) createInviteHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	var req communityserverv1.CreateInviteRequest
+	if !o.readProtoJson(w, r, &req) {
+		return
+	}
+
+	if req.MaxUses < 0 {
+		http.Error(w, "Invalid max uses", http.StatusBadRequest)
+		return
+	}
+
+	if req.MaxAgeSeconds < 0 || req.MaxAgeSeconds > int64(maxInviteAge/time.Second) {
+		http.Error(w, "Invalid max age", http.StatusBadRequest)
+		return
+	}
+
+	var channelId pgtype.UUID
+	if req.ChannelId != "" {
+		id, err := uuid.Parse(req.ChannelId)
+		if err != nil {
+			http.Error(w, "Invalid channel id", http.StatusBadRequest)
+			return
+		}
+
+		_, err = o.communityDb.GetChannel(r.Context(), communitydb.GetChannelParams{
+			ID:          id,
+			CommunityID: caller.CommunityID,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			slog.Error("could not get channel", "error", err)
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+			return
+		}
+
+		_, ok = o.requireChannelPermission(w, r, caller, id, PermissionViewChannel)
+		if !ok {
+			return
+		}
+
+		channelId = pgtype.UUID{Bytes: id, Valid: true}
+	}
+
+	var expiresAt pgtype.Timestamptz
+	if req.MaxAgeSeconds > 0 {
+		expiresAt = pgtype.Timestamptz{Time: time.Now().Add(time.Duration(req.MaxAgeSeconds) * time.Second), Valid: true}
+	}
+
+	code, err := generateInviteCode()
+	if err != nil {
+		slog.Error("failed to generate invite code", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	invite, err := o.communityDb.InsertInvite(r.Context(), communitydb.InsertInviteParams{
+		Code:               code,
+		CommunityID:        caller.CommunityID,
+		ChannelID:          channelId,
+		CreatorUserAddress: caller.Member.UserAddress,
+		MaxUses:            req.MaxUses,
+		ExpiresAt:          expiresAt,
+	})
+	if err != nil {
+		slog.Error("failed to insert invite", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.writeProtoJson(w, &communityserverv1.CreateInviteResponse{
+		Invite: inviteToProto(invite),
+	})
+}
+
+// revokeInviteHandler revokes an invite. Members can revoke the invites they created, and members that can
+// manage invites can revoke any invite of the community.
+func (o *Routes) revokeInviteHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	invite, err := o.communityDb.GetInvite(r.Context(), r.PathValue("code"))
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && invite.CommunityID != caller.CommunityID) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		slog.Error("could not get invite", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	if invite.CreatorUserAddress != caller.Member.UserAddress {
+		_, ok = o.requirePermission(w, r, caller, PermissionManageInvites)
+		if !ok {
+			return
+		}
+	}
+
+	revoked, err := o.communityDb.RevokeInvite(r.Context(), communitydb.RevokeInviteParams{
+		Code:        invite.Code,
+		CommunityID: caller.CommunityID,
+	})
+	if err != nil {
+		slog.Error("failed to revoke invite", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	if revoked == 0 {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_INVITE_REVOKE,
+		TargetID: invite.Code,
+		Reason:   auditReason(r),
+		Before:   inviteToProto(invite),
+	})
+
+	o.writeProtoJson(w, &communityserverv1.RevokeInviteResponse{})
+}
+
+// resolveInviteHandler returns a preview of the community an invite leads to, so it can be shown before
+// joining. Invites that can no longer be used are reported as not found.
+func (o *Routes) resolveInviteHandler(w http.ResponseWriter, r *http.Request) {
+	_, ok := o.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	invite, err := o.communityDb.GetInvite(r.Context(), r.PathValue("code"))
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && !inviteUsable(invite, time.Now())) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		slog.Error("could not get invite", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	community, err := o.communityDb.GetCommunity(r.Context(), invite.CommunityID)
+	if err != nil {
+		slog.Error("could not get invite community", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	memberCount, err := o.communityDb.CountCommunityMembers(r.Context(), community.ID)
+	if err != nil {
+		slog.Error("could not count community members", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	resp := &communityserverv1.ResolveInviteResponse{
+		Invite: inviteToProto(invite),
+		Community: &communityserverv1.ResolveInviteResponse_Community{
+			Id:          community.ID.String(),
+			Name:        community.Name,
+			MemberCount: memberCount,
+		},
+	}
+
+	if invite.ChannelID.Valid {
+		channel, err := o.communityDb.GetChannel(r.Context(), communitydb.GetChannelParams{
+			ID:          invite.ChannelID.Bytes,
+			CommunityID: community.ID,
+		})
+		if err != nil {
+			slog.Error("could not get invite channel", "error", err)
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+			return
+		}
+
+		resp.Channel = channelToProto(channel)
+	}
+
+	o.writeProtoJson(w, resp)
+}
+
+// joinWithInvite makes the member join the community of the invite. A use of the invite is only counted if
+// the member wasn't already a member of the community. It returns errBanned if the member is banned from the
+// community.
+func (o *Routes) joinWithInvite(ctx context.Context, member communitydb.Member, code string) (communitydb.Invite, error) {
+	invite, err := o.communityDb.GetInvite(ctx, code)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return communitydb.Invite{}, errInvalidInvite
+	}
+	if err != nil {
+		return communitydb.Invite{}, fmt.Errorf("failed to get invite: %w", err)
+	}
+
+	_, err = o.communityDb.GetCommunityMember(ctx, communitydb.GetCommunityMemberParams{
+		MemberID:    member.ID,
+		CommunityID: invite.CommunityID,
+	})
+	if err == nil {
+		return invite, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return communitydb.Invite{}, fmt.Errorf("failed to get community member: %w", err)
+	}
+
+	// Banned members must not use up the invite
+	err = o.checkBan(ctx, invite.CommunityID, member.UserAddress)
+	if err != nil {
+		return communitydb.Invite{}, err
+	}
+
+	// The use and the membership are committed together, so a failed join doesn't use up the invite. Uses are
+	// counted atomically, so concurrent joins can't exceed the max uses of the invite.
+	tx, err := o.postgresClient.Begin(ctx)
+	if err != nil {
+		return communitydb.Invite{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := communitydb.New(tx)
+
+	usedInvite, err := queries.UseInvite(ctx, code)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return communitydb.Invite{}, errInvalidInvite
+	}
+	if err != nil {
+		return communitydb.Invite{}, fmt.Errorf("failed to use invite: %w", err)
+	}
+
+	joined, err := insertCommunityMember(ctx, queries, member, usedInvite.CommunityID)
+	if err != nil {
+		return communitydb.Invite{}, err
+	}
+
+	// The member joined concurrently, so the use is rolled back
+	if !joined {
+		return invite, nil
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return communitydb.Invite{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	o.publishMemberJoined(ctx, usedInvite.CommunityID, member)
+
+	return usedInvite, nil
+}
+
+// inviteUsable reports whether the invite can still be used to join its community.
+func inviteUsable(invite communitydb.Invite, now time.Time) bool {
+	if invite.RevokedAt.Valid {
+		return false
+	}
+
+	if invite.ExpiresAt.Valid && !now.Before(invite.ExpiresAt.Time) {
+		return false
+	}
+
+	return invite.MaxUses == 0 || invite.Uses < invite.MaxUses
+}
+
+func generateInviteCode() (string, error) {
+	codeBytes := make([]byte, inviteCodeLength)
+	_, err := rand.Read(codeBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(codeBytes), nil
+}
+
+func inviteToProto(invite communitydb.Invite) *communityserverv1.Invite {
+	inviteProto := &communityserverv1.Invite{
+		Code:               invite.Code,
+		CommunityId:        invite.CommunityID.String(),
+		CreatorUserAddress: invite.CreatorUserAddress,
+		MaxUses:            invite.MaxUses,
+		Uses:               invite.Uses,
+		ExpiresAt:          formatTimestamp(invite.ExpiresAt),
+		CreatedAt:          formatTimestamp(invite.CreatedAt),
+	}
+
+	if invite.ChannelID.Valid {
+		inviteProto.ChannelId = uuid.UUID(invite.ChannelID.Bytes).String()
+	}
+
+	return inviteProto
+}
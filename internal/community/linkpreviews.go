@@ -0,0 +1,446 @@
+package community
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+	"github.com/varsotech/prochat-server/internal/pkg/httputil"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	linkPreviewKeyPrefix = "community:link_preview:"
+
+	// Failed previews are cached for a shorter time, so pages that were unavailable are retried sooner
+	linkPreviewTTL       = 24 * time.Hour
+	linkPreviewFailedTTL = time.Hour
+
+	linkPreviewTimeout         = 30 * time.Second
+	maxLinkPreviewsPerMessage  = 3
+	maxLinkPreviewUrlLength    = 2048
+	maxLinkPreviewDocumentSize = 512 * 1024
+
+	maxLinkPreviewTitleLength       = 256
+	maxLinkPreviewDescriptionLength = 1024
+	maxLinkPreviewSiteNameLength    = 128
+
+	// linkPreviewWorkers bounds the messages whose link previews are fetched concurrently
+	linkPreviewWorkers = 8
+
+	// maxQueuedLinkPreviewUpdates bounds the messages waiting for a worker. Updates are dropped while the queue
+	// is full, leaving those messages without previews.
+	maxQueuedLinkPreviewUpdates = 1000
+)
+
+var (
+	// linkRegexp matches http and https links in a message body
+	linkRegexp = regexp.MustCompile(`https?://[^\s<>"']+`)
+
+	metaTagRegexp       = regexp.MustCompile(`(?is)<meta\s[^>]*>`)
+	htmlAttributeRegexp = regexp.MustCompile(`(?s)([a-zA-Z:-]+)\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'>]+))`)
+	titleTagRegexp      = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+)
+
+// linkPreviewer fetches the OpenGraph and Twitter card metadata of links, and caches it in Redis so links
+// shared in several messages, or on several instances of the server, are only fetched once.
+//
+// Pages are fetched with a client that only connects to public addresses, so links can't be used to reach
+// internal services. Concurrent previews of the same link share a single fetch.
+type linkPreviewer struct {
+	httpClient  *httputil.Client
+	redisClient *redis.Client
+	fetches     singleflight.Group
+	updates     chan linkPreviewUpdate
+}
+
+// linkPreviewUpdate is a message waiting for the previews of its links to be fetched.
+type linkPreviewUpdate struct {
+	communityId uuid.UUID
+	message     communitydb.Message
+	links       []string
+}
+
+func newLinkPreviewer(redisClient *redis.Client) *linkPreviewer {
+	return &linkPreviewer{
+		httpClient:  httputil.NewClient(),
+		redisClient: redisClient,
+		updates:     make(chan linkPreviewUpdate, maxQueuedLinkPreviewUpdates),
+	}
+}
+
+// preview returns the preview of a link, or nil if the link has no metadata to preview.
+func (p *linkPreviewer) preview(ctx context.Context, link string) (*communityserverv1.LinkPreview, error) {
+	key := linkPreviewKeyPrefix + hashLink(link)
+
+	data, err := p.redisClient.Get(ctx, key).Bytes()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("failed to get cached link preview: %w", err)
+	}
+	if err == nil {
+		var preview communityserverv1.LinkPreview
+		err = proto.Unmarshal(data, &preview)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal cached link preview: %w", err)
+		}
+
+		// Links without a preview are cached as empty previews
+		if preview.Url == "" {
+			return nil, nil
+		}
+
+		return &preview, nil
+	}
+
+	result, err, _ := p.fetches.Do(key, func() (any, error) {
+		return p.fetchAndCache(ctx, key, link)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	preview := result.(*communityserverv1.LinkPreview)
+	if preview.Url == "" {
+		return nil, nil
+	}
+
+	return preview, nil
+}
+
+// fetchAndCache fetches the preview of a link and caches it, returning an empty preview if the link has no
+// metadata to preview.
+func (p *linkPreviewer) fetchAndCache(ctx context.Context, key string, link string) (*communityserverv1.LinkPreview, error) {
+	preview, err := p.fetch(ctx, link)
+	if err != nil {
+		slog.Debug("failed to fetch link preview", "error", err, "url", link)
+	}
+
+	ttl := linkPreviewTTL
+	if preview == nil {
+		preview = &communityserverv1.LinkPreview{}
+		ttl = linkPreviewFailedTTL
+	}
+
+	data, err := proto.Marshal(preview)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal link preview: %w", err)
+	}
+
+	err = p.redisClient.Set(ctx, key, data, ttl).Err()
+	if err != nil {
+		return nil, fmt.Errorf("failed to cache link preview: %w", err)
+	}
+
+	return preview, nil
+}
+
+// fetch requests a link and builds its preview from the metadata of the page. Links to images are previewed
+// as the image itself.
+func (p *linkPreviewer) fetch(ctx context.Context, link string) (*communityserverv1.LinkPreview, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", link, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create http request: %w", err)
+	}
+	req.Header.Set("Accept", "text/html, image/*;q=0.8")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute http request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("invalid http status code: %d", resp.StatusCode)
+	}
+
+	contentType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid content type: %w", err)
+	}
+
+	if strings.HasPrefix(contentType, "image/") {
+		return &communityserverv1.LinkPreview{
+			Url:      link,
+			ImageUrl: link,
+		}, nil
+	}
+
+	if contentType != "text/html" && contentType != "application/xhtml+xml" {
+		return nil, fmt.Errorf("unsupported content type: %s", contentType)
+	}
+
+	document, err := io.ReadAll(io.LimitReader(resp.Body, maxLinkPreviewDocumentSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read document: %w", err)
+	}
+
+	// Relative image URLs are relative to the page after redirects
+	return parseLinkPreview(link, resp.Request.URL, string(document)), nil
+}
+
+// parseLinkPreview builds the preview of a link from the OpenGraph and Twitter card metadata of its page,
+// falling back to the title of the page. It returns nil if the page has no title nor image.
+func parseLinkPreview(link string, pageUrl *url.URL, document string) *communityserverv1.LinkPreview {
+	metadata := map[string]string{}
+	for _, tag := range metaTagRegexp.FindAllString(document, -1) {
+		attributes := map[string]string{}
+		for _, match := range htmlAttributeRegexp.FindAllStringSubmatch(tag, -1) {
+			attributes[strings.ToLower(match[1])] = match[2] + match[3] + match[4]
+		}
+
+		// OpenGraph uses the property attribute, and Twitter cards use the name attribute
+		name := attributes["property"]
+		if name == "" {
+			name = attributes["name"]
+		}
+		name = strings.ToLower(name)
+
+		// The first occurrence of a property takes precedence, like in the OpenGraph protocol
+		if _, ok := metadata[name]; name != "" && !ok {
+			metadata[name] = strings.TrimSpace(html.UnescapeString(attributes["content"]))
+		}
+	}
+
+	first := func(names ...string) string {
+		for _, name := range names {
+			if metadata[name] != "" {
+				return metadata[name]
+			}
+		}
+		return ""
+	}
+
+	title := first("og:title", "twitter:title")
+	if title == "" {
+		if match := titleTagRegexp.FindStringSubmatch(document); match != nil {
+			title = strings.Join(strings.Fields(html.UnescapeString(match[1])), " ")
+		}
+	}
+
+	var imageUrl string
+	if image := first("og:image:secure_url", "og:image", "og:image:url", "twitter:image", "twitter:image:src"); image != "" {
+		imageUrl = resolveLinkPreviewImage(pageUrl, image)
+	}
+
+	if title == "" && imageUrl == "" {
+		return nil
+	}
+
+	return &communityserverv1.LinkPreview{
+		Url:         link,
+		Title:       truncateRunes(title, maxLinkPreviewTitleLength),
+		Description: truncateRunes(first("og:description", "twitter:description", "description"), maxLinkPreviewDescriptionLength),
+		SiteName:    truncateRunes(first("og:site_name"), maxLinkPreviewSiteNameLength),
+		ImageUrl:    imageUrl,
+	}
+}
+
+// resolveLinkPreviewImage resolves the URL of a preview image against the page it is on, or returns an empty
+// string if it isn't a valid http or https URL.
+func resolveLinkPreviewImage(pageUrl *url.URL, image string) string {
+	imageUrl, err := pageUrl.Parse(image)
+	if err != nil || (imageUrl.Scheme != "http" && imageUrl.Scheme != "https") || imageUrl.Host == "" {
+		return ""
+	}
+
+	resolved := imageUrl.String()
+	if len(resolved) > maxLinkPreviewUrlLength {
+		return ""
+	}
+
+	return resolved
+}
+
+// parseLinks returns the distinct http and https links in a message body, in the order they first appear.
+func parseLinks(body string) []string {
+	var links []string
+	seen := map[string]struct{}{}
+	for _, match := range linkRegexp.FindAllString(body, -1) {
+		// Links at the end of a sentence or in parentheses are followed by punctuation that isn't part of them
+		link := strings.TrimRight(match, ".,:;!?)]}")
+
+		parsedUrl, err := url.Parse(link)
+		if err != nil || parsedUrl.Host == "" || len(link) > maxLinkPreviewUrlLength {
+			continue
+		}
+
+		if _, ok := seen[link]; ok {
+			continue
+		}
+		seen[link] = struct{}{}
+
+		links = append(links, link)
+		if len(links) == maxLinkPreviewsPerMessage {
+			break
+		}
+	}
+
+	return links
+}
+
+func hashLink(link string) string {
+	hash := sha256.Sum256([]byte(link))
+	return hex.EncodeToString(hash[:])
+}
+
+// updateLinkPreviews queues fetching the previews of the links in a message in the background. The previews
+// are stored with the message, and the updated message is announced. Previews are only stored if the message
+// wasn't edited or deleted while they were fetched, since its links may have changed.
+func (o *Routes) updateLinkPreviews(communityId uuid.UUID, message communitydb.Message, hadLinks bool) {
+	links := parseLinks(message.Body)
+	if len(links) == 0 && !hadLinks {
+		return
+	}
+
+	select {
+	case o.linkPreviews.updates <- linkPreviewUpdate{communityId: communityId, message: message, links: links}:
+	default:
+		slog.Info("dropped link preview update, queue is full", "messageId", message.ID)
+	}
+}
+
+// updateQueuedLinkPreviews updates the link previews of queued messages, one at a time, for the lifetime of
+// the server.
+func (o *Routes) updateQueuedLinkPreviews() {
+	for update := range o.linkPreviews.updates {
+		o.updateMessageLinkPreviews(update)
+	}
+}
+
+func (o *Routes) updateMessageLinkPreviews(update linkPreviewUpdate) {
+	ctx, cancel := context.WithTimeout(context.Background(), linkPreviewTimeout)
+	defer cancel()
+
+	var previews []*communityserverv1.LinkPreview
+	for _, link := range update.links {
+		preview, err := o.linkPreviews.preview(ctx, link)
+		if err != nil {
+			slog.Error("failed to get link preview", "error", err)
+			continue
+		}
+
+		if preview != nil {
+			previews = append(previews, preview)
+		}
+	}
+
+	// The stored message is announced rather than the queued one, which may be stale by now
+	message, updated, err := o.storeLinkPreviews(ctx, update.message, previews)
+	if err != nil {
+		slog.Error("failed to store link previews", "error", err)
+		return
+	}
+
+	if updated {
+		o.publishMessageUpdated(ctx, update.communityId, message)
+	}
+}
+
+// storeLinkPreviews replaces the link previews of a message, and returns the stored message and whether its
+// previews changed.
+func (o *Routes) storeLinkPreviews(ctx context.Context, message communitydb.Message, previews []*communityserverv1.LinkPreview) (communitydb.Message, bool, error) {
+	tx, err := o.postgresClient.Begin(ctx)
+	if err != nil {
+		return communitydb.Message{}, false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := communitydb.New(tx)
+
+	current, err := queries.GetMessageForUpdate(ctx, communitydb.GetMessageForUpdateParams{
+		ID:        message.ID,
+		ChannelID: message.ChannelID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return communitydb.Message{}, false, nil
+	}
+	if err != nil {
+		return communitydb.Message{}, false, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	if current.DeletedAt.Valid || current.Body != message.Body {
+		return communitydb.Message{}, false, nil
+	}
+
+	deleted, err := queries.DeleteMessageLinkPreviews(ctx, message.ID)
+	if err != nil {
+		return communitydb.Message{}, false, fmt.Errorf("failed to delete link previews: %w", err)
+	}
+
+	for i, preview := range previews {
+		err = queries.InsertMessageLinkPreview(ctx, communitydb.InsertMessageLinkPreviewParams{
+			MessageID:   message.ID,
+			Position:    int32(i),
+			Url:         preview.Url,
+			Title:       preview.Title,
+			Description: preview.Description,
+			SiteName:    preview.SiteName,
+			ImageUrl:    preview.ImageUrl,
+		})
+		if err != nil {
+			return communitydb.Message{}, false, fmt.Errorf("failed to insert link preview: %w", err)
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return communitydb.Message{}, false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return current, deleted > 0 || len(previews) > 0, nil
+}
+
+// getMessagesLinkPreviews returns the link previews of messages, by message ID. Preview images are served
+// through the image proxy, so clients never contact the hosts of links directly.
+func (o *Routes) getMessagesLinkPreviews(ctx context.Context, messages []communitydb.Message) (map[uuid.UUID][]*communityserverv1.LinkPreview, error) {
+	var messageIds []uuid.UUID
+	for _, message := range messages {
+		if !message.DeletedAt.Valid {
+			messageIds = append(messageIds, message.ID)
+		}
+	}
+
+	previewsProto := map[uuid.UUID][]*communityserverv1.LinkPreview{}
+	if len(messageIds) == 0 {
+		return previewsProto, nil
+	}
+
+	previews, err := o.communityDb.GetMessagesLinkPreviews(ctx, messageIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message link previews: %w", err)
+	}
+
+	for _, preview := range previews {
+		previewProto := &communityserverv1.LinkPreview{
+			Url:         preview.Url,
+			Title:       preview.Title,
+			Description: preview.Description,
+			SiteName:    preview.SiteName,
+		}
+
+		if preview.ImageUrl != "" {
+			previewProto.ImageUrl = o.urlSigner.GenerateSignedURL(preview.ImageUrl)
+		}
+
+		previewsProto[preview.MessageID] = append(previewsProto[preview.MessageID], previewProto)
+	}
+
+	return previewsProto, nil
+}
@@ -0,0 +1,122 @@
+package community
+
+import (
+	"net/url"
+	"slices"
+	"testing"
+
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseLinks(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{
+			name: "no links",
+			body: "hello example.com",
+		},
+		{
+			name: "links",
+			body: "see https://example.com/a?b=c and http://example.org",
+			want: []string{"https://example.com/a?b=c", "http://example.org"},
+		},
+		{
+			name: "punctuation",
+			body: "(https://example.com/a), https://example.com/b.",
+			want: []string{"https://example.com/a", "https://example.com/b"},
+		},
+		{
+			name: "duplicates",
+			body: "https://example.com https://example.com",
+			want: []string{"https://example.com"},
+		},
+		{
+			name: "limit",
+			body: "https://a.com https://b.com https://c.com https://d.com",
+			want: []string{"https://a.com", "https://b.com", "https://c.com"},
+		},
+		{
+			name: "other schemes",
+			body: "ftp://example.com javascript:alert(1)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseLinks(tt.body)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("parseLinks() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLinkPreview(t *testing.T) {
+	const link = "https://example.com/article"
+	pageUrl, _ := url.Parse("https://www.example.com/article")
+
+	tests := []struct {
+		name     string
+		document string
+		want     *communityserverv1.LinkPreview
+	}{
+		{
+			name: "opengraph",
+			document: `<html><head>
+				<meta property="og:title" content="Title &amp; more">
+				<meta property="og:description" content='Description'>
+				<meta property="og:site_name" content="Example">
+				<meta property="og:image" content="/image.png">
+				<meta property="og:title" content="Second title">
+			</head></html>`,
+			want: &communityserverv1.LinkPreview{
+				Url:         link,
+				Title:       "Title & more",
+				Description: "Description",
+				SiteName:    "Example",
+				ImageUrl:    "https://www.example.com/image.png",
+			},
+		},
+		{
+			name: "twitter card",
+			document: `<meta name="twitter:title" content="Title">
+				<meta content="https://cdn.example.com/image.png" name="twitter:image">`,
+			want: &communityserverv1.LinkPreview{
+				Url:      link,
+				Title:    "Title",
+				ImageUrl: "https://cdn.example.com/image.png",
+			},
+		},
+		{
+			name: "title tag",
+			document: `<title>  Page
+				title </title><meta name="description" content="Description">`,
+			want: &communityserverv1.LinkPreview{
+				Url:         link,
+				Title:       "Page title",
+				Description: "Description",
+			},
+		},
+		{
+			name:     "invalid image",
+			document: `<meta property="og:image" content="javascript:alert(1)">`,
+		},
+		{
+			name:     "no metadata",
+			document: `<html><body>hello</body></html>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseLinkPreview(link, pageUrl, tt.document)
+			if (got == nil) != (tt.want == nil) || (got != nil && !proto.Equal(got, tt.want)) {
+				t.Errorf("parseLinkPreview() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
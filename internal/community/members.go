@@ -43,18 +43,43 @@ func (o *Routes) joinServer(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	member, err := o.communityDb.UpsertMember(r.Context(), communitydb.UpsertMemberParams{
-		ID:          uuid.New(),
-		UserAddress: auth.UserAddress,
-	})
-	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+	member, err := o.upsertMember(r.Context(), auth.UserAddress)
+	if err != nil {
 		slog.Error("failed to upsert member", "error", err)
 		http.Error(w, "Internal error", http.StatusInternalServerError)
 		return
 	}
 
+	resp := &communityserverv1.JoinServerResponse{}
+
+	if req.InviteCode != "" {
+		invite, err := o.joinWithInvite(r.Context(), member, req.InviteCode)
+		if errors.Is(err, errInvalidInvite) {
+			http.Error(w, "Invalid invite", http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, errBanned) {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+		if err != nil {
+			slog.Error("failed to join community with invite", "error", err)
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+			return
+		}
+
+		resp.CommunityId = invite.CommunityID.String()
+		if invite.ChannelID.Valid {
+			resp.ChannelId = uuid.UUID(invite.ChannelID.Bytes).String()
+		}
+	}
+
 	if req.JoinDefaultCommunity {
-		err = o.joinDefaultCommunity(r.Context(), member.ID)
+		err = o.joinDefaultCommunity(r.Context(), member)
+		if errors.Is(err, errBanned) {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
 		if err != nil {
 			slog.Error("failed to join default community", "error", err)
 			http.Error(w, "Internal error", http.StatusInternalServerError)
@@ -62,10 +87,32 @@ func (o *Routes) joinServer(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	o.writeProtoJson(w, &communityserverv1.JoinServerResponse{})
+	o.writeProtoJson(w, resp)
 }
 
-func (o *Routes) joinDefaultCommunity(ctx context.Context, memberId uuid.UUID) error {
+// upsertMember returns the member of the user address, and creates it if it doesn't exist.
+func (o *Routes) upsertMember(ctx context.Context, userAddress string) (communitydb.Member, error) {
+	member, err := o.communityDb.UpsertMember(ctx, communitydb.UpsertMemberParams{
+		ID:          uuid.New(),
+		UserAddress: userAddress,
+	})
+	if err == nil {
+		return member, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return communitydb.Member{}, fmt.Errorf("failed to upsert member: %w", err)
+	}
+
+	// The member already exists
+	member, err = o.communityDb.GetMemberByUserAddress(ctx, userAddress)
+	if err != nil {
+		return communitydb.Member{}, fmt.Errorf("failed to get member by user address: %w", err)
+	}
+
+	return member, nil
+}
+
+func (o *Routes) joinDefaultCommunity(ctx context.Context, member communitydb.Member) error {
 	community, err := o.communityDb.GetDefaultCommunity(ctx)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil
@@ -74,14 +121,125 @@ func (o *Routes) joinDefaultCommunity(ctx context.Context, memberId uuid.UUID) e
 		return fmt.Errorf("failed to get the default community: %w", err)
 	}
 
-	_, err = o.communityDb.UpsertCommunityMember(ctx, communitydb.UpsertCommunityMemberParams{
+	return o.joinCommunity(ctx, member, community.ID)
+}
+
+// joinCommunity adds the member to a community, and announces it to the community if it wasn't a member yet.
+// It returns errBanned if the member is banned from the community.
+func (o *Routes) joinCommunity(ctx context.Context, member communitydb.Member, communityId uuid.UUID) error {
+	err := o.checkBan(ctx, communityId, member.UserAddress)
+	if err != nil {
+		return err
+	}
+
+	joined, err := insertCommunityMember(ctx, o.communityDb, member, communityId)
+	if err != nil {
+		return err
+	}
+
+	if joined {
+		o.publishMemberJoined(ctx, communityId, member)
+	}
+
+	return nil
+}
+
+// insertCommunityMember adds the member to the community, and reports whether it wasn't already a member.
+func insertCommunityMember(ctx context.Context, queries *communitydb.Queries, member communitydb.Member, communityId uuid.UUID) (bool, error) {
+	_, err := queries.UpsertCommunityMember(ctx, communitydb.UpsertCommunityMemberParams{
 		ID:          uuid.New(),
-		MemberID:    memberId,
-		CommunityID: community.ID,
+		MemberID:    member.ID,
+		CommunityID: communityId,
 	})
-	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
-		return fmt.Errorf("failed to upsert community member: %w", err)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Already a member of the community
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to upsert community member: %w", err)
 	}
 
-	return nil
+	return true, nil
+}
+
+func (o *Routes) publishMemberJoined(ctx context.Context, communityId uuid.UUID, member communitydb.Member) {
+	o.publishEvent(ctx, communityId, communityserverv1.Event_TYPE_MEMBER_JOINED, &communityserverv1.MemberJoinedEvent{
+		UserAddress: member.UserAddress,
+	})
+}
+
+// leaveCommunityHandler removes the caller from a community. Owners can't leave their community, since it
+// would be left without an owner.
+func (o *Routes) leaveCommunityHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	community, err := o.communityDb.GetCommunity(r.Context(), caller.CommunityID)
+	if err != nil {
+		slog.Error("could not get community", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	if isOwner(community, caller.Member) {
+		http.Error(w, "Owners can't leave their community", http.StatusConflict)
+		return
+	}
+
+	err = o.removeMember(r.Context(), caller.CommunityID, caller.Member)
+	if err != nil {
+		slog.Error("failed to leave community", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.writeProtoJson(w, &communityserverv1.LeaveCommunityResponse{})
+}
+
+// leaveServerHandler removes the caller from every community of the server. Owners must delete their
+// communities before leaving the server.
+func (o *Routes) leaveServerHandler(w http.ResponseWriter, r *http.Request) {
+	auth, ok := o.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	member, err := o.communityDb.GetMemberByUserAddress(r.Context(), auth.UserAddress)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Never joined the server
+		o.writeProtoJson(w, &communityserverv1.LeaveServerResponse{})
+		return
+	}
+	if err != nil {
+		slog.Error("could not get member by user address", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	communities, err := o.communityDb.GetMemberCommunities(r.Context(), member.ID)
+	if err != nil {
+		slog.Error("could not get member communities", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	for _, community := range communities {
+		if isOwner(community, member) {
+			http.Error(w, "Owners can't leave the server", http.StatusConflict)
+			return
+		}
+	}
+
+	for _, community := range communities {
+		err = o.removeMember(r.Context(), community.ID, member)
+		if err != nil {
+			slog.Error("failed to leave community", "error", err)
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	o.writeProtoJson(w, &communityserverv1.LeaveServerResponse{})
 }
@@ -0,0 +1,230 @@
+package community
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/varsotech/prochat-server/internal/homeserver/identity"
+	homeserverv1 "github.com/varsotech/prochat-server/internal/models/gen/homeserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+)
+
+// maxMentionsPerMessage caps the user and role mentions of a message that are counted, so a single message
+// can't cause an unbounded amount of work.
+const maxMentionsPerMessage = 20
+
+var (
+	// userMentionRegexp matches mentions of user addresses, like @0190a8e4-7c1d-7b3e-9f2a-1c2d3e4f5a6b@example.com
+	userMentionRegexp = regexp.MustCompile(`@([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}@[A-Za-z0-9.-]+(?::[0-9]+)?)`)
+
+	// roleMentionRegexp matches mentions of roles by id, like @&0190a8e4-7c1d-7b3e-9f2a-1c2d3e4f5a6b
+	roleMentionRegexp = regexp.MustCompile(`@&([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})`)
+
+	// everyoneMentionRegexp matches @everyone, but not as part of an address like user@everyone.com
+	everyoneMentionRegexp = regexp.MustCompile(`(?:^|[^\w@.-])@everyone(?:$|[^\w@-])`)
+)
+
+// messageMentions are the mentions in a message body.
+type messageMentions struct {
+	UserAddresses []string
+	RoleIds       []uuid.UUID
+	Everyone      bool
+}
+
+func (m messageMentions) empty() bool {
+	return len(m.UserAddresses) == 0 && len(m.RoleIds) == 0 && !m.Everyone
+}
+
+// parseMentions returns the distinct user addresses and roles mentioned in a message body, in the order they
+// are first mentioned, and whether the message mentions everyone.
+func parseMentions(body string) messageMentions {
+	var mentions messageMentions
+
+	seenUsers := map[string]struct{}{}
+	for _, match := range userMentionRegexp.FindAllStringSubmatch(body, -1) {
+		// Mentions at the end of a sentence are followed by a period, which isn't part of the host
+		userAddress := strings.ToLower(strings.TrimRight(match[1], "."))
+		if _, ok := seenUsers[userAddress]; ok {
+			continue
+		}
+		seenUsers[userAddress] = struct{}{}
+
+		mentions.UserAddresses = append(mentions.UserAddresses, userAddress)
+		if len(mentions.UserAddresses) == maxMentionsPerMessage {
+			break
+		}
+	}
+
+	seenRoles := map[uuid.UUID]struct{}{}
+	for _, match := range roleMentionRegexp.FindAllStringSubmatch(body, -1) {
+		roleId, err := uuid.Parse(match[1])
+		if err != nil {
+			continue
+		}
+
+		if _, ok := seenRoles[roleId]; ok {
+			continue
+		}
+		seenRoles[roleId] = struct{}{}
+
+		mentions.RoleIds = append(mentions.RoleIds, roleId)
+		if len(mentions.RoleIds) == maxMentionsPerMessage {
+			break
+		}
+	}
+
+	mentions.Everyone = everyoneMentionRegexp.MatchString(body)
+
+	return mentions
+}
+
+// recordMentions increments the mention counts of the members mentioned in a message, and notifies their
+// homeservers. Members are mentioned directly, through one of their roles, or by mentioning everyone, which
+// requires the mention everyone permission in the channel. Mentions of users that aren't members of the
+// community, or that can't view the channel, are ignored. Like unread counts, mention counts only track the
+// channel history, so mentions in threads notify without counting towards the mention count of the channel.
+func (o *Routes) recordMentions(ctx context.Context, caller *communityMember, channel communitydb.Channel, message communitydb.Message) error {
+	mentions := parseMentions(message.Body)
+	if mentions.empty() {
+		return nil
+	}
+
+	community, err := o.communityDb.GetCommunity(ctx, caller.CommunityID)
+	if err != nil {
+		return fmt.Errorf("failed to get community: %w", err)
+	}
+
+	roles, err := o.communityDb.GetCommunityRoles(ctx, caller.CommunityID)
+	if err != nil {
+		return fmt.Errorf("failed to get community roles: %w", err)
+	}
+
+	rolePermissions := map[uuid.UUID]Permission{}
+	for _, role := range roles {
+		rolePermissions[role.ID] = Permission(role.Permissions)
+	}
+
+	if len(mentions.RoleIds) > 0 || mentions.Everyone {
+		permissions, err := o.getChannelPermissions(ctx, caller, channel.ID)
+		if err != nil {
+			return err
+		}
+
+		if !permissions.Has(PermissionMentionEveryone) {
+			mentions.RoleIds = nil
+			mentions.Everyone = false
+		}
+	}
+
+	mentionedRoles := map[uuid.UUID]struct{}{}
+	var roleIds []uuid.UUID
+	for _, roleId := range mentions.RoleIds {
+		if _, ok := rolePermissions[roleId]; ok {
+			mentionedRoles[roleId] = struct{}{}
+			roleIds = append(roleIds, roleId)
+		}
+	}
+
+	members, err := o.communityDb.GetMentionedMembers(ctx, communitydb.GetMentionedMembersParams{
+		CommunityID:   caller.CommunityID,
+		Everyone:      mentions.Everyone,
+		UserAddresses: mentions.UserAddresses,
+		RoleIds:       roleIds,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get mentioned members: %w", err)
+	}
+
+	overwrites, err := o.communityDb.GetChannelOverwrites(ctx, channel.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get channel overwrites: %w", err)
+	}
+
+	mentionedUsers := map[string]struct{}{}
+	for _, userAddress := range mentions.UserAddresses {
+		mentionedUsers[userAddress] = struct{}{}
+	}
+
+	memberIds := []uuid.UUID{}
+	recipients := map[homeserverv1.Notification_Type][]string{}
+	for _, member := range members {
+		if member.UserAddress == caller.Member.UserAddress {
+			continue
+		}
+
+		base := AllPermissions
+		if !community.OwnerMemberID.Valid || community.OwnerMemberID.Bytes != member.MemberID {
+			var permissions Permission
+			for _, roleId := range member.RoleIds {
+				permissions |= rolePermissions[roleId]
+			}
+			base = basePermissions(permissions)
+		}
+
+		if !resolveChannelPermissions(base, overwrites, member.RoleIds, member.UserAddress).Has(PermissionViewChannel) {
+			continue
+		}
+
+		memberIds = append(memberIds, member.MemberID)
+
+		notificationType := homeserverv1.Notification_TYPE_EVERYONE_MENTION
+		if _, ok := mentionedUsers[strings.ToLower(member.UserAddress)]; ok {
+			notificationType = homeserverv1.Notification_TYPE_USER_MENTION
+		} else if hasMentionedRole(member.RoleIds, mentionedRoles) {
+			notificationType = homeserverv1.Notification_TYPE_ROLE_MENTION
+		}
+		recipients[notificationType] = append(recipients[notificationType], member.UserAddress)
+	}
+
+	if len(memberIds) == 0 {
+		return nil
+	}
+
+	if !message.ThreadID.Valid {
+		err = o.communityDb.IncrementMentionCounts(ctx, communitydb.IncrementMentionCountsParams{
+			MemberIds: memberIds,
+			ChannelID: channel.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to increment mention counts: %w", err)
+		}
+	}
+
+	for notificationType, userAddresses := range recipients {
+		o.notifier.notify(&homeserverv1.Notification{
+			Type:          notificationType,
+			CommunityId:   community.ID.String(),
+			CommunityName: community.Name,
+			ChannelId:     channel.ID.String(),
+			ChannelName:   channel.Name,
+			MessageId:     message.ID.String(),
+			AuthorAddress: message.UserAddress,
+			Body:          truncateRunes(message.Body, identity.MaxNotificationBodyLength),
+		}, userAddresses)
+	}
+
+	return nil
+}
+
+func hasMentionedRole(roleIds []uuid.UUID, mentionedRoles map[uuid.UUID]struct{}) bool {
+	for _, roleId := range roleIds {
+		if _, ok := mentionedRoles[roleId]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// truncateRunes truncates a string to at most maxRunes runes.
+func truncateRunes(s string, maxRunes int) string {
+	if utf8.RuneCountInString(s) <= maxRunes {
+		return s
+	}
+
+	return string([]rune(s)[:maxRunes])
+}
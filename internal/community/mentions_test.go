@@ -0,0 +1,100 @@
+package community
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestParseMentions(t *testing.T) {
+	const (
+		alice = "0190a8e4-7c1d-7b3e-9f2a-1c2d3e4f5a6b@example.com"
+		bob   = "0190a8e4-7c1d-7b3e-9f2a-000000000000@localhost:8080"
+	)
+
+	moderators := uuid.MustParse("0190a8e4-7c1d-7b3e-9f2a-111111111111")
+
+	tests := []struct {
+		name string
+		body string
+		want messageMentions
+	}{
+		{
+			name: "no mentions",
+			body: "hello everyone",
+			want: messageMentions{},
+		},
+		{
+			name: "user mentions",
+			body: "hey @" + alice + ", have you met @" + bob + "?",
+			want: messageMentions{UserAddresses: []string{alice, bob}},
+		},
+		{
+			name: "duplicate mentions",
+			body: "@" + alice + " @" + strings.ToUpper(alice),
+			want: messageMentions{UserAddresses: []string{alice}},
+		},
+		{
+			name: "end of sentence",
+			body: "ask @" + alice + ".",
+			want: messageMentions{UserAddresses: []string{alice}},
+		},
+		{
+			name: "address without mention",
+			body: "mail " + alice,
+			want: messageMentions{},
+		},
+		{
+			name: "invalid user id",
+			body: "@someone@example.com",
+			want: messageMentions{},
+		},
+		{
+			name: "role mentions",
+			body: "@&" + moderators.String() + " @&" + strings.ToUpper(moderators.String()),
+			want: messageMentions{RoleIds: []uuid.UUID{moderators}},
+		},
+		{
+			name: "everyone",
+			body: "@everyone, meeting starts now",
+			want: messageMentions{Everyone: true},
+		},
+		{
+			name: "everyone within a sentence",
+			body: "hey @everyone.",
+			want: messageMentions{Everyone: true},
+		},
+		{
+			name: "everyone as an address",
+			body: "mail admin@everyone.com or @everyones",
+			want: messageMentions{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseMentions(tt.body)
+			if !slices.Equal(got.UserAddresses, tt.want.UserAddresses) || !slices.Equal(got.RoleIds, tt.want.RoleIds) || got.Everyone != tt.want.Everyone {
+				t.Errorf("parseMentions() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseMentionsLimit(t *testing.T) {
+	var body strings.Builder
+	for i := range maxMentionsPerMessage + 5 {
+		fmt.Fprintf(&body, "@0190a8e4-7c1d-7b3e-9f2a-%012d@example.com @&0190a8e4-7c1d-7b3e-9f2a-%012d ", i, i)
+	}
+
+	got := parseMentions(body.String())
+	if len(got.UserAddresses) != maxMentionsPerMessage {
+		t.Errorf("parseMentions() returned %d user mentions, want %d", len(got.UserAddresses), maxMentionsPerMessage)
+	}
+	if len(got.RoleIds) != maxMentionsPerMessage {
+		t.Errorf("parseMentions() returned %d role mentions, want %d", len(got.RoleIds), maxMentionsPerMessage)
+	}
+}
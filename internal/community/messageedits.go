@@ -0,0 +1,315 @@
+package community
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+)
+
+var errNotMessageAuthor = errors.New("not the author of the message")
+
+// updateMessageHandler replaces the body of a message sent by the caller. The prior body is kept as a
+// revision, which moderators can review.
+func (o *Routes) updateMessageHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	channel, _, ok := o.getChannel(w, r, caller, PermissionViewChannel)
+	if !ok {
+		return
+	}
+
+	if isMuted(caller.Membership, time.Now()) {
+		http.Error(w, "Muted", http.StatusForbidden)
+		return
+	}
+
+	messageId, ok := pathUUID(w, r, "messageId")
+	if !ok {
+		return
+	}
+
+	var req communityserverv1.UpdateMessageRequest
+	if !o.readProtoJson(w, r, &req) {
+		return
+	}
+
+	if strings.TrimSpace(req.Body) == "" || utf8.RuneCountInString(req.Body) > maxMessageBodyLength {
+		http.Error(w, "Invalid message body", http.StatusBadRequest)
+		return
+	}
+
+	authorize := func(message communitydb.Message) error {
+		if message.UserAddress != caller.Auth.UserAddress {
+			return errNotMessageAuthor
+		}
+		return nil
+	}
+
+	oldMessage, message, err := o.reviseMessage(r.Context(), channel.ID, messageId, authorize, func(queries *communitydb.Queries) (communitydb.Message, error) {
+		return queries.UpdateMessageBody(r.Context(), communitydb.UpdateMessageBodyParams{
+			ID:   messageId,
+			Body: req.Body,
+		})
+	})
+	if !writeReviseMessageError(w, err) {
+		return
+	}
+
+	messagesProto, err := o.messagesToProto(r.Context(), channel.ID, caller.Auth.UserAddress, []communitydb.Message{message})
+	if err != nil {
+		slog.Error("could not convert message", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.publishChannelEvent(r.Context(), caller.CommunityID, channel.ID, communityserverv1.Event_TYPE_MESSAGE_UPDATED, &communityserverv1.MessageUpdatedEvent{
+		Message: messagesProto[0],
+	})
+
+	// Previews of links that were removed from the message are removed alongside them
+	o.updateLinkPreviews(caller.CommunityID, message, len(parseLinks(oldMessage.Body)) > 0)
+
+	o.writeProtoJson(w, &communityserverv1.UpdateMessageResponse{
+		Message: messagesProto[0],
+	})
+}
+
+// deleteMessageHandler deletes a message, leaving a tombstone without a body in its place. Authors can delete
+// their messages, and members with the manage messages permission can delete any message. Deletes by
+// moderators are recorded in the audit log.
+func (o *Routes) deleteMessageHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	channel, permissions, ok := o.getChannel(w, r, caller, PermissionViewChannel)
+	if !ok {
+		return
+	}
+
+	messageId, ok := pathUUID(w, r, "messageId")
+	if !ok {
+		return
+	}
+
+	authorize := func(message communitydb.Message) error {
+		if message.UserAddress != caller.Auth.UserAddress && !permissions.Has(PermissionManageMessages) {
+			return errNotMessageAuthor
+		}
+		return nil
+	}
+
+	// Deleted messages are no longer pinned, no longer count as replies of their thread, and their
+	// attachments are deleted
+	var unpinned int64
+	var updatedParent *communitydb.Message
+	var attachments []communitydb.MessageAttachment
+	oldMessage, message, err := o.reviseMessage(r.Context(), channel.ID, messageId, authorize, func(queries *communitydb.Queries) (communitydb.Message, error) {
+		var err error
+		unpinned, err = queries.UnpinMessage(r.Context(), communitydb.UnpinMessageParams{
+			MessageID: messageId,
+			ChannelID: channel.ID,
+		})
+		if err != nil {
+			return communitydb.Message{}, fmt.Errorf("failed to unpin message: %w", err)
+		}
+
+		attachments, err = queries.DeleteMessageAttachments(r.Context(), pgtype.UUID{Bytes: messageId, Valid: true})
+		if err != nil {
+			return communitydb.Message{}, fmt.Errorf("failed to delete message attachments: %w", err)
+		}
+
+		message, err := queries.DeleteMessage(r.Context(), communitydb.DeleteMessageParams{
+			ID:        messageId,
+			DeletedBy: caller.Auth.UserAddress,
+		})
+		if err != nil || !message.ThreadID.Valid {
+			return message, err
+		}
+
+		parent, err := queries.RemoveThreadReply(r.Context(), message.ThreadID.Bytes)
+		if err != nil {
+			return communitydb.Message{}, fmt.Errorf("failed to remove thread reply: %w", err)
+		}
+		updatedParent = &parent
+
+		return message, nil
+	})
+	if !writeReviseMessageError(w, err) {
+		return
+	}
+
+	o.deleteAttachmentObjects(r.Context(), attachments)
+
+	if unpinned > 0 {
+		o.publishMessageUnpinned(r.Context(), caller.CommunityID, channel.ID, message.ID)
+	}
+
+	o.publishChannelEvent(r.Context(), caller.CommunityID, channel.ID, communityserverv1.Event_TYPE_MESSAGE_DELETED, &communityserverv1.MessageDeletedEvent{
+		MessageId: message.ID.String(),
+		ChannelId: channel.ID.String(),
+	})
+
+	// Announces the reply count of the thread
+	if updatedParent != nil {
+		o.publishMessageUpdated(r.Context(), caller.CommunityID, *updatedParent)
+	}
+
+	if oldMessage.UserAddress != caller.Auth.UserAddress {
+		o.recordAuditLog(r.Context(), caller, auditLogEntry{
+			Action:   communityserverv1.AuditLogEntry_ACTION_MESSAGE_DELETE,
+			TargetID: message.ID.String(),
+			Reason:   auditReason(r),
+			Before:   messageToProto(oldMessage),
+		})
+	}
+
+	o.writeProtoJson(w, &communityserverv1.DeleteMessageResponse{})
+}
+
+// getMessageRevisionsHandler returns the prior bodies of an edited or deleted message, oldest first.
+func (o *Routes) getMessageRevisionsHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	channel, _, ok := o.getChannel(w, r, caller, PermissionManageMessages)
+	if !ok {
+		return
+	}
+
+	messageId, ok := pathUUID(w, r, "messageId")
+	if !ok {
+		return
+	}
+
+	_, err := o.communityDb.GetMessage(r.Context(), communitydb.GetMessageParams{
+		ID:        messageId,
+		ChannelID: channel.ID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		slog.Error("could not get message", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	revisions, err := o.communityDb.GetMessageRevisions(r.Context(), messageId)
+	if err != nil {
+		slog.Error("could not get message revisions", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	revisionsProto := []*communityserverv1.MessageRevision{}
+	for _, revision := range revisions {
+		revisionsProto = append(revisionsProto, &communityserverv1.MessageRevision{
+			Id:        revision.ID.String(),
+			Body:      revision.Body,
+			CreatedAt: formatTimestamp(revision.CreatedAt),
+		})
+	}
+
+	o.writeProtoJson(w, &communityserverv1.GetMessageRevisionsResponse{
+		Revisions: revisionsProto,
+	})
+}
+
+// reviseMessage locks a message of a channel, keeps its current body as a revision, and replaces it using
+// revise. It returns the message before and after the revision, pgx.ErrNoRows if the message doesn't exist
+// or is deleted, or the error of authorize if the revision isn't allowed.
+func (o *Routes) reviseMessage(ctx context.Context, channelId uuid.UUID, messageId uuid.UUID, authorize func(communitydb.Message) error, revise func(*communitydb.Queries) (communitydb.Message, error)) (communitydb.Message, communitydb.Message, error) {
+	tx, err := o.postgresClient.Begin(ctx)
+	if err != nil {
+		return communitydb.Message{}, communitydb.Message{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := communitydb.New(tx)
+
+	oldMessage, err := queries.GetMessageForUpdate(ctx, communitydb.GetMessageForUpdateParams{
+		ID:        messageId,
+		ChannelID: channelId,
+	})
+	if err != nil {
+		return communitydb.Message{}, communitydb.Message{}, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	if oldMessage.DeletedAt.Valid {
+		return communitydb.Message{}, communitydb.Message{}, pgx.ErrNoRows
+	}
+
+	err = authorize(oldMessage)
+	if err != nil {
+		return communitydb.Message{}, communitydb.Message{}, err
+	}
+
+	revisionId, err := uuid.NewV7()
+	if err != nil {
+		return communitydb.Message{}, communitydb.Message{}, fmt.Errorf("failed to generate message revision id: %w", err)
+	}
+
+	// The current body was written when the message was last edited, or when it was sent
+	writtenAt := oldMessage.CreatedAt
+	if oldMessage.UpdatedAt.Valid {
+		writtenAt = oldMessage.UpdatedAt
+	}
+
+	err = queries.InsertMessageRevision(ctx, communitydb.InsertMessageRevisionParams{
+		ID:        revisionId,
+		MessageID: oldMessage.ID,
+		Body:      oldMessage.Body,
+		CreatedAt: writtenAt,
+	})
+	if err != nil {
+		return communitydb.Message{}, communitydb.Message{}, fmt.Errorf("failed to insert message revision: %w", err)
+	}
+
+	message, err := revise(queries)
+	if err != nil {
+		return communitydb.Message{}, communitydb.Message{}, fmt.Errorf("failed to revise message: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return communitydb.Message{}, communitydb.Message{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return oldMessage, message, nil
+}
+
+// writeReviseMessageError writes the error response of reviseMessage, and reports whether there was no error.
+func writeReviseMessageError(w http.ResponseWriter, err error) bool {
+	switch {
+	case err == nil:
+		return true
+	case errors.Is(err, pgx.ErrNoRows):
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+	case errors.Is(err, errNotMessageAuthor):
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+	default:
+		slog.Error("failed to revise message", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+	}
+
+	return false
+}
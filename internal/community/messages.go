@@ -0,0 +1,578 @@
+package community
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+)
+
+const (
+	defaultMessagesPageSize = 50
+	maxMessagesPageSize     = 100
+	maxMessageBodyLength    = 4000
+)
+
+// getMessagesHandler returns a page of channel message history, ordered from oldest to newest. Messages in
+// threads aren't part of the channel history.
+func (o *Routes) getMessagesHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	channel, _, ok := o.getChannel(w, r, caller, PermissionViewChannel)
+	if !ok {
+		return
+	}
+
+	o.writeMessagesPage(w, r, caller, channel, pgtype.UUID{})
+}
+
+// getThreadMessagesHandler returns a page of the messages of the thread branching off a message, ordered
+// from oldest to newest.
+func (o *Routes) getThreadMessagesHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	channel, _, ok := o.getChannel(w, r, caller, PermissionViewChannel)
+	if !ok {
+		return
+	}
+
+	parent, ok := o.getThreadParent(w, r, channel)
+	if !ok {
+		return
+	}
+
+	o.writeMessagesPage(w, r, caller, channel, pgtype.UUID{Bytes: parent.ID, Valid: true})
+}
+
+// writeMessagesPage writes a page of the history of a channel, or of a thread if threadId is set.
+// Pages are selected using message ID cursors: "before" pages backwards from a message, "after" pages
+// forwards from a message, and no cursor returns the latest messages. Since message IDs are UUIDv7,
+// cursors remain stable as new messages are sent.
+func (o *Routes) writeMessagesPage(w http.ResponseWriter, r *http.Request, caller *communityMember, channel communitydb.Channel, threadId pgtype.UUID) {
+	query := r.URL.Query()
+
+	limit, err := parsePageSize(query.Get("limit"))
+	if err != nil {
+		http.Error(w, "Invalid limit", http.StatusBadRequest)
+		return
+	}
+
+	if query.Has("before") && query.Has("after") {
+		http.Error(w, "Only one of before and after may be set", http.StatusBadRequest)
+		return
+	}
+
+	// Fetch one extra message to know whether there are more messages to page through
+	maxResults := limit + 1
+
+	var messages []communitydb.Message
+	switch {
+	case query.Has("before"):
+		before, parseErr := uuid.Parse(query.Get("before"))
+		if parseErr != nil {
+			http.Error(w, "Invalid before cursor", http.StatusBadRequest)
+			return
+		}
+
+		if threadId.Valid {
+			messages, err = o.communityDb.GetThreadMessagesBefore(r.Context(), communitydb.GetThreadMessagesBeforeParams{
+				ThreadID:   threadId,
+				Before:     before,
+				MaxResults: maxResults,
+			})
+		} else {
+			messages, err = o.communityDb.GetChannelMessagesBefore(r.Context(), communitydb.GetChannelMessagesBeforeParams{
+				ChannelID:  channel.ID,
+				Before:     before,
+				MaxResults: maxResults,
+			})
+		}
+	case query.Has("after"):
+		after, parseErr := uuid.Parse(query.Get("after"))
+		if parseErr != nil {
+			http.Error(w, "Invalid after cursor", http.StatusBadRequest)
+			return
+		}
+
+		if threadId.Valid {
+			messages, err = o.communityDb.GetThreadMessagesAfter(r.Context(), communitydb.GetThreadMessagesAfterParams{
+				ThreadID:   threadId,
+				After:      after,
+				MaxResults: maxResults,
+			})
+		} else {
+			messages, err = o.communityDb.GetChannelMessagesAfter(r.Context(), communitydb.GetChannelMessagesAfterParams{
+				ChannelID:  channel.ID,
+				After:      after,
+				MaxResults: maxResults,
+			})
+		}
+	default:
+		if threadId.Valid {
+			messages, err = o.communityDb.GetLatestThreadMessages(r.Context(), communitydb.GetLatestThreadMessagesParams{
+				ThreadID:   threadId,
+				MaxResults: maxResults,
+			})
+		} else {
+			messages, err = o.communityDb.GetLatestChannelMessages(r.Context(), communitydb.GetLatestChannelMessagesParams{
+				ChannelID:  channel.ID,
+				MaxResults: maxResults,
+			})
+		}
+	}
+	if err != nil {
+		slog.Error("could not get channel messages", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	hasMore := len(messages) > int(limit)
+	if hasMore {
+		messages = messages[:limit]
+	}
+
+	// Pages that aren't paging forwards are queried newest first
+	if !query.Has("after") {
+		slices.Reverse(messages)
+	}
+
+	messagesProto, err := o.messagesToProto(r.Context(), channel.ID, caller.Auth.UserAddress, messages)
+	if err != nil {
+		slog.Error("could not convert messages", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.writeProtoJson(w, &communityserverv1.GetMessagesResponse{
+		Messages: messagesProto,
+		HasMore:  hasMore,
+	})
+}
+
+func (o *Routes) sendMessageHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	channel, _, ok := o.getChannel(w, r, caller, PermissionSendMessages)
+	if !ok {
+		return
+	}
+
+	o.sendMessage(w, r, caller, channel, nil)
+}
+
+// sendThreadMessageHandler sends a message to the thread branching off a message. Threads are started by
+// their first message, and can't be started on deleted messages.
+func (o *Routes) sendThreadMessageHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	channel, _, ok := o.getChannel(w, r, caller, PermissionSendMessages)
+	if !ok {
+		return
+	}
+
+	parent, ok := o.getThreadParent(w, r, channel)
+	if !ok {
+		return
+	}
+
+	if parent.DeletedAt.Valid && parent.ThreadReplyCount == 0 {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	o.sendMessage(w, r, caller, channel, &parent)
+}
+
+// sendMessage sends a message to a channel, or to the thread branching off parent if it is set. Messages in a
+// thread can only reply to messages in the same thread.
+func (o *Routes) sendMessage(w http.ResponseWriter, r *http.Request, caller *communityMember, channel communitydb.Channel, parent *communitydb.Message) {
+	if isMuted(caller.Membership, time.Now()) {
+		http.Error(w, "Muted", http.StatusForbidden)
+		return
+	}
+
+	var req communityserverv1.SendMessageRequest
+	if !o.readProtoJson(w, r, &req) {
+		return
+	}
+
+	attachmentIds, err := parseAttachmentIds(req.AttachmentIds)
+	if err != nil {
+		http.Error(w, "Invalid attachments", http.StatusBadRequest)
+		return
+	}
+
+	// Messages with attachments don't need a body
+	if (strings.TrimSpace(req.Body) == "" && len(attachmentIds) == 0) || utf8.RuneCountInString(req.Body) > maxMessageBodyLength {
+		http.Error(w, "Invalid message body", http.StatusBadRequest)
+		return
+	}
+
+	var threadId pgtype.UUID
+	if parent != nil {
+		threadId = pgtype.UUID{Bytes: parent.ID, Valid: true}
+	}
+
+	var replyToMessageId pgtype.UUID
+	if req.ReplyToMessageId != "" {
+		replyTo, ok := o.getReplyTo(w, r, channel, threadId, req.ReplyToMessageId)
+		if !ok {
+			return
+		}
+
+		replyToMessageId = pgtype.UUID{Bytes: replyTo.ID, Valid: true}
+	}
+
+	// Only valid messages count against the rate limits
+	refundSend, ok := o.requireSendAllowed(w, r, caller, channel)
+	if !ok {
+		return
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		refundSend(r.Context())
+		slog.Error("failed to generate message id", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	message, updatedParent, err := o.insertMessage(r.Context(), communitydb.InsertMessageParams{
+		ID:               id,
+		ChannelID:        channel.ID,
+		UserAddress:      caller.Auth.UserAddress,
+		Body:             req.Body,
+		ReplyToMessageID: replyToMessageId,
+		ThreadID:         threadId,
+		HasAttachments:   len(attachmentIds) > 0,
+	}, attachmentIds)
+	if err != nil {
+		refundSend(r.Context())
+	}
+	if errors.Is(err, errInvalidAttachments) {
+		http.Error(w, "Invalid attachments", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		slog.Error("failed to insert message", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Sending a message reads the channel up to it. Read states only track the channel history, so messages
+	// in threads don't read the channel.
+	if parent == nil {
+		err = o.ackChannel(r.Context(), caller, channel.ID, message.ID)
+		if err != nil {
+			slog.Error("failed to ack sent message", "error", err)
+		}
+	}
+
+	err = o.recordMentions(r.Context(), caller, channel, message)
+	if err != nil {
+		slog.Error("failed to record mentions", "error", err)
+	}
+
+	o.updateLinkPreviews(caller.CommunityID, message, false)
+
+	messagesProto, err := o.messagesToProto(r.Context(), channel.ID, caller.Auth.UserAddress, []communitydb.Message{message})
+	if err != nil {
+		slog.Error("could not convert message", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.publishChannelEvent(r.Context(), caller.CommunityID, channel.ID, communityserverv1.Event_TYPE_MESSAGE_CREATED, &communityserverv1.MessageCreatedEvent{
+		Message: messagesProto[0],
+	})
+
+	// Announces the reply count and last activity of the thread
+	if updatedParent != nil {
+		o.publishMessageUpdated(r.Context(), caller.CommunityID, *updatedParent)
+	}
+
+	o.writeProtoJson(w, &communityserverv1.SendMessageResponse{
+		Message: messagesProto[0],
+	})
+}
+
+// insertMessage inserts a message with its attachments, and adds it to the reply count of its thread. It
+// returns the message that the thread branches off if the message is in a thread.
+func (o *Routes) insertMessage(ctx context.Context, params communitydb.InsertMessageParams, attachmentIds []uuid.UUID) (communitydb.Message, *communitydb.Message, error) {
+	tx, err := o.postgresClient.Begin(ctx)
+	if err != nil {
+		return communitydb.Message{}, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := communitydb.New(tx)
+
+	message, err := queries.InsertMessage(ctx, params)
+	if err != nil {
+		return communitydb.Message{}, nil, fmt.Errorf("failed to insert message: %w", err)
+	}
+
+	err = attachMessageAttachments(ctx, queries, message, attachmentIds)
+	if err != nil {
+		return communitydb.Message{}, nil, err
+	}
+
+	var parent *communitydb.Message
+	if params.ThreadID.Valid {
+		updatedParent, err := queries.AddThreadReply(ctx, params.ThreadID.Bytes)
+		if err != nil {
+			return communitydb.Message{}, nil, fmt.Errorf("failed to add thread reply: %w", err)
+		}
+
+		parent = &updatedParent
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return communitydb.Message{}, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return message, parent, nil
+}
+
+// getThreadParent gets the message in the {messageId} path parameter that a thread branches off, and writes
+// the error response if it isn't a message of the channel. Threads can't branch off messages in threads.
+func (o *Routes) getThreadParent(w http.ResponseWriter, r *http.Request, channel communitydb.Channel) (communitydb.Message, bool) {
+	messageId, ok := pathUUID(w, r, "messageId")
+	if !ok {
+		return communitydb.Message{}, false
+	}
+
+	parent, err := o.communityDb.GetMessage(r.Context(), communitydb.GetMessageParams{
+		ID:        messageId,
+		ChannelID: channel.ID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return communitydb.Message{}, false
+	}
+	if err != nil {
+		slog.Error("could not get thread parent message", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return communitydb.Message{}, false
+	}
+
+	if parent.ThreadID.Valid {
+		http.Error(w, "Threads can't branch off messages in threads", http.StatusBadRequest)
+		return communitydb.Message{}, false
+	}
+
+	return parent, true
+}
+
+// getReplyTo gets the message a new message replies to, and writes the error response if it isn't a message
+// of the same channel and thread.
+func (o *Routes) getReplyTo(w http.ResponseWriter, r *http.Request, channel communitydb.Channel, threadId pgtype.UUID, replyToMessageId string) (communitydb.Message, bool) {
+	id, err := uuid.Parse(replyToMessageId)
+	if err != nil {
+		http.Error(w, "Invalid reply to message id", http.StatusBadRequest)
+		return communitydb.Message{}, false
+	}
+
+	replyTo, err := o.communityDb.GetMessage(r.Context(), communitydb.GetMessageParams{
+		ID:        id,
+		ChannelID: channel.ID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, "Invalid reply to message id", http.StatusBadRequest)
+		return communitydb.Message{}, false
+	}
+	if err != nil {
+		slog.Error("could not get replied message", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return communitydb.Message{}, false
+	}
+
+	if replyTo.ThreadID != threadId {
+		http.Error(w, "Replies must be in the same thread as the message they reply to", http.StatusBadRequest)
+		return communitydb.Message{}, false
+	}
+
+	return replyTo, true
+}
+
+// getChannel gets the channel in the {channelId} path parameter and the caller's permissions in it. It writes
+// the error response if the channel doesn't belong to the caller's community, or if the caller doesn't have
+// the required permissions in it.
+func (o *Routes) getChannel(w http.ResponseWriter, r *http.Request, caller *communityMember, required Permission) (communitydb.Channel, Permission, bool) {
+	channelId, ok := pathUUID(w, r, "channelId")
+	if !ok {
+		return communitydb.Channel{}, 0, false
+	}
+
+	channel, err := o.communityDb.GetChannel(r.Context(), communitydb.GetChannelParams{
+		ID:          channelId,
+		CommunityID: caller.CommunityID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return communitydb.Channel{}, 0, false
+	}
+	if err != nil {
+		slog.Error("could not get channel", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return communitydb.Channel{}, 0, false
+	}
+
+	permissions, ok := o.requireChannelPermission(w, r, caller, channel.ID, required)
+	if !ok {
+		return communitydb.Channel{}, 0, false
+	}
+
+	return channel, permissions, true
+}
+
+func parsePageSize(limit string) (int32, error) {
+	if limit == "" {
+		return defaultMessagesPageSize, nil
+	}
+
+	pageSize, err := strconv.ParseInt(limit, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	if pageSize < 1 || pageSize > maxMessagesPageSize {
+		return 0, errors.New("page size out of range")
+	}
+
+	return int32(pageSize), nil
+}
+
+// publishMessageUpdated announces the new state of a message to the members that can view its channel.
+func (o *Routes) publishMessageUpdated(ctx context.Context, communityId uuid.UUID, message communitydb.Message) {
+	messagesProto, err := o.messagesToProto(ctx, message.ChannelID, "", []communitydb.Message{message})
+	if err != nil {
+		slog.Error("could not convert updated message", "error", err)
+		return
+	}
+
+	o.publishChannelEvent(ctx, communityId, message.ChannelID, communityserverv1.Event_TYPE_MESSAGE_UPDATED, &communityserverv1.MessageUpdatedEvent{
+		Message: messagesProto[0],
+	})
+}
+
+// messagesToProto converts messages of a channel to their protos, alongside references to the messages they
+// reply to, their reactions, attachments and link previews. Reactions made by the user are marked as its own.
+func (o *Routes) messagesToProto(ctx context.Context, channelId uuid.UUID, userAddress string, messages []communitydb.Message) ([]*communityserverv1.Message, error) {
+	var messageIds, replyToIds []uuid.UUID
+	for _, message := range messages {
+		messageIds = append(messageIds, message.ID)
+		if message.ReplyToMessageID.Valid {
+			replyToIds = append(replyToIds, message.ReplyToMessageID.Bytes)
+		}
+	}
+
+	repliesTo := map[uuid.UUID]communitydb.Message{}
+	if len(replyToIds) > 0 {
+		replyToMessages, err := o.communityDb.GetMessagesByIds(ctx, communitydb.GetMessagesByIdsParams{
+			ChannelID: channelId,
+			Ids:       replyToIds,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get replied messages: %w", err)
+		}
+
+		for _, replyTo := range replyToMessages {
+			repliesTo[replyTo.ID] = replyTo
+		}
+	}
+
+	reactions, err := o.getMessagesReactions(ctx, userAddress, messageIds)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := o.getMessagesAttachments(ctx, messages)
+	if err != nil {
+		return nil, err
+	}
+
+	linkPreviews, err := o.getMessagesLinkPreviews(ctx, messages)
+	if err != nil {
+		return nil, err
+	}
+
+	messagesProto := []*communityserverv1.Message{}
+	for _, message := range messages {
+		messageProto := messageToProto(message)
+		messageProto.Reactions = reactions[message.ID]
+		messageProto.Attachments = attachments[message.ID]
+		messageProto.LinkPreviews = linkPreviews[message.ID]
+		if replyTo, ok := repliesTo[message.ReplyToMessageID.Bytes]; ok && message.ReplyToMessageID.Valid {
+			messageProto.ReplyTo = &communityserverv1.MessageReference{
+				Id:          replyTo.ID.String(),
+				UserAddress: replyTo.UserAddress,
+				Body:        replyTo.Body,
+				Deleted:     replyTo.DeletedAt.Valid,
+			}
+		}
+
+		messagesProto = append(messagesProto, messageProto)
+	}
+
+	return messagesProto, nil
+}
+
+func messageToProto(message communitydb.Message) *communityserverv1.Message {
+	return &communityserverv1.Message{
+		Id:                   message.ID.String(),
+		ChannelId:            message.ChannelID.String(),
+		UserAddress:          message.UserAddress,
+		Body:                 message.Body,
+		CreatedAt:            formatTimestamp(message.CreatedAt),
+		UpdatedAt:            formatTimestamp(message.UpdatedAt),
+		Deleted:              message.DeletedAt.Valid,
+		ReplyToMessageId:     formatUUID(message.ReplyToMessageID),
+		ThreadId:             formatUUID(message.ThreadID),
+		ThreadReplyCount:     message.ThreadReplyCount,
+		ThreadLastActivityAt: formatTimestamp(message.ThreadLastActivityAt),
+	}
+}
+
+// formatUUID formats a nullable UUID, or returns an empty string if it is null.
+func formatUUID(id pgtype.UUID) string {
+	if !id.Valid {
+		return ""
+	}
+
+	return uuid.UUID(id.Bytes).String()
+}
+
+// formatTimestamp formats a timestamp as RFC 3339, or returns an empty string if it is null.
+func formatTimestamp(t pgtype.Timestamptz) string {
+	if !t.Valid {
+		return ""
+	}
+
+	return t.Time.UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,601 @@
+package community
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+)
+
+const (
+	maxModerationDuration = 365 * 24 * time.Hour
+	maxBanReasonLength    = 500
+)
+
+var errBanned = errors.New("banned from the community")
+
+// userAddressRegexp matches a user address, which is formatted as uuid@host
+var userAddressRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}@[A-Za-z0-9.-]+(?::[0-9]+)?$`)
+
+func (o *Routes) kickMemberHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	callerPermissions, ok := o.requirePermission(w, r, caller, PermissionKickMembers)
+	if !ok {
+		return
+	}
+
+	target, ok := o.getTargetMember(w, r, caller, r.PathValue("userAddress"))
+	if !ok {
+		return
+	}
+
+	if !o.requireModeratable(w, r, caller, callerPermissions, target) {
+		return
+	}
+
+	err := o.removeMember(r.Context(), caller.CommunityID, target)
+	if err != nil {
+		slog.Error("failed to kick member", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_MEMBER_KICK,
+		TargetID: target.UserAddress,
+		Reason:   auditReason(r),
+	})
+
+	o.writeProtoJson(w, &communityserverv1.KickMemberResponse{})
+}
+
+// muteMemberHandler prevents a member from sending messages for a duration. Muting a muted member replaces
+// the duration of its mute.
+func (o *Routes) muteMemberHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	callerPermissions, ok := o.requirePermission(w, r, caller, PermissionMuteMembers)
+	if !ok {
+		return
+	}
+
+	var req communityserverv1.MuteMemberRequest
+	if !o.readProtoJson(w, r, &req) {
+		return
+	}
+
+	if req.DurationSeconds <= 0 || req.DurationSeconds > int64(maxModerationDuration/time.Second) {
+		http.Error(w, "Invalid duration", http.StatusBadRequest)
+		return
+	}
+
+	target, ok := o.getTargetMember(w, r, caller, r.PathValue("userAddress"))
+	if !ok {
+		return
+	}
+
+	if !o.requireModeratable(w, r, caller, callerPermissions, target) {
+		return
+	}
+
+	mutedUntil := pgtype.Timestamptz{Time: time.Now().Add(time.Duration(req.DurationSeconds) * time.Second), Valid: true}
+
+	ok = o.setMutedUntil(w, r, caller, target, mutedUntil)
+	if !ok {
+		return
+	}
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_MEMBER_MUTE,
+		TargetID: target.UserAddress,
+		Reason:   auditReason(r),
+		After: &communityserverv1.MemberMutedEvent{
+			UserAddress: target.UserAddress,
+			MutedUntil:  formatTimestamp(mutedUntil),
+		},
+	})
+
+	o.writeProtoJson(w, &communityserverv1.MuteMemberResponse{
+		MutedUntil: formatTimestamp(mutedUntil),
+	})
+}
+
+func (o *Routes) unmuteMemberHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	callerPermissions, ok := o.requirePermission(w, r, caller, PermissionMuteMembers)
+	if !ok {
+		return
+	}
+
+	target, ok := o.getTargetMember(w, r, caller, r.PathValue("userAddress"))
+	if !ok {
+		return
+	}
+
+	if !o.requireModeratable(w, r, caller, callerPermissions, target) {
+		return
+	}
+
+	ok = o.setMutedUntil(w, r, caller, target, pgtype.Timestamptz{})
+	if !ok {
+		return
+	}
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_MEMBER_UNMUTE,
+		TargetID: target.UserAddress,
+		Reason:   auditReason(r),
+	})
+
+	o.writeProtoJson(w, &communityserverv1.UnmuteMemberResponse{})
+}
+
+func (o *Routes) getBansHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	_, ok = o.requirePermission(w, r, caller, PermissionBanMembers)
+	if !ok {
+		return
+	}
+
+	bans, err := o.communityDb.GetCommunityBans(r.Context(), caller.CommunityID)
+	if err != nil {
+		slog.Error("could not get community bans", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	bansProto := []*communityserverv1.Ban{}
+	for _, ban := range bans {
+		bansProto = append(bansProto, banToProto(ban))
+	}
+
+	o.writeProtoJson(w, &communityserverv1.GetBansResponse{
+		Bans: bansProto,
+	})
+}
+
+// createBanHandler bans a user address or every user of a homeserver host from the community, and removes
+// the banned users that are currently members. Banning an already banned target replaces its ban. Targets
+// are stored lowercase. Host bans are rejected if they would remove members the caller can't moderate.
+func (o *Routes) createBanHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	callerPermissions, ok := o.requirePermission(w, r, caller, PermissionBanMembers)
+	if !ok {
+		return
+	}
+
+	var req communityserverv1.CreateBanRequest
+	if !o.readProtoJson(w, r, &req) {
+		return
+	}
+
+	if (req.UserAddress == "") == (req.Host == "") {
+		http.Error(w, "Exactly one of user address and host must be set", http.StatusBadRequest)
+		return
+	}
+
+	if utf8.RuneCountInString(req.Reason) > maxBanReasonLength {
+		http.Error(w, "Invalid reason", http.StatusBadRequest)
+		return
+	}
+
+	if req.DurationSeconds < 0 || req.DurationSeconds > int64(maxModerationDuration/time.Second) {
+		http.Error(w, "Invalid duration", http.StatusBadRequest)
+		return
+	}
+
+	var expiresAt pgtype.Timestamptz
+	if req.DurationSeconds > 0 {
+		expiresAt = pgtype.Timestamptz{Time: time.Now().Add(time.Duration(req.DurationSeconds) * time.Second), Valid: true}
+	}
+
+	var target string
+	var isHost bool
+	var removedMembers []communitydb.Member
+	if req.UserAddress != "" {
+		target = strings.ToLower(req.UserAddress)
+
+		if !userAddressRegexp.MatchString(target) {
+			http.Error(w, "Invalid user address", http.StatusBadRequest)
+			return
+		}
+
+		// Bans match addresses case-insensitively, so every member the ban matches is removed
+		members, err := o.communityDb.GetMembersByUserAddressInsensitive(r.Context(), target)
+		if err != nil {
+			slog.Error("could not get members by user address", "error", err)
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+			return
+		}
+
+		for _, member := range members {
+			_, err = o.communityDb.GetCommunityMember(r.Context(), communitydb.GetCommunityMemberParams{
+				MemberID:    member.ID,
+				CommunityID: caller.CommunityID,
+			})
+			if errors.Is(err, pgx.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				slog.Error("could not get community member", "error", err)
+				http.Error(w, "Internal error", http.StatusInternalServerError)
+				return
+			}
+
+			if !o.requireModeratable(w, r, caller, callerPermissions, member) {
+				return
+			}
+
+			removedMembers = append(removedMembers, member)
+		}
+	} else {
+		target = strings.ToLower(req.Host)
+		isHost = true
+
+		if strings.ContainsAny(target, "@/ ") {
+			http.Error(w, "Invalid host", http.StatusBadRequest)
+			return
+		}
+
+		if !o.requireBannableHost(w, r, caller, target) {
+			return
+		}
+
+		// A host ban removes every member from the host, so it's only allowed if each of them can be moderated
+		removedMembers, ok = o.requireModeratableHost(w, r, caller, callerPermissions, target)
+		if !ok {
+			return
+		}
+	}
+
+	ban, err := o.communityDb.UpsertBan(r.Context(), communitydb.UpsertBanParams{
+		ID:          uuid.New(),
+		CommunityID: caller.CommunityID,
+		Target:      target,
+		IsHost:      isHost,
+		Reason:      req.Reason,
+		BannedBy:    caller.Member.UserAddress,
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		slog.Error("failed to upsert ban", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	reason := req.Reason
+	if reason == "" {
+		reason = auditReason(r)
+	}
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_BAN_CREATE,
+		TargetID: ban.Target,
+		Reason:   reason,
+		After:    banToProto(ban),
+	})
+
+	for _, member := range removedMembers {
+		err = o.removeMember(r.Context(), caller.CommunityID, member)
+		if err != nil {
+			slog.Error("failed to remove banned member", "error", err)
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+			return
+		}
+
+		o.recordAuditLog(r.Context(), caller, auditLogEntry{
+			Action:   communityserverv1.AuditLogEntry_ACTION_BAN_MEMBER_REMOVE,
+			TargetID: member.UserAddress,
+			Reason:   reason,
+		})
+	}
+
+	o.writeProtoJson(w, &communityserverv1.CreateBanResponse{
+		Ban: banToProto(ban),
+	})
+}
+
+func (o *Routes) deleteBanHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	_, ok = o.requirePermission(w, r, caller, PermissionBanMembers)
+	if !ok {
+		return
+	}
+
+	banId, ok := pathUUID(w, r, "banId")
+	if !ok {
+		return
+	}
+
+	ban, err := o.communityDb.DeleteBan(r.Context(), communitydb.DeleteBanParams{
+		ID:          banId,
+		CommunityID: caller.CommunityID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		slog.Error("failed to delete ban", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_BAN_DELETE,
+		TargetID: ban.Target,
+		Reason:   auditReason(r),
+		Before:   banToProto(ban),
+	})
+
+	o.writeProtoJson(w, &communityserverv1.DeleteBanResponse{})
+}
+
+// requireModeratable reports whether the caller may moderate the target member, and writes a forbidden
+// response if it may not. Owners can't be moderated, only owners can moderate administrators, and other
+// members can only be moderated by members that have every permission they have.
+func (o *Routes) requireModeratable(w http.ResponseWriter, r *http.Request, caller *communityMember, callerPermissions Permission, target communitydb.Member) bool {
+	if target.ID == caller.Member.ID {
+		http.Error(w, "Members can't moderate themselves", http.StatusBadRequest)
+		return false
+	}
+
+	community, err := o.communityDb.GetCommunity(r.Context(), caller.CommunityID)
+	if err != nil {
+		slog.Error("could not get community", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return false
+	}
+
+	moderatable, err := o.canModerate(r.Context(), caller, callerPermissions, community, target)
+	if err != nil {
+		slog.Error("failed to check whether member is moderatable", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return false
+	}
+
+	if !moderatable {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return false
+	}
+
+	return true
+}
+
+// canModerate reports whether the caller may moderate the target member of the community.
+func (o *Routes) canModerate(ctx context.Context, caller *communityMember, callerPermissions Permission, community communitydb.Community, target communitydb.Member) (bool, error) {
+	if target.ID == caller.Member.ID || isOwner(community, target) {
+		return false, nil
+	}
+
+	if isOwner(community, caller.Member) {
+		return true, nil
+	}
+
+	targetPermissions, err := o.getPermissions(ctx, &communityMember{Member: target, CommunityID: caller.CommunityID})
+	if err != nil {
+		return false, fmt.Errorf("failed to get target permissions: %w", err)
+	}
+
+	return !targetPermissions.Has(PermissionAdministrator) && callerPermissions.Has(targetPermissions), nil
+}
+
+// requireModeratableHost reports whether the caller may moderate every member of the community from a
+// homeserver host, and writes a forbidden response if it may not. It returns the members from the host.
+func (o *Routes) requireModeratableHost(w http.ResponseWriter, r *http.Request, caller *communityMember, callerPermissions Permission, host string) ([]communitydb.Member, bool) {
+	community, err := o.communityDb.GetCommunity(r.Context(), caller.CommunityID)
+	if err != nil {
+		slog.Error("could not get community", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return nil, false
+	}
+
+	members, err := o.communityDb.GetHostCommunityMembers(r.Context(), communitydb.GetHostCommunityMembersParams{
+		CommunityID: caller.CommunityID,
+		Host:        host,
+	})
+	if err != nil {
+		slog.Error("could not get host community members", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return nil, false
+	}
+
+	for _, member := range members {
+		moderatable, err := o.canModerate(r.Context(), caller, callerPermissions, community, member)
+		if err != nil {
+			slog.Error("failed to check whether member is moderatable", "error", err)
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+			return nil, false
+		}
+
+		if !moderatable {
+			http.Error(w, "Banning the host would remove members you can't moderate", http.StatusForbidden)
+			return nil, false
+		}
+	}
+
+	return members, true
+}
+
+// requireBannableHost reports whether a homeserver host may be banned, and writes a forbidden response if it
+// may not. Hosts of the caller and of the owner can't be banned, since that would lock them out.
+func (o *Routes) requireBannableHost(w http.ResponseWriter, r *http.Request, caller *communityMember, host string) bool {
+	if userAddressHost(caller.Member.UserAddress) == host {
+		http.Error(w, "Members can't ban their own host", http.StatusBadRequest)
+		return false
+	}
+
+	community, err := o.communityDb.GetCommunity(r.Context(), caller.CommunityID)
+	if err != nil {
+		slog.Error("could not get community", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return false
+	}
+
+	if !community.OwnerMemberID.Valid {
+		return true
+	}
+
+	owner, err := o.communityDb.GetMember(r.Context(), community.OwnerMemberID.Bytes)
+	if err != nil {
+		slog.Error("could not get community owner", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return false
+	}
+
+	if userAddressHost(owner.UserAddress) == host {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return false
+	}
+
+	return true
+}
+
+func (o *Routes) setMutedUntil(w http.ResponseWriter, r *http.Request, caller *communityMember, target communitydb.Member, mutedUntil pgtype.Timestamptz) bool {
+	updated, err := o.communityDb.SetCommunityMemberMutedUntil(r.Context(), communitydb.SetCommunityMemberMutedUntilParams{
+		MemberID:    target.ID,
+		CommunityID: caller.CommunityID,
+		MutedUntil:  mutedUntil,
+	})
+	if err != nil {
+		slog.Error("failed to set member muted until", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return false
+	}
+
+	if updated == 0 {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return false
+	}
+
+	o.publishEvent(r.Context(), caller.CommunityID, communityserverv1.Event_TYPE_MEMBER_MUTED, &communityserverv1.MemberMutedEvent{
+		UserAddress: target.UserAddress,
+		MutedUntil:  formatTimestamp(mutedUntil),
+	})
+
+	return true
+}
+
+// removeMember removes a member from a community alongside its roles in it, and announces it to the
+// community.
+func (o *Routes) removeMember(ctx context.Context, communityId uuid.UUID, member communitydb.Member) error {
+	_, err := o.communityDb.DeleteCommunityMember(ctx, communitydb.DeleteCommunityMemberParams{
+		MemberID:    member.ID,
+		CommunityID: communityId,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete community member: %w", err)
+	}
+
+	err = o.communityDb.DeleteCommunityMemberRoles(ctx, communitydb.DeleteCommunityMemberRolesParams{
+		MemberID:    member.ID,
+		CommunityID: communityId,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete community member roles: %w", err)
+	}
+
+	err = o.communityDb.DeleteCommunityMemberReadStates(ctx, communitydb.DeleteCommunityMemberReadStatesParams{
+		MemberID:    member.ID,
+		CommunityID: communityId,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete community member read states: %w", err)
+	}
+
+	err = o.presence.removeMember(ctx, communityId, member.UserAddress)
+	if err != nil {
+		// The member is no longer counted as online once its presence expires
+		slog.Error("failed to remove online member", "error", err)
+	}
+
+	o.publishEvent(ctx, communityId, communityserverv1.Event_TYPE_MEMBER_REMOVED, &communityserverv1.MemberRemovedEvent{
+		UserAddress: member.UserAddress,
+	})
+
+	return nil
+}
+
+// checkBan returns errBanned if the user address, or its homeserver host, is banned from the community.
+func (o *Routes) checkBan(ctx context.Context, communityId uuid.UUID, userAddress string) error {
+	_, err := o.communityDb.GetActiveBan(ctx, communitydb.GetActiveBanParams{
+		CommunityID: communityId,
+		UserAddress: userAddress,
+		Host:        userAddressHost(userAddress),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get active ban: %w", err)
+	}
+
+	return errBanned
+}
+
+// isMuted reports whether the member is muted in its community.
+func isMuted(membership communitydb.CommunityMember, now time.Time) bool {
+	return membership.MutedUntil.Valid && now.Before(membership.MutedUntil.Time)
+}
+
+func isOwner(community communitydb.Community, member communitydb.Member) bool {
+	return community.OwnerMemberID.Valid && community.OwnerMemberID.Bytes == member.ID
+}
+
+// userAddressHost returns the lowercase homeserver host of a user address, which is formatted as uuid@host.
+func userAddressHost(userAddress string) string {
+	_, host, _ := strings.Cut(userAddress, "@")
+	return strings.ToLower(host)
+}
+
+func banToProto(ban communitydb.Ban) *communityserverv1.Ban {
+	banProto := &communityserverv1.Ban{
+		Id:        ban.ID.String(),
+		Reason:    ban.Reason,
+		BannedBy:  ban.BannedBy,
+		ExpiresAt: formatTimestamp(ban.ExpiresAt),
+		CreatedAt: formatTimestamp(ban.CreatedAt),
+	}
+
+	if ban.IsHost {
+		banProto.Host = ban.Target
+	} else {
+		banProto.UserAddress = ban.Target
+	}
+
+	return banProto
+}
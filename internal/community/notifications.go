@@ -0,0 +1,151 @@
+package community
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/varsotech/prochat-server/internal/homeserver/identity"
+	homeserverv1 "github.com/varsotech/prochat-server/internal/models/gen/homeserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/httputil"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	notificationDeliveryTimeout = 30 * time.Second
+
+	// notificationWorkers bounds the notifications delivered concurrently
+	notificationWorkers = 16
+
+	// maxQueuedNotificationDeliveries bounds the deliveries waiting for a worker. Deliveries are dropped while
+	// the queue is full.
+	maxQueuedNotificationDeliveries = 1000
+)
+
+// mentionNotifier pushes notifications to the homeserver of their recipients, so users find out about
+// mentions in communities they aren't connected to. Notifications are signed with the identity key of the
+// server, which homeservers verify with the public key in its well known document.
+type mentionNotifier struct {
+	httpClient         *httputil.Client
+	host               string
+	identityPrivateKey string
+	deliveries         chan notificationDelivery
+}
+
+// notificationDelivery is a notification waiting to be pushed to the homeserver at host.
+type notificationDelivery struct {
+	host         string
+	recipients   []string
+	notification []byte
+}
+
+func newMentionNotifier(host string, identityPrivateKey string) *mentionNotifier {
+	n := &mentionNotifier{
+		httpClient:         httputil.NewClient(),
+		host:               host,
+		identityPrivateKey: identityPrivateKey,
+		deliveries:         make(chan notificationDelivery, maxQueuedNotificationDeliveries),
+	}
+
+	if identityPrivateKey != "" {
+		for range notificationWorkers {
+			go n.deliverQueued()
+		}
+	}
+
+	return n
+}
+
+// notify queues a notification for delivery to the homeservers of the given users. Delivery is best effort,
+// so failures are logged and not retried. Servers without an identity key don't push notifications.
+func (n *mentionNotifier) notify(notification *homeserverv1.Notification, userAddresses []string) {
+	if n.identityPrivateKey == "" {
+		return
+	}
+
+	data, err := proto.Marshal(notification)
+	if err != nil {
+		slog.Error("failed to marshal notification", "error", err)
+		return
+	}
+
+	recipientsByHost := map[string][]string{}
+	for _, userAddress := range userAddresses {
+		userId, host, ok := strings.Cut(userAddress, "@")
+		if !ok {
+			continue
+		}
+
+		recipientsByHost[host] = append(recipientsByHost[host], userId)
+	}
+
+	for host, recipients := range recipientsByHost {
+		for batch := range slices.Chunk(recipients, identity.MaxNotificationRecipients) {
+			select {
+			case n.deliveries <- notificationDelivery{host: host, recipients: batch, notification: data}:
+			default:
+				slog.Info("dropped notification, delivery queue is full", "host", host)
+			}
+		}
+	}
+}
+
+// deliverQueued delivers queued notifications, one at a time, for the lifetime of the server.
+func (n *mentionNotifier) deliverQueued() {
+	for delivery := range n.deliveries {
+		ctx, cancel := context.WithTimeout(context.Background(), notificationDeliveryTimeout)
+		err := n.deliver(ctx, delivery.host, delivery.recipients, delivery.notification)
+		cancel()
+		if err != nil {
+			slog.Info("failed to deliver notification", "error", err, "host", delivery.host)
+		}
+	}
+}
+
+// deliver pushes a notification to the homeserver at host, signed for the given recipients.
+func (n *mentionNotifier) deliver(ctx context.Context, host string, recipients []string, notification []byte) error {
+	token, err := identity.NewNotificationClaims(n.host, host, recipients, notification).Sign(n.identityPrivateKey)
+	if err != nil {
+		return err
+	}
+
+	homeserverUrl, err := identity.ParseIssuer(host)
+	if err != nil {
+		return err
+	}
+
+	body, err := protojson.Marshal(&homeserverv1.DeliverNotificationsRequest{
+		Token: token,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", homeserverUrl.JoinPath("/api/v1/homeserver/notifications").String(), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute deliver notifications request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("homeserver returned bad status: %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,362 @@
+package community
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+	"google.golang.org/protobuf/proto"
+)
+
+// ChannelPermissions are the permissions that can be allowed or denied per channel.
+const ChannelPermissions = PermissionViewChannel | PermissionSendMessages | PermissionManageMessages | PermissionManageChannels
+
+// Overwrite target types, as stored in the database.
+const (
+	overwriteTargetEveryone = "everyone"
+	overwriteTargetRole     = "role"
+	overwriteTargetMember   = "member"
+)
+
+// resolveChannelPermissions applies the overwrites of a channel to the community permissions of a member.
+// Overwrites are applied from the least to the most specific, so the most specific overwrite wins:
+//
+//  1. Owners and administrators have every permission, and overwrites don't apply to them.
+//  2. The everyone overwrite of the channel.
+//  3. The overwrites of the member's roles. The denies of all roles are applied before their allows, so a
+//     role allowing a permission wins over another role denying it.
+//  4. The overwrite of the member itself.
+//
+// Within a single overwrite, deny is applied before allow. Members that can't view a channel have no
+// permissions in it.
+func resolveChannelPermissions(base Permission, overwrites []communitydb.ChannelOverwrite, roleIds []uuid.UUID, userAddress string) Permission {
+	if base.Has(PermissionAdministrator) {
+		return AllPermissions
+	}
+
+	roles := map[string]struct{}{}
+	for _, roleId := range roleIds {
+		roles[roleId.String()] = struct{}{}
+	}
+
+	var everyone, member *communitydb.ChannelOverwrite
+	var roleAllow, roleDeny Permission
+	for i, overwrite := range overwrites {
+		switch overwrite.TargetType {
+		case overwriteTargetEveryone:
+			everyone = &overwrites[i]
+		case overwriteTargetRole:
+			if _, ok := roles[overwrite.Target]; ok {
+				roleAllow |= Permission(overwrite.Allow)
+				roleDeny |= Permission(overwrite.Deny)
+			}
+		case overwriteTargetMember:
+			if overwrite.Target == userAddress {
+				member = &overwrites[i]
+			}
+		}
+	}
+
+	permissions := base
+	if everyone != nil {
+		permissions = permissions&^Permission(everyone.Deny) | Permission(everyone.Allow)
+	}
+	permissions = permissions&^roleDeny | roleAllow
+	if member != nil {
+		permissions = permissions&^Permission(member.Deny) | Permission(member.Allow)
+	}
+
+	if !permissions.Has(PermissionViewChannel) {
+		return 0
+	}
+
+	return permissions
+}
+
+// getChannelPermissions resolves the permissions of the caller in a channel of its community.
+func (o *Routes) getChannelPermissions(ctx context.Context, caller *communityMember, channelId uuid.UUID) (Permission, error) {
+	base, err := o.getPermissions(ctx, caller)
+	if err != nil {
+		return 0, err
+	}
+
+	if base.Has(PermissionAdministrator) {
+		return base, nil
+	}
+
+	overwrites, err := o.communityDb.GetChannelOverwrites(ctx, channelId)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get channel overwrites: %w", err)
+	}
+
+	roleIds, err := o.communityDb.GetMemberRoleIds(ctx, communitydb.GetMemberRoleIdsParams{
+		MemberID:    caller.Member.ID,
+		CommunityID: caller.CommunityID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get member role ids: %w", err)
+	}
+
+	return resolveChannelPermissions(base, overwrites, roleIds, caller.Member.UserAddress), nil
+}
+
+// getVisibleChannels returns the channels of the caller's community that it can view.
+func (o *Routes) getVisibleChannels(ctx context.Context, caller *communityMember) ([]communitydb.Channel, error) {
+	channels, err := o.communityDb.GetCommunityChannels(ctx, caller.CommunityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get community channels: %w", err)
+	}
+
+	base, err := o.getPermissions(ctx, caller)
+	if err != nil {
+		return nil, err
+	}
+
+	if base.Has(PermissionAdministrator) {
+		return channels, nil
+	}
+
+	overwrites, err := o.communityDb.GetCommunityChannelOverwrites(ctx, caller.CommunityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get community channel overwrites: %w", err)
+	}
+
+	roleIds, err := o.communityDb.GetMemberRoleIds(ctx, communitydb.GetMemberRoleIdsParams{
+		MemberID:    caller.Member.ID,
+		CommunityID: caller.CommunityID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member role ids: %w", err)
+	}
+
+	channelOverwrites := map[uuid.UUID][]communitydb.ChannelOverwrite{}
+	for _, overwrite := range overwrites {
+		channelOverwrites[overwrite.ChannelID] = append(channelOverwrites[overwrite.ChannelID], overwrite)
+	}
+
+	var visibleChannels []communitydb.Channel
+	for _, channel := range channels {
+		permissions := resolveChannelPermissions(base, channelOverwrites[channel.ID], roleIds, caller.Member.UserAddress)
+		if permissions.Has(PermissionViewChannel) {
+			visibleChannels = append(visibleChannels, channel)
+		}
+	}
+
+	return visibleChannels, nil
+}
+
+// requireChannelPermission is the channel counterpart of requirePermission. Channels the caller can't view
+// are reported as not found, so their existence isn't leaked.
+func (o *Routes) requireChannelPermission(w http.ResponseWriter, r *http.Request, caller *communityMember, channelId uuid.UUID, required Permission) (Permission, bool) {
+	permissions, err := o.getChannelPermissions(r.Context(), caller, channelId)
+	if err != nil {
+		slog.Error("failed to get channel permissions", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return 0, false
+	}
+
+	if !permissions.Has(PermissionViewChannel) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return 0, false
+	}
+
+	if !permissions.Has(required) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return 0, false
+	}
+
+	return permissions, true
+}
+
+func (o *Routes) getChannelOverwritesHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	channel, _, ok := o.getChannel(w, r, caller, PermissionManageChannels)
+	if !ok {
+		return
+	}
+
+	overwrites, err := o.communityDb.GetChannelOverwrites(r.Context(), channel.ID)
+	if err != nil {
+		slog.Error("could not get channel overwrites", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	overwritesProto := []*communityserverv1.PermissionOverwrite{}
+	for _, overwrite := range overwrites {
+		overwritesProto = append(overwritesProto, overwriteToProto(overwrite))
+	}
+
+	o.writeProtoJson(w, &communityserverv1.GetChannelOverwritesResponse{
+		Overwrites: overwritesProto,
+	})
+}
+
+// setChannelOverwriteHandler creates or replaces the overwrite of a target in a channel. Overwrites that
+// neither allow nor deny anything are removed.
+func (o *Routes) setChannelOverwriteHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	channel, callerPermissions, ok := o.getChannel(w, r, caller, PermissionManageChannels)
+	if !ok {
+		return
+	}
+
+	var req communityserverv1.SetChannelOverwriteRequest
+	if !o.readProtoJson(w, r, &req) {
+		return
+	}
+
+	if req.Overwrite == nil {
+		http.Error(w, "Missing overwrite", http.StatusBadRequest)
+		return
+	}
+
+	allow, deny := Permission(req.Overwrite.Allow), Permission(req.Overwrite.Deny)
+	if allow&^ChannelPermissions != 0 || deny&^ChannelPermissions != 0 || allow&deny != 0 {
+		http.Error(w, "Invalid permissions", http.StatusBadRequest)
+		return
+	}
+
+	// Members can't allow or deny permissions they don't have themselves
+	if !callerPermissions.Has(allow | deny) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	targetType, target, ok := o.getOverwriteTarget(w, r, caller, req.Overwrite)
+	if !ok {
+		return
+	}
+
+	var before proto.Message
+	oldOverwrite, err := o.communityDb.GetChannelOverwrite(r.Context(), communitydb.GetChannelOverwriteParams{
+		ChannelID:  channel.ID,
+		TargetType: targetType,
+		Target:     target,
+	})
+	if err == nil {
+		before = overwriteToProto(oldOverwrite)
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		slog.Error("could not get channel overwrite", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	var after proto.Message
+	if allow == 0 && deny == 0 {
+		_, err = o.communityDb.DeleteChannelOverwrite(r.Context(), communitydb.DeleteChannelOverwriteParams{
+			ChannelID:  channel.ID,
+			TargetType: targetType,
+			Target:     target,
+		})
+		if err != nil {
+			slog.Error("failed to delete channel overwrite", "error", err)
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+			return
+		}
+	} else {
+		overwrite, err := o.communityDb.UpsertChannelOverwrite(r.Context(), communitydb.UpsertChannelOverwriteParams{
+			ChannelID:  channel.ID,
+			TargetType: targetType,
+			Target:     target,
+			Allow:      int64(allow),
+			Deny:       int64(deny),
+		})
+		if err != nil {
+			slog.Error("failed to upsert channel overwrite", "error", err)
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+			return
+		}
+
+		after = overwriteToProto(overwrite)
+	}
+
+	// Members that gained access to the channel learn about it, members that lost access can't be told
+	o.publishChannelEvent(r.Context(), caller.CommunityID, channel.ID, communityserverv1.Event_TYPE_CHANNEL_UPDATED, &communityserverv1.ChannelUpdatedEvent{
+		Channel: channelToProto(channel),
+	})
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_CHANNEL_OVERWRITE_UPDATE,
+		TargetID: channel.ID.String(),
+		Reason:   auditReason(r),
+		Before:   before,
+		After:    after,
+	})
+
+	o.writeProtoJson(w, &communityserverv1.SetChannelOverwriteResponse{})
+}
+
+// getOverwriteTarget validates the target of an overwrite, and returns how it is stored. It writes the
+// error response if the target isn't a role or member of the caller's community.
+func (o *Routes) getOverwriteTarget(w http.ResponseWriter, r *http.Request, caller *communityMember, overwrite *communityserverv1.PermissionOverwrite) (string, string, bool) {
+	switch overwrite.TargetType {
+	case communityserverv1.PermissionOverwrite_TARGET_TYPE_EVERYONE:
+		return overwriteTargetEveryone, "", true
+	case communityserverv1.PermissionOverwrite_TARGET_TYPE_ROLE:
+		roleId, err := uuid.Parse(overwrite.TargetId)
+		if err != nil {
+			http.Error(w, "Invalid role id", http.StatusBadRequest)
+			return "", "", false
+		}
+
+		role, err := o.communityDb.GetRole(r.Context(), communitydb.GetRoleParams{
+			ID:          roleId,
+			CommunityID: caller.CommunityID,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return "", "", false
+		}
+		if err != nil {
+			slog.Error("could not get role", "error", err)
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+			return "", "", false
+		}
+
+		return overwriteTargetRole, role.ID.String(), true
+	case communityserverv1.PermissionOverwrite_TARGET_TYPE_MEMBER:
+		member, ok := o.getTargetMember(w, r, caller, overwrite.TargetId)
+		if !ok {
+			return "", "", false
+		}
+
+		return overwriteTargetMember, member.UserAddress, true
+	default:
+		http.Error(w, "Invalid target type", http.StatusBadRequest)
+		return "", "", false
+	}
+}
+
+func overwriteToProto(overwrite communitydb.ChannelOverwrite) *communityserverv1.PermissionOverwrite {
+	var targetType communityserverv1.PermissionOverwrite_TargetType
+	switch overwrite.TargetType {
+	case overwriteTargetEveryone:
+		targetType = communityserverv1.PermissionOverwrite_TARGET_TYPE_EVERYONE
+	case overwriteTargetRole:
+		targetType = communityserverv1.PermissionOverwrite_TARGET_TYPE_ROLE
+	case overwriteTargetMember:
+		targetType = communityserverv1.PermissionOverwrite_TARGET_TYPE_MEMBER
+	}
+
+	return &communityserverv1.PermissionOverwrite{
+		TargetType: targetType,
+		TargetId:   overwrite.Target,
+		Allow:      overwrite.Allow,
+		Deny:       overwrite.Deny,
+	}
+}
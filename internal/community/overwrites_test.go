@@ -0,0 +1,99 @@
+package community
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+)
+
+func TestResolveChannelPermissions(t *testing.T) {
+	staffRoleId := uuid.New()
+	mutedRoleId := uuid.New()
+	userAddress := "user@example.com"
+
+	everyone := func(allow, deny Permission) communitydb.ChannelOverwrite {
+		return communitydb.ChannelOverwrite{TargetType: overwriteTargetEveryone, Allow: int64(allow), Deny: int64(deny)}
+	}
+	role := func(roleId uuid.UUID, allow, deny Permission) communitydb.ChannelOverwrite {
+		return communitydb.ChannelOverwrite{TargetType: overwriteTargetRole, Target: roleId.String(), Allow: int64(allow), Deny: int64(deny)}
+	}
+	member := func(userAddress string, allow, deny Permission) communitydb.ChannelOverwrite {
+		return communitydb.ChannelOverwrite{TargetType: overwriteTargetMember, Target: userAddress, Allow: int64(allow), Deny: int64(deny)}
+	}
+
+	tests := []struct {
+		name       string
+		base       Permission
+		overwrites []communitydb.ChannelOverwrite
+		roleIds    []uuid.UUID
+		want       Permission
+	}{
+		{
+			name: "no overwrites",
+			base: DefaultPermissions,
+			want: DefaultPermissions,
+		},
+		{
+			name:       "read-only channel",
+			base:       DefaultPermissions,
+			overwrites: []communitydb.ChannelOverwrite{everyone(0, PermissionSendMessages)},
+			want:       PermissionViewChannel,
+		},
+		{
+			name:       "private channel hides everything",
+			base:       DefaultPermissions | PermissionManageMessages,
+			overwrites: []communitydb.ChannelOverwrite{everyone(0, PermissionViewChannel)},
+			want:       0,
+		},
+		{
+			name:       "role overwrite wins over everyone",
+			base:       DefaultPermissions,
+			overwrites: []communitydb.ChannelOverwrite{everyone(0, PermissionViewChannel), role(staffRoleId, PermissionViewChannel, 0)},
+			roleIds:    []uuid.UUID{staffRoleId},
+			want:       DefaultPermissions,
+		},
+		{
+			name:       "overwrites of other roles don't apply",
+			base:       DefaultPermissions,
+			overwrites: []communitydb.ChannelOverwrite{everyone(0, PermissionViewChannel), role(staffRoleId, PermissionViewChannel, 0)},
+			roleIds:    []uuid.UUID{mutedRoleId},
+			want:       0,
+		},
+		{
+			name:       "role allow wins over role deny",
+			base:       DefaultPermissions,
+			overwrites: []communitydb.ChannelOverwrite{role(mutedRoleId, 0, PermissionSendMessages), role(staffRoleId, PermissionSendMessages, 0)},
+			roleIds:    []uuid.UUID{staffRoleId, mutedRoleId},
+			want:       DefaultPermissions,
+		},
+		{
+			name:       "member overwrite wins over roles",
+			base:       DefaultPermissions,
+			overwrites: []communitydb.ChannelOverwrite{role(staffRoleId, PermissionSendMessages, 0), member(userAddress, 0, PermissionSendMessages)},
+			roleIds:    []uuid.UUID{staffRoleId},
+			want:       PermissionViewChannel,
+		},
+		{
+			name:       "overwrites of other members don't apply",
+			base:       DefaultPermissions,
+			overwrites: []communitydb.ChannelOverwrite{member("other@example.com", 0, PermissionViewChannel)},
+			want:       DefaultPermissions,
+		},
+		{
+			name:       "administrators ignore overwrites",
+			base:       AllPermissions,
+			overwrites: []communitydb.ChannelOverwrite{everyone(0, PermissionViewChannel), member(userAddress, 0, PermissionViewChannel)},
+			want:       AllPermissions,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resolveChannelPermissions(tt.base, tt.overwrites, tt.roleIds, userAddress)
+			if got != tt.want {
+				t.Errorf("resolveChannelPermissions() = %b, want %b", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package community
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+)
+
+// Permission is a bitset of community permissions. The bit values are defined by communityserverv1.Permission,
+// so they are shared with clients.
+type Permission int64
+
+const (
+	PermissionManageChannels  = Permission(communityserverv1.Permission_PERMISSION_MANAGE_CHANNELS)
+	PermissionManageMessages  = Permission(communityserverv1.Permission_PERMISSION_MANAGE_MESSAGES)
+	PermissionKickMembers     = Permission(communityserverv1.Permission_PERMISSION_KICK_MEMBERS)
+	PermissionBanMembers      = Permission(communityserverv1.Permission_PERMISSION_BAN_MEMBERS)
+	PermissionManageRoles     = Permission(communityserverv1.Permission_PERMISSION_MANAGE_ROLES)
+	PermissionAdministrator   = Permission(communityserverv1.Permission_PERMISSION_ADMINISTRATOR)
+	PermissionViewChannel     = Permission(communityserverv1.Permission_PERMISSION_VIEW_CHANNEL)
+	PermissionSendMessages    = Permission(communityserverv1.Permission_PERMISSION_SEND_MESSAGES)
+	PermissionManageInvites   = Permission(communityserverv1.Permission_PERMISSION_MANAGE_INVITES)
+	PermissionMuteMembers     = Permission(communityserverv1.Permission_PERMISSION_MUTE_MEMBERS)
+	PermissionViewAuditLog    = Permission(communityserverv1.Permission_PERMISSION_VIEW_AUDIT_LOG)
+	PermissionManageCommunity = Permission(communityserverv1.Permission_PERMISSION_MANAGE_COMMUNITY)
+	PermissionManageEmojis    = Permission(communityserverv1.Permission_PERMISSION_MANAGE_EMOJIS)
+	PermissionMentionEveryone = Permission(communityserverv1.Permission_PERMISSION_MENTION_EVERYONE)
+
+	// AllPermissions is granted to community owners and administrators
+	AllPermissions = PermissionManageChannels | PermissionManageMessages | PermissionKickMembers |
+		PermissionBanMembers | PermissionManageRoles | PermissionAdministrator | PermissionViewChannel |
+		PermissionSendMessages | PermissionManageInvites | PermissionMuteMembers | PermissionViewAuditLog | PermissionManageCommunity |
+		PermissionManageEmojis | PermissionMentionEveryone
+
+	// DefaultPermissions are granted to every member of a community, on top of the permissions of their roles
+	DefaultPermissions = PermissionViewChannel | PermissionSendMessages
+)
+
+// Has reports whether every permission in required is granted.
+func (p Permission) Has(required Permission) bool {
+	return p&required == required
+}
+
+// Valid reports whether the bitset only contains known permissions.
+func (p Permission) Valid() bool {
+	return p&^AllPermissions == 0
+}
+
+// getPermissions resolves the community-wide permissions of the caller, which are the default permissions
+// and the permissions of its roles. Owners and members with the administrator permission are granted every
+// permission.
+func (o *Routes) getPermissions(ctx context.Context, caller *communityMember) (Permission, error) {
+	community, err := o.communityDb.GetCommunity(ctx, caller.CommunityID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get community: %w", err)
+	}
+
+	if isOwner(community, caller.Member) {
+		return AllPermissions, nil
+	}
+
+	permissions, err := o.communityDb.GetMemberPermissions(ctx, communitydb.GetMemberPermissionsParams{
+		MemberID:    caller.Member.ID,
+		CommunityID: caller.CommunityID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get member permissions: %w", err)
+	}
+
+	return basePermissions(Permission(permissions)), nil
+}
+
+// basePermissions resolves the community-wide permissions of a member that isn't the owner from the combined
+// permissions of its roles.
+func basePermissions(rolePermissions Permission) Permission {
+	if rolePermissions.Has(PermissionAdministrator) {
+		return AllPermissions
+	}
+
+	return DefaultPermissions | rolePermissions
+}
+
+// requirePermission is the permission check consulted by community handlers. It returns the permissions of
+// the caller, and writes a forbidden response if the required permissions aren't granted.
+func (o *Routes) requirePermission(w http.ResponseWriter, r *http.Request, caller *communityMember, required Permission) (Permission, bool) {
+	permissions, err := o.getPermissions(r.Context(), caller)
+	if err != nil {
+		slog.Error("failed to get permissions", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return 0, false
+	}
+
+	if !permissions.Has(required) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return 0, false
+	}
+
+	return permissions, true
+}
@@ -0,0 +1,265 @@
+package community
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+)
+
+// maxPinnedMessages is the amount of messages that can be pinned to a channel
+const maxPinnedMessages = 50
+
+var (
+	errMessageAlreadyPinned = errors.New("message is already pinned")
+	errTooManyPins          = errors.New("too many pinned messages")
+)
+
+// getPinnedMessagesHandler returns the messages pinned to a channel, most recently pinned first.
+func (o *Routes) getPinnedMessagesHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	channel, _, ok := o.getChannel(w, r, caller, PermissionViewChannel)
+	if !ok {
+		return
+	}
+
+	pins, err := o.communityDb.GetPinnedMessages(r.Context(), channel.ID)
+	if err != nil {
+		slog.Error("could not get pinned messages", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	pinsProto, err := o.pinnedMessagesToProto(r.Context(), channel.ID, caller.Auth.UserAddress, pins)
+	if err != nil {
+		slog.Error("could not convert pinned messages", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.writeProtoJson(w, &communityserverv1.GetPinnedMessagesResponse{
+		Pins: pinsProto,
+	})
+}
+
+// pinMessageHandler pins a message to its channel. Pinning requires the manage messages permission in the
+// channel, and is recorded in the audit log. Pinning a pinned message is a no-op.
+func (o *Routes) pinMessageHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	channel, _, ok := o.getChannel(w, r, caller, PermissionManageMessages)
+	if !ok {
+		return
+	}
+
+	message, ok := o.getChannelMessage(w, r, channel)
+	if !ok {
+		return
+	}
+
+	pin, err := o.pinMessage(r.Context(), channel, communitydb.PinMessageParams{
+		MessageID: message.ID,
+		ChannelID: channel.ID,
+		PinnedBy:  caller.Auth.UserAddress,
+	})
+	if errors.Is(err, errMessageAlreadyPinned) {
+		o.writeProtoJson(w, &communityserverv1.PinMessageResponse{})
+		return
+	}
+	if errors.Is(err, errTooManyPins) {
+		http.Error(w, "Too many pinned messages", http.StatusConflict)
+		return
+	}
+	if err != nil {
+		slog.Error("failed to pin message", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	pinsProto, err := o.pinnedMessagesToProto(r.Context(), channel.ID, caller.Auth.UserAddress, []communitydb.PinnedMessage{pin})
+	if err != nil {
+		slog.Error("could not convert pinned message", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.publishChannelEvent(r.Context(), caller.CommunityID, channel.ID, communityserverv1.Event_TYPE_MESSAGE_PINNED, &communityserverv1.MessagePinnedEvent{
+		Pin: pinsProto[0],
+	})
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_MESSAGE_PIN,
+		TargetID: message.ID.String(),
+		Reason:   auditReason(r),
+		After:    pinsProto[0],
+	})
+
+	o.writeProtoJson(w, &communityserverv1.PinMessageResponse{
+		Pin: pinsProto[0],
+	})
+}
+
+// pinMessage pins a message to its channel. The channel is locked while its pins are counted, so concurrent
+// pins can't exceed maxPinnedMessages. It returns errMessageAlreadyPinned if the message is pinned, and
+// errTooManyPins if the channel has no room for another pin.
+func (o *Routes) pinMessage(ctx context.Context, channel communitydb.Channel, params communitydb.PinMessageParams) (communitydb.PinnedMessage, error) {
+	tx, err := o.postgresClient.Begin(ctx)
+	if err != nil {
+		return communitydb.PinnedMessage{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := communitydb.New(tx)
+
+	_, err = queries.GetChannelForUpdate(ctx, communitydb.GetChannelForUpdateParams{
+		ID:          channel.ID,
+		CommunityID: channel.CommunityID,
+	})
+	if err != nil {
+		return communitydb.PinnedMessage{}, fmt.Errorf("failed to lock channel: %w", err)
+	}
+
+	alreadyPinned, err := queries.IsMessagePinned(ctx, params.MessageID)
+	if err != nil {
+		return communitydb.PinnedMessage{}, fmt.Errorf("failed to check pinned message: %w", err)
+	}
+
+	if alreadyPinned {
+		return communitydb.PinnedMessage{}, errMessageAlreadyPinned
+	}
+
+	pinned, err := queries.CountPinnedMessages(ctx, channel.ID)
+	if err != nil {
+		return communitydb.PinnedMessage{}, fmt.Errorf("failed to count pinned messages: %w", err)
+	}
+
+	if pinned >= maxPinnedMessages {
+		return communitydb.PinnedMessage{}, errTooManyPins
+	}
+
+	pin, err := queries.PinMessage(ctx, params)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return communitydb.PinnedMessage{}, errMessageAlreadyPinned
+	}
+	if err != nil {
+		return communitydb.PinnedMessage{}, fmt.Errorf("failed to pin message: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return communitydb.PinnedMessage{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return pin, nil
+}
+
+// unpinMessageHandler unpins a message from its channel. Unpinning requires the manage messages permission in
+// the channel, and is recorded in the audit log.
+func (o *Routes) unpinMessageHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	channel, _, ok := o.getChannel(w, r, caller, PermissionManageMessages)
+	if !ok {
+		return
+	}
+
+	messageId, ok := pathUUID(w, r, "messageId")
+	if !ok {
+		return
+	}
+
+	unpinned, err := o.communityDb.UnpinMessage(r.Context(), communitydb.UnpinMessageParams{
+		MessageID: messageId,
+		ChannelID: channel.ID,
+	})
+	if err != nil {
+		slog.Error("failed to unpin message", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	if unpinned == 0 {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	o.publishMessageUnpinned(r.Context(), caller.CommunityID, channel.ID, messageId)
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_MESSAGE_UNPIN,
+		TargetID: messageId.String(),
+		Reason:   auditReason(r),
+	})
+
+	o.writeProtoJson(w, &communityserverv1.UnpinMessageResponse{})
+}
+
+// publishMessageUnpinned announces that a message is no longer pinned to its channel.
+func (o *Routes) publishMessageUnpinned(ctx context.Context, communityId uuid.UUID, channelId uuid.UUID, messageId uuid.UUID) {
+	o.publishChannelEvent(ctx, communityId, channelId, communityserverv1.Event_TYPE_MESSAGE_UNPINNED, &communityserverv1.MessageUnpinnedEvent{
+		MessageId: messageId.String(),
+		ChannelId: channelId.String(),
+	})
+}
+
+// pinnedMessagesToProto converts the pins of a channel to their protos, alongside their messages.
+func (o *Routes) pinnedMessagesToProto(ctx context.Context, channelId uuid.UUID, userAddress string, pins []communitydb.PinnedMessage) ([]*communityserverv1.PinnedMessage, error) {
+	pinsProto := []*communityserverv1.PinnedMessage{}
+	if len(pins) == 0 {
+		return pinsProto, nil
+	}
+
+	var messageIds []uuid.UUID
+	for _, pin := range pins {
+		messageIds = append(messageIds, pin.MessageID)
+	}
+
+	messages, err := o.communityDb.GetMessagesByIds(ctx, communitydb.GetMessagesByIdsParams{
+		ChannelID: channelId,
+		Ids:       messageIds,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pinned messages: %w", err)
+	}
+
+	messagesProto, err := o.messagesToProto(ctx, channelId, userAddress, messages)
+	if err != nil {
+		return nil, err
+	}
+
+	messagesProtoById := map[string]*communityserverv1.Message{}
+	for _, messageProto := range messagesProto {
+		messagesProtoById[messageProto.Id] = messageProto
+	}
+
+	for _, pin := range pins {
+		messageProto, ok := messagesProtoById[pin.MessageID.String()]
+		if !ok {
+			continue
+		}
+
+		pinsProto = append(pinsProto, &communityserverv1.PinnedMessage{
+			Message:  messageProto,
+			PinnedBy: pin.PinnedBy,
+			PinnedAt: formatTimestamp(pin.PinnedAt),
+		})
+	}
+
+	return pinsProto, nil
+}
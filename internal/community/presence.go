@@ -0,0 +1,274 @@
+package community
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	presenceKeyPrefix            = "community:presence:"
+	presenceConnectionsKeyPrefix = "community:presence:connections:"
+	onlineKeyPrefix              = "community:online:"
+
+	// presenceTTL is how long a connection is considered online after its last heartbeat, so users of
+	// instances that stopped without disconnecting them eventually appear offline
+	presenceTTL               = 90 * time.Second
+	presenceHeartbeatInterval = 30 * time.Second
+	maxCustomStatusLength     = 128
+)
+
+// presenceStore tracks the presence of users in Redis, so it is shared by every instance of the server.
+//
+// A user is online while any of its gateway connections sent a heartbeat within presenceTTL. The status
+// chosen by the user is stored alongside its connections, and the members of a community that are online
+// are stored in a sorted set per community, scored by the time their presence expires.
+type presenceStore struct {
+	redisClient *redis.Client
+}
+
+func newPresenceStore(redisClient *redis.Client) *presenceStore {
+	return &presenceStore{
+		redisClient: redisClient,
+	}
+}
+
+// heartbeat keeps a connection of the user online for presenceTTL, and returns the presence of the user.
+// Users that chose to appear offline aren't counted as online in their communities.
+func (s *presenceStore) heartbeat(ctx context.Context, userAddress string, connectionId string, communityIds []uuid.UUID) (*communityserverv1.Presence, error) {
+	now := time.Now()
+	expiresAt := float64(now.Add(presenceTTL).Unix())
+
+	_, err := s.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, presenceConnectionsKeyPrefix+userAddress, redis.Z{Score: expiresAt, Member: connectionId})
+		pipe.ZRemRangeByScore(ctx, presenceConnectionsKeyPrefix+userAddress, "-inf", strconv.FormatInt(now.Unix(), 10))
+		pipe.Expire(ctx, presenceConnectionsKeyPrefix+userAddress, presenceTTL)
+		pipe.Expire(ctx, presenceKeyPrefix+userAddress, presenceTTL)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh presence connection: %w", err)
+	}
+
+	presence, err := s.getPresence(ctx, userAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.redisClient.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, communityId := range communityIds {
+			key := onlineKeyPrefix + communityId.String()
+			if presence.Status == communityserverv1.PresenceStatus_PRESENCE_STATUS_OFFLINE {
+				pipe.ZRem(ctx, key, userAddress)
+				continue
+			}
+
+			pipe.ZAdd(ctx, key, redis.Z{Score: expiresAt, Member: userAddress})
+			pipe.Expire(ctx, key, presenceTTL)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh online members: %w", err)
+	}
+
+	return presence, nil
+}
+
+// setPresence stores the status chosen by the user. It is kept while the user has connections.
+func (s *presenceStore) setPresence(ctx context.Context, presence *communityserverv1.Presence) error {
+	data, err := proto.Marshal(presence)
+	if err != nil {
+		return fmt.Errorf("failed to marshal presence: %w", err)
+	}
+
+	err = s.redisClient.Set(ctx, presenceKeyPrefix+presence.UserAddress, data, presenceTTL).Err()
+	if err != nil {
+		return fmt.Errorf("failed to set presence: %w", err)
+	}
+
+	return nil
+}
+
+// getPresence returns the status chosen by the user, which is online if the user didn't choose one.
+func (s *presenceStore) getPresence(ctx context.Context, userAddress string) (*communityserverv1.Presence, error) {
+	presence := &communityserverv1.Presence{
+		UserAddress: userAddress,
+		Status:      communityserverv1.PresenceStatus_PRESENCE_STATUS_ONLINE,
+	}
+
+	data, err := s.redisClient.Get(ctx, presenceKeyPrefix+userAddress).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return presence, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get presence: %w", err)
+	}
+
+	err = proto.Unmarshal(data, presence)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal presence: %w", err)
+	}
+
+	return presence, nil
+}
+
+// disconnect removes a connection of the user, and reports whether the user has no connections left. Users
+// without connections are removed from the online members of their communities.
+func (s *presenceStore) disconnect(ctx context.Context, userAddress string, connectionId string, communityIds []uuid.UUID) (bool, error) {
+	key := presenceConnectionsKeyPrefix + userAddress
+
+	var remaining *redis.IntCmd
+	_, err := s.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRem(ctx, key, connectionId)
+		remaining = pipe.ZCount(ctx, key, strconv.FormatInt(time.Now().Unix(), 10), "+inf")
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to remove presence connection: %w", err)
+	}
+
+	if remaining.Val() > 0 {
+		return false, nil
+	}
+
+	_, err = s.redisClient.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, presenceKeyPrefix+userAddress)
+		for _, communityId := range communityIds {
+			pipe.ZRem(ctx, onlineKeyPrefix+communityId.String(), userAddress)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to remove online member: %w", err)
+	}
+
+	return true, nil
+}
+
+// removeMember removes a user from the online members of a community it is no longer a member of.
+func (s *presenceStore) removeMember(ctx context.Context, communityId uuid.UUID, userAddress string) error {
+	return s.redisClient.ZRem(ctx, onlineKeyPrefix+communityId.String(), userAddress).Err()
+}
+
+// onlineMembers returns the user addresses of the online members of a community.
+func (s *presenceStore) onlineMembers(ctx context.Context, communityId uuid.UUID) ([]string, error) {
+	userAddresses, err := s.redisClient.ZRangeByScore(ctx, onlineKeyPrefix+communityId.String(), &redis.ZRangeBy{
+		Min: strconv.FormatInt(time.Now().Unix(), 10),
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get online members: %w", err)
+	}
+
+	return userAddresses, nil
+}
+
+// onlineCount returns the amount of online members of a community.
+func (s *presenceStore) onlineCount(ctx context.Context, communityId uuid.UUID) (int64, error) {
+	count, err := s.redisClient.ZCount(ctx, onlineKeyPrefix+communityId.String(), strconv.FormatInt(time.Now().Unix(), 10), "+inf").Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count online members: %w", err)
+	}
+
+	return count, nil
+}
+
+// getPresencesHandler returns the presence of the online members of a community. Members that aren't
+// listed are offline.
+func (o *Routes) getPresencesHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	userAddresses, err := o.presence.onlineMembers(r.Context(), caller.CommunityID)
+	if err != nil {
+		slog.Error("could not get online members", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	presencesProto := []*communityserverv1.Presence{}
+	for _, userAddress := range userAddresses {
+		presence, err := o.presence.getPresence(r.Context(), userAddress)
+		if err != nil {
+			slog.Error("could not get presence", "error", err)
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+			return
+		}
+
+		presencesProto = append(presencesProto, presence)
+	}
+
+	o.writeProtoJson(w, &communityserverv1.GetPresencesResponse{
+		Presences: presencesProto,
+	})
+}
+
+// updatePresence stores the status chosen by the user, and announces it to its communities.
+func (o *Routes) updatePresence(ctx context.Context, connection *gatewayConnection, command *communityserverv1.UpdatePresenceCommand) error {
+	switch command.Status {
+	case communityserverv1.PresenceStatus_PRESENCE_STATUS_ONLINE,
+		communityserverv1.PresenceStatus_PRESENCE_STATUS_IDLE,
+		communityserverv1.PresenceStatus_PRESENCE_STATUS_DO_NOT_DISTURB,
+		communityserverv1.PresenceStatus_PRESENCE_STATUS_OFFLINE:
+	default:
+		return errors.New("invalid presence status")
+	}
+
+	customStatus := strings.TrimSpace(command.CustomStatus)
+	if utf8.RuneCountInString(customStatus) > maxCustomStatusLength {
+		return errors.New("custom status is too long")
+	}
+
+	presence := &communityserverv1.Presence{
+		UserAddress:  connection.userAddress,
+		Status:       command.Status,
+		CustomStatus: customStatus,
+	}
+
+	err := o.presence.setPresence(ctx, presence)
+	if err != nil {
+		return err
+	}
+
+	// Refreshes the online members of the communities, since the user may have chosen to appear offline
+	communityIds := o.hub.ClientCommunities(connection.client)
+	_, err = o.presence.heartbeat(ctx, connection.userAddress, connection.id, communityIds)
+	if err != nil {
+		return err
+	}
+
+	o.publishPresence(ctx, communityIds, presence)
+
+	return nil
+}
+
+// publishPresence announces the presence of a user to its communities. Users that chose to appear offline
+// are announced as offline without their custom status.
+func (o *Routes) publishPresence(ctx context.Context, communityIds []uuid.UUID, presence *communityserverv1.Presence) {
+	if presence.Status == communityserverv1.PresenceStatus_PRESENCE_STATUS_OFFLINE {
+		presence = &communityserverv1.Presence{
+			UserAddress: presence.UserAddress,
+			Status:      communityserverv1.PresenceStatus_PRESENCE_STATUS_OFFLINE,
+		}
+	}
+
+	for _, communityId := range communityIds {
+		o.publishEvent(ctx, communityId, communityserverv1.Event_TYPE_PRESENCE_UPDATED, &communityserverv1.PresenceUpdatedEvent{
+			Presence: presence,
+		})
+	}
+}
@@ -0,0 +1,373 @@
+package community
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	slowModeKeyPrefix = "community:slow_mode:"
+	sendRateKeyPrefix = "community:send_rate:"
+
+	// sendBurstLimit is the amount of messages a member can send in sendBurstWindow, across every channel
+	// of the server
+	sendBurstLimit  = 10
+	sendBurstWindow = 10 * time.Second
+
+	maxSlowModeSeconds = 6 * 60 * 60
+)
+
+// sendLimitScript checks the slow mode of a member in a channel and its send burst limit, and counts the
+// message against both if it is allowed. It returns the scope of the limit that rejected the message, and
+// the milliseconds until it can be sent, or 0 if the message is allowed.
+//
+// KEYS[1] is the slow mode key of the member in the channel, and KEYS[2] is the send rate key of the member.
+// ARGV[1] is the slow mode interval in milliseconds, ARGV[2] the burst limit and ARGV[3] the burst window in
+// milliseconds.
+var sendLimitScript = redis.NewScript(`
+local slowMode = tonumber(ARGV[1])
+if slowMode > 0 then
+	local ttl = redis.call("PTTL", KEYS[1])
+	if ttl > 0 then
+		return {1, ttl}
+	end
+end
+
+local count = tonumber(redis.call("GET", KEYS[2]) or "0")
+if count >= tonumber(ARGV[2]) then
+	local ttl = redis.call("PTTL", KEYS[2])
+	if ttl > 0 then
+		return {2, ttl}
+	end
+	redis.call("DEL", KEYS[2])
+end
+
+if redis.call("INCR", KEYS[2]) == 1 then
+	redis.call("PEXPIRE", KEYS[2], ARGV[3])
+end
+
+if slowMode > 0 then
+	redis.call("SET", KEYS[1], 1, "PX", slowMode)
+end
+
+return {0, 0}
+`)
+
+// sendRefundScript takes back a message counted by sendLimitScript that wasn't sent. It clears the slow mode
+// of the member in the channel, and decrements its send rate if the window of the message hasn't expired.
+//
+// KEYS and ARGV[1] are the same as those of sendLimitScript.
+var sendRefundScript = redis.NewScript(`
+if tonumber(ARGV[1]) > 0 then
+	redis.call("DEL", KEYS[1])
+end
+
+if tonumber(redis.call("GET", KEYS[2]) or "0") > 0 then
+	redis.call("DECR", KEYS[2])
+end
+
+return 0
+`)
+
+// sendLimiter enforces the slow mode of channels and the send burst limit of members in Redis, so limits are
+// shared by every instance of the server. Both limits are checked and counted in a single script, so
+// concurrent messages can't exceed them.
+type sendLimiter struct {
+	redisClient *redis.Client
+}
+
+func newSendLimiter(redisClient *redis.Client) *sendLimiter {
+	return &sendLimiter{
+		redisClient: redisClient,
+	}
+}
+
+// allow counts a message of the member in a channel, or in a conversation, against its limits. A slow mode
+// of 0 only applies the send burst limit. If the message isn't allowed, it returns the scope of the limit that
+// rejected it and how long until the member can send it.
+func (l *sendLimiter) allow(ctx context.Context, channelId uuid.UUID, slowMode time.Duration, userAddress string) (communityserverv1.RateLimitedError_Scope, time.Duration, error) {
+	result, err := sendLimitScript.Run(ctx, l.redisClient, []string{
+		slowModeKey(channelId, userAddress),
+		sendRateKeyPrefix + userAddress,
+	}, slowMode.Milliseconds(), sendBurstLimit, sendBurstWindow.Milliseconds()).Int64Slice()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to run send limit script: %w", err)
+	}
+
+	if len(result) != 2 {
+		return 0, 0, fmt.Errorf("unexpected send limit script result: %v", result)
+	}
+
+	return communityserverv1.RateLimitedError_Scope(result[0]), time.Duration(result[1]) * time.Millisecond, nil
+}
+
+// refund takes back a message counted by allow that wasn't sent, so failed sends don't count against the
+// limits of the member.
+func (l *sendLimiter) refund(ctx context.Context, channelId uuid.UUID, slowMode time.Duration, userAddress string) error {
+	err := sendRefundScript.Run(ctx, l.redisClient, []string{
+		slowModeKey(channelId, userAddress),
+		sendRateKeyPrefix + userAddress,
+	}, slowMode.Milliseconds()).Err()
+	if err != nil {
+		return fmt.Errorf("failed to run send refund script: %w", err)
+	}
+
+	return nil
+}
+
+func slowModeKey(channelId uuid.UUID, userAddress string) string {
+	return slowModeKeyPrefix + channelId.String() + ":" + userAddress
+}
+
+// requireSendAllowed counts a message of the caller in the channel against the slow mode of the channel and
+// the send burst limit of the caller, and writes the rate limited response if it isn't allowed. Users the
+// owner of the community exempted aren't limited. The returned refund takes the message back if it fails to
+// be sent.
+func (o *Routes) requireSendAllowed(w http.ResponseWriter, r *http.Request, caller *communityMember, channel communitydb.Channel) (func(context.Context), bool) {
+	exempt, err := o.communityDb.IsRateLimitExempt(r.Context(), communitydb.IsRateLimitExemptParams{
+		CommunityID: caller.CommunityID,
+		UserAddress: caller.Auth.UserAddress,
+	})
+	if err != nil {
+		slog.Error("could not get rate limit exemption", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if exempt {
+		return func(context.Context) {}, true
+	}
+
+	slowMode := time.Duration(channel.SlowModeSeconds) * time.Second
+	scope, retryAfter, err := o.sendLimits.allow(r.Context(), channel.ID, slowMode, caller.Auth.UserAddress)
+	if err != nil {
+		slog.Error("failed to check send limits", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if scope != communityserverv1.RateLimitedError_SCOPE_UNSPECIFIED {
+		writeRateLimited(w, scope, retryAfter)
+		return nil, false
+	}
+
+	refund := func(ctx context.Context) {
+		err := o.sendLimits.refund(ctx, channel.ID, slowMode, caller.Auth.UserAddress)
+		if err != nil {
+			slog.Error("failed to refund send limits", "error", err)
+		}
+	}
+
+	return refund, true
+}
+
+// writeRateLimited writes a too many requests response, with the time until the request can be retried in
+// the Retry-After header and in the body, so clients can show a countdown.
+func writeRateLimited(w http.ResponseWriter, scope communityserverv1.RateLimitedError_Scope, retryAfter time.Duration) {
+	data, err := protojson.Marshal(&communityserverv1.RateLimitedError{
+		Scope:        scope,
+		RetryAfterMs: retryAfter.Milliseconds(),
+	})
+	if err != nil {
+		slog.Error("failed to marshal rate limited error", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	w.WriteHeader(http.StatusTooManyRequests)
+	_, err = w.Write(data)
+	if err != nil {
+		slog.Error("failed to write response", "error", err)
+	}
+}
+
+// updateSlowModeHandler sets the minimum interval between messages of a member in a channel. An interval of
+// 0 disables slow mode.
+func (o *Routes) updateSlowModeHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	oldChannel, _, ok := o.getChannel(w, r, caller, PermissionManageChannels)
+	if !ok {
+		return
+	}
+
+	var req communityserverv1.UpdateSlowModeRequest
+	if !o.readProtoJson(w, r, &req) {
+		return
+	}
+
+	if req.SlowModeSeconds < 0 || req.SlowModeSeconds > maxSlowModeSeconds {
+		http.Error(w, "Invalid slow mode interval", http.StatusBadRequest)
+		return
+	}
+
+	channel, err := o.communityDb.UpdateChannelSlowMode(r.Context(), communitydb.UpdateChannelSlowModeParams{
+		ID:              oldChannel.ID,
+		CommunityID:     caller.CommunityID,
+		SlowModeSeconds: req.SlowModeSeconds,
+	})
+	if err != nil {
+		slog.Error("failed to update channel slow mode", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.publishChannelEvent(r.Context(), caller.CommunityID, channel.ID, communityserverv1.Event_TYPE_CHANNEL_UPDATED, &communityserverv1.ChannelUpdatedEvent{
+		Channel: channelToProto(channel),
+	})
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_CHANNEL_UPDATE,
+		TargetID: channel.ID.String(),
+		Reason:   auditReason(r),
+		Before:   channelToProto(oldChannel),
+		After:    channelToProto(channel),
+	})
+
+	o.writeProtoJson(w, &communityserverv1.UpdateSlowModeResponse{
+		Channel: channelToProto(channel),
+	})
+}
+
+// getRateLimitExemptionsHandler returns the users exempted from slow mode and send rate limits in a
+// community. Only the owner of the community manages exemptions.
+func (o *Routes) getRateLimitExemptionsHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	if !o.requireOwner(w, r, caller) {
+		return
+	}
+
+	exemptions, err := o.communityDb.GetRateLimitExemptions(r.Context(), caller.CommunityID)
+	if err != nil {
+		slog.Error("could not get rate limit exemptions", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	exemptionsProto := []*communityserverv1.RateLimitExemption{}
+	for _, exemption := range exemptions {
+		exemptionsProto = append(exemptionsProto, &communityserverv1.RateLimitExemption{
+			UserAddress: exemption.UserAddress,
+			CreatedBy:   exemption.CreatedBy,
+			CreatedAt:   formatTimestamp(exemption.CreatedAt),
+		})
+	}
+
+	o.writeProtoJson(w, &communityserverv1.GetRateLimitExemptionsResponse{
+		Exemptions: exemptionsProto,
+	})
+}
+
+// addRateLimitExemptionHandler exempts a member of the community from slow mode and send rate limits in it.
+// Exempting an exempted member is a no-op.
+func (o *Routes) addRateLimitExemptionHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	if !o.requireOwner(w, r, caller) {
+		return
+	}
+
+	target, ok := o.getTargetMember(w, r, caller, r.PathValue("userAddress"))
+	if !ok {
+		return
+	}
+
+	added, err := o.communityDb.InsertRateLimitExemption(r.Context(), communitydb.InsertRateLimitExemptionParams{
+		CommunityID: caller.CommunityID,
+		UserAddress: target.UserAddress,
+		CreatedBy:   caller.Auth.UserAddress,
+	})
+	if err != nil {
+		slog.Error("failed to insert rate limit exemption", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	if added > 0 {
+		o.recordAuditLog(r.Context(), caller, auditLogEntry{
+			Action:   communityserverv1.AuditLogEntry_ACTION_RATE_LIMIT_EXEMPTION_CREATE,
+			TargetID: target.UserAddress,
+			Reason:   auditReason(r),
+		})
+	}
+
+	o.writeProtoJson(w, &communityserverv1.AddRateLimitExemptionResponse{})
+}
+
+// removeRateLimitExemptionHandler removes the exemption of a user from slow mode and send rate limits. Users
+// that left the community can still be removed.
+func (o *Routes) removeRateLimitExemptionHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	if !o.requireOwner(w, r, caller) {
+		return
+	}
+
+	userAddress := r.PathValue("userAddress")
+
+	removed, err := o.communityDb.DeleteRateLimitExemption(r.Context(), communitydb.DeleteRateLimitExemptionParams{
+		CommunityID: caller.CommunityID,
+		UserAddress: userAddress,
+	})
+	if err != nil {
+		slog.Error("failed to delete rate limit exemption", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	if removed == 0 {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_RATE_LIMIT_EXEMPTION_DELETE,
+		TargetID: userAddress,
+		Reason:   auditReason(r),
+	})
+
+	o.writeProtoJson(w, &communityserverv1.RemoveRateLimitExemptionResponse{})
+}
+
+// requireOwner writes a forbidden response if the caller isn't the owner of its community.
+func (o *Routes) requireOwner(w http.ResponseWriter, r *http.Request, caller *communityMember) bool {
+	community, err := o.communityDb.GetCommunity(r.Context(), caller.CommunityID)
+	if err != nil {
+		slog.Error("could not get community", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return false
+	}
+
+	if !isOwner(community, caller.Member) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return false
+	}
+
+	return true
+}
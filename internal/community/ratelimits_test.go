@@ -0,0 +1,96 @@
+package community
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+)
+
+// newTestRedisClient connects to the Redis at REDIS_HOST and REDIS_PORT, and skips the test if there is none.
+func newTestRedisClient(t *testing.T) *redis.Client {
+	t.Helper()
+
+	host, port := os.Getenv("REDIS_HOST"), os.Getenv("REDIS_PORT")
+	if host == "" || port == "" {
+		t.Skip("REDIS_HOST and REDIS_PORT aren't set")
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: host + ":" + port})
+	t.Cleanup(func() { _ = client.Close() })
+
+	err := client.Ping(context.Background()).Err()
+	if err != nil {
+		t.Skipf("redis is unavailable: %v", err)
+	}
+
+	return client
+}
+
+func TestSendLimiter(t *testing.T) {
+	limiter := newSendLimiter(newTestRedisClient(t))
+	channelId, otherChannelId := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name     string
+		slowMode time.Duration
+		// sends are the messages sent before the tested one, to otherChannelId if otherChannel is set
+		sends        int
+		otherChannel bool
+		refunds      int
+		want         communityserverv1.RateLimitedError_Scope
+	}{
+		{name: "first message", slowMode: time.Minute, want: communityserverv1.RateLimitedError_SCOPE_UNSPECIFIED},
+		{name: "slow mode", slowMode: time.Minute, sends: 1, want: communityserverv1.RateLimitedError_SCOPE_SLOW_MODE},
+		{name: "slow mode of other channel", slowMode: time.Minute, sends: 1, otherChannel: true, want: communityserverv1.RateLimitedError_SCOPE_UNSPECIFIED},
+		{name: "refunded slow mode", slowMode: time.Minute, sends: 1, refunds: 1, want: communityserverv1.RateLimitedError_SCOPE_UNSPECIFIED},
+		{name: "under burst limit", sends: sendBurstLimit - 1, want: communityserverv1.RateLimitedError_SCOPE_UNSPECIFIED},
+		{name: "burst", sends: sendBurstLimit, want: communityserverv1.RateLimitedError_SCOPE_BURST},
+		{name: "burst across channels", sends: sendBurstLimit, otherChannel: true, want: communityserverv1.RateLimitedError_SCOPE_BURST},
+		{name: "refunded burst", sends: sendBurstLimit, refunds: 1, want: communityserverv1.RateLimitedError_SCOPE_UNSPECIFIED},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			userAddress := uuid.NewString() + "@example.com"
+
+			sendChannelId := channelId
+			if tt.otherChannel {
+				sendChannelId = otherChannelId
+			}
+
+			for range tt.sends {
+				scope, _, err := limiter.allow(ctx, sendChannelId, tt.slowMode, userAddress)
+				if err != nil {
+					t.Fatalf("allow() error = %v", err)
+				}
+				if scope != communityserverv1.RateLimitedError_SCOPE_UNSPECIFIED {
+					t.Fatalf("allow() of earlier message = %v, want allowed", scope)
+				}
+			}
+
+			for range tt.refunds {
+				err := limiter.refund(ctx, sendChannelId, tt.slowMode, userAddress)
+				if err != nil {
+					t.Fatalf("refund() error = %v", err)
+				}
+			}
+
+			scope, retryAfter, err := limiter.allow(ctx, channelId, tt.slowMode, userAddress)
+			if err != nil {
+				t.Fatalf("allow() error = %v", err)
+			}
+			if scope != tt.want {
+				t.Errorf("allow() scope = %v, want %v", scope, tt.want)
+			}
+			if (retryAfter > 0) != (tt.want != communityserverv1.RateLimitedError_SCOPE_UNSPECIFIED) {
+				t.Errorf("allow() retry after = %v, want it set only when limited", retryAfter)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+package community
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+)
+
+const (
+	// maxReactionEmojis is the amount of distinct emojis a message can be reacted with
+	maxReactionEmojis = 20
+	maxEmojiLength    = 32
+)
+
+var errTooManyReactions = errors.New("too many reaction emojis")
+
+// reactionEmoji is the emoji of a reaction, which is either a unicode emoji or a custom emoji of the community.
+type reactionEmoji struct {
+	Emoji         string
+	CustomEmojiID pgtype.UUID
+}
+
+// addReactionHandler adds a reaction of the caller to a message. Members that can view a channel can react in
+// it, unless they are muted. The {emoji} path parameter is either a unicode emoji, or the id of a custom emoji
+// of the community. Adding an existing reaction is a no-op.
+func (o *Routes) addReactionHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	channel, _, ok := o.getChannel(w, r, caller, PermissionViewChannel)
+	if !ok {
+		return
+	}
+
+	if isMuted(caller.Membership, time.Now()) {
+		http.Error(w, "Muted", http.StatusForbidden)
+		return
+	}
+
+	message, ok := o.getChannelMessage(w, r, channel)
+	if !ok {
+		return
+	}
+
+	emoji, ok := o.parseReactionEmoji(w, r, caller, true)
+	if !ok {
+		return
+	}
+
+	added, err := o.addReaction(r.Context(), communitydb.AddReactionParams{
+		MessageID:     message.ID,
+		Emoji:         emoji.Emoji,
+		CustomEmojiID: emoji.CustomEmojiID,
+		UserAddress:   caller.Auth.UserAddress,
+	}, channel.ID)
+	if errors.Is(err, errTooManyReactions) {
+		http.Error(w, "Too many reactions", http.StatusConflict)
+		return
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		slog.Error("failed to add reaction", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	if added > 0 {
+		o.publishChannelEvent(r.Context(), caller.CommunityID, channel.ID, communityserverv1.Event_TYPE_REACTION_ADDED, &communityserverv1.ReactionAddedEvent{
+			MessageId:     message.ID.String(),
+			ChannelId:     channel.ID.String(),
+			UserAddress:   caller.Auth.UserAddress,
+			Emoji:         emoji.Emoji,
+			CustomEmojiId: formatUUID(emoji.CustomEmojiID),
+		})
+	}
+
+	o.writeProtoJson(w, &communityserverv1.AddReactionResponse{})
+}
+
+// addReaction adds a reaction to a message, and returns the amount of added reactions. The message is locked
+// while its reaction emojis are counted, so concurrent reactions can't exceed maxReactionEmojis. It returns
+// errTooManyReactions if the message has no room for another emoji, and pgx.ErrNoRows if the message was
+// deleted.
+func (o *Routes) addReaction(ctx context.Context, params communitydb.AddReactionParams, channelId uuid.UUID) (int64, error) {
+	tx, err := o.postgresClient.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := communitydb.New(tx)
+
+	message, err := queries.GetMessageForUpdate(ctx, communitydb.GetMessageForUpdateParams{
+		ID:        params.MessageID,
+		ChannelID: channelId,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to lock message: %w", err)
+	}
+
+	if message.DeletedAt.Valid {
+		return 0, pgx.ErrNoRows
+	}
+
+	otherEmojis, err := queries.CountOtherReactionEmojis(ctx, communitydb.CountOtherReactionEmojisParams{
+		MessageID: params.MessageID,
+		Emoji:     params.Emoji,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count reaction emojis: %w", err)
+	}
+
+	if otherEmojis >= maxReactionEmojis {
+		return 0, errTooManyReactions
+	}
+
+	added, err := queries.AddReaction(ctx, params)
+	if err != nil {
+		return 0, fmt.Errorf("failed to add reaction: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return added, nil
+}
+
+// removeReactionHandler removes a reaction of the caller from a message. Removing a missing reaction is a
+// no-op.
+func (o *Routes) removeReactionHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	channel, _, ok := o.getChannel(w, r, caller, PermissionViewChannel)
+	if !ok {
+		return
+	}
+
+	message, ok := o.getChannelMessage(w, r, channel)
+	if !ok {
+		return
+	}
+
+	// Custom emojis may have been deleted since, which removed their reactions already
+	emoji, ok := o.parseReactionEmoji(w, r, caller, false)
+	if !ok {
+		return
+	}
+
+	removed, err := o.communityDb.RemoveReaction(r.Context(), communitydb.RemoveReactionParams{
+		MessageID:   message.ID,
+		Emoji:       emoji.Emoji,
+		UserAddress: caller.Auth.UserAddress,
+	})
+	if err != nil {
+		slog.Error("failed to remove reaction", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	if removed > 0 {
+		o.publishChannelEvent(r.Context(), caller.CommunityID, channel.ID, communityserverv1.Event_TYPE_REACTION_REMOVED, &communityserverv1.ReactionRemovedEvent{
+			MessageId:     message.ID.String(),
+			ChannelId:     channel.ID.String(),
+			UserAddress:   caller.Auth.UserAddress,
+			Emoji:         emoji.Emoji,
+			CustomEmojiId: formatUUID(emoji.CustomEmojiID),
+		})
+	}
+
+	o.writeProtoJson(w, &communityserverv1.RemoveReactionResponse{})
+}
+
+// getChannelMessage gets the message in the {messageId} path parameter, and writes the error response if it
+// isn't a message of the channel. Deleted messages are reported as not found.
+func (o *Routes) getChannelMessage(w http.ResponseWriter, r *http.Request, channel communitydb.Channel) (communitydb.Message, bool) {
+	messageId, ok := pathUUID(w, r, "messageId")
+	if !ok {
+		return communitydb.Message{}, false
+	}
+
+	message, err := o.communityDb.GetMessage(r.Context(), communitydb.GetMessageParams{
+		ID:        messageId,
+		ChannelID: channel.ID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && message.DeletedAt.Valid) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return communitydb.Message{}, false
+	}
+	if err != nil {
+		slog.Error("could not get message", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return communitydb.Message{}, false
+	}
+
+	return message, true
+}
+
+// parseReactionEmoji parses the {emoji} path parameter, and writes the error response if it is invalid. If
+// requireCustomEmoji is set, custom emojis must exist in the caller's community.
+func (o *Routes) parseReactionEmoji(w http.ResponseWriter, r *http.Request, caller *communityMember, requireCustomEmoji bool) (reactionEmoji, bool) {
+	emoji := r.PathValue("emoji")
+
+	customEmojiId, err := uuid.Parse(emoji)
+	if err != nil {
+		if !isUnicodeEmoji(emoji) {
+			http.Error(w, "Invalid emoji", http.StatusBadRequest)
+			return reactionEmoji{}, false
+		}
+
+		return reactionEmoji{Emoji: emoji}, true
+	}
+
+	if requireCustomEmoji {
+		customEmoji, err := o.communityDb.GetCustomEmoji(r.Context(), customEmojiId)
+		if errors.Is(err, pgx.ErrNoRows) || (err == nil && customEmoji.CommunityID != caller.CommunityID) {
+			http.Error(w, "Invalid emoji", http.StatusBadRequest)
+			return reactionEmoji{}, false
+		}
+		if err != nil {
+			slog.Error("could not get custom emoji", "error", err)
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+			return reactionEmoji{}, false
+		}
+	}
+
+	return reactionEmoji{
+		Emoji:         customEmojiId.String(),
+		CustomEmojiID: pgtype.UUID{Bytes: customEmojiId, Valid: true},
+	}, true
+}
+
+// isUnicodeEmoji reports whether s looks like a single unicode emoji, including sequences joined with zero
+// width joiners, skin tone modifiers, keycaps and flags. s isn't checked against a list of emojis, so clients
+// decide how to render sequences they don't know.
+func isUnicodeEmoji(s string) bool {
+	if s == "" || len(s) > maxEmojiLength || !utf8.ValidString(s) {
+		return false
+	}
+
+	hasSymbol := false
+	for _, r := range s {
+		switch {
+		case unicode.Is(unicode.So, r):
+			hasSymbol = true
+		case r == 0x20E3: // Combining enclosing keycap
+			hasSymbol = true
+		case r == 0x200D, // Zero width joiner
+			r == 0xFE0E, r == 0xFE0F, // Variation selectors
+			r >= 0x1F3FB && r <= 0x1F3FF,             // Skin tone modifiers
+			r >= 0xE0020 && r <= 0xE007F,             // Tags of subdivision flags
+			r >= '0' && r <= '9', r == '#', r == '*': // Keycap bases
+		default:
+			return false
+		}
+	}
+
+	return hasSymbol
+}
+
+// getMessagesReactions returns the reactions of messages by message ID. me is set on reactions the user made.
+func (o *Routes) getMessagesReactions(ctx context.Context, userAddress string, messageIds []uuid.UUID) (map[uuid.UUID][]*communityserverv1.Reaction, error) {
+	rows, err := o.communityDb.GetMessagesReactions(ctx, communitydb.GetMessagesReactionsParams{
+		UserAddress: userAddress,
+		MessageIds:  messageIds,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get messages reactions: %w", err)
+	}
+
+	reactions := map[uuid.UUID][]*communityserverv1.Reaction{}
+	for _, row := range rows {
+		reaction := &communityserverv1.Reaction{
+			Emoji:         row.Emoji,
+			CustomEmojiId: formatUUID(row.CustomEmojiID),
+			Count:         row.Count,
+			Me:            row.Me,
+		}
+		reactions[row.MessageID] = append(reactions[row.MessageID], reaction)
+	}
+
+	return reactions, nil
+}
@@ -0,0 +1,36 @@
+package community
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsUnicodeEmoji(t *testing.T) {
+	tests := []struct {
+		name  string
+		emoji string
+		want  bool
+	}{
+		{name: "emoji", emoji: "👍", want: true},
+		{name: "skin tone", emoji: "👍🏽", want: true},
+		{name: "zero width joiner sequence", emoji: "👨‍👩‍👧", want: true},
+		{name: "variation selector", emoji: "❤️", want: true},
+		{name: "flag", emoji: "🇫🇷", want: true},
+		{name: "keycap", emoji: "1️⃣", want: true},
+		{name: "empty", emoji: "", want: false},
+		{name: "letter", emoji: "a", want: false},
+		{name: "digit", emoji: "1", want: false},
+		{name: "text", emoji: "thumbsup", want: false},
+		{name: "space", emoji: "👍 👍", want: false},
+		{name: "too long", emoji: strings.Repeat("👍", 10), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isUnicodeEmoji(tt.emoji)
+			if got != tt.want {
+				t.Errorf("isUnicodeEmoji(%q) = %v, want %v", tt.emoji, got, tt.want)
+			}
+		})
+	}
+}
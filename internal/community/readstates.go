@@ -0,0 +1,225 @@
+package community
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+)
+
+// maxUnreadCount caps the unread count of a channel, so counting stays cheap in busy channels. Clients are
+// expected to display capped counts as "99+" or similar.
+const maxUnreadCount = 100
+
+// getReadStatesHandler returns the read state of every channel of the community the caller can view.
+func (o *Routes) getReadStatesHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	readStates, err := o.getReadStates(r.Context(), caller)
+	if err != nil {
+		slog.Error("could not get read states", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.writeProtoJson(w, &communityserverv1.GetReadStatesResponse{
+		ReadStates: readStates,
+	})
+}
+
+// ackChannelHandler marks the messages of a channel as read up to the given message, and clears the mentions
+// of the caller in it. Read states never move backwards, so acknowledging an older message is a no-op.
+func (o *Routes) ackChannelHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	channel, _, ok := o.getChannel(w, r, caller, PermissionViewChannel)
+	if !ok {
+		return
+	}
+
+	var req communityserverv1.AckChannelRequest
+	if !o.readProtoJson(w, r, &req) {
+		return
+	}
+
+	messageId, err := uuid.Parse(req.MessageId)
+	if err != nil {
+		http.Error(w, "Invalid message id", http.StatusBadRequest)
+		return
+	}
+
+	_, err = o.communityDb.GetMessage(r.Context(), communitydb.GetMessageParams{
+		ID:        messageId,
+		ChannelID: channel.ID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		slog.Error("could not get message", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	err = o.ackChannel(r.Context(), caller, channel.ID, messageId)
+	if err != nil {
+		slog.Error("failed to ack channel", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	readStates, err := o.getReadStates(r.Context(), caller)
+	if err != nil {
+		slog.Error("could not get read states", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	resp := &communityserverv1.AckChannelResponse{}
+	for _, readState := range readStates {
+		if readState.ChannelId == channel.ID.String() {
+			resp.ReadState = readState
+		}
+	}
+
+	o.writeProtoJson(w, resp)
+}
+
+func (o *Routes) ackChannel(ctx context.Context, caller *communityMember, channelId uuid.UUID, messageId uuid.UUID) error {
+	_, err := o.communityDb.AckChannel(ctx, communitydb.AckChannelParams{
+		MemberID:          caller.Member.ID,
+		ChannelID:         channelId,
+		LastReadMessageID: pgtype.UUID{Bytes: messageId, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to ack channel: %w", err)
+	}
+
+	return nil
+}
+
+// getReadStates returns the read states of the channels of the caller's community that it can view.
+func (o *Routes) getReadStates(ctx context.Context, caller *communityMember) ([]*communityserverv1.ReadState, error) {
+	rows, err := o.communityDb.GetChannelReadStates(ctx, communitydb.GetChannelReadStatesParams{
+		MemberID:    caller.Member.ID,
+		CommunityID: caller.CommunityID,
+		Limit:       maxUnreadCount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get channel read states: %w", err)
+	}
+
+	channels, err := o.getVisibleChannels(ctx, caller)
+	if err != nil {
+		return nil, err
+	}
+
+	visibleChannelIds := map[uuid.UUID]struct{}{}
+	for _, channel := range channels {
+		visibleChannelIds[channel.ID] = struct{}{}
+	}
+
+	readStates := []*communityserverv1.ReadState{}
+	for _, row := range rows {
+		if _, ok := visibleChannelIds[row.ChannelID]; !ok {
+			continue
+		}
+
+		readState := &communityserverv1.ReadState{
+			ChannelId:    row.ChannelID.String(),
+			UnreadCount:  row.UnreadCount,
+			MentionCount: int64(row.MentionCount),
+		}
+		if row.LastReadMessageID.Valid {
+			readState.LastReadMessageId = uuid.UUID(row.LastReadMessageID.Bytes).String()
+		}
+
+		readStates = append(readStates, readState)
+	}
+
+	return readStates, nil
+}
+
+// unreadCounts are the summed unread and mention counts of the channels of a community that a member can view.
+type unreadCounts struct {
+	Unread   int64
+	Mentions int64
+}
+
+// getMemberUnreadCounts returns the unread counts of the given communities of the member, keyed by community ID.
+// The read states, roles and channel overwrites of all of the member's communities are each fetched in a single
+// query, so listing communities doesn't query the database once per community.
+func (o *Routes) getMemberUnreadCounts(ctx context.Context, member communitydb.Member, communities []communitydb.Community) (map[uuid.UUID]unreadCounts, error) {
+	rows, err := o.communityDb.GetMemberChannelReadStates(ctx, communitydb.GetMemberChannelReadStatesParams{
+		MemberID: member.ID,
+		Limit:    maxUnreadCount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member channel read states: %w", err)
+	}
+
+	roles, err := o.communityDb.GetMemberCommunityRoles(ctx, member.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member community roles: %w", err)
+	}
+
+	overwrites, err := o.communityDb.GetMemberChannelOverwrites(ctx, member.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member channel overwrites: %w", err)
+	}
+
+	rolePermissions := map[uuid.UUID]Permission{}
+	roleIds := map[uuid.UUID][]uuid.UUID{}
+	for _, role := range roles {
+		rolePermissions[role.CommunityID] |= Permission(role.Permissions)
+		roleIds[role.CommunityID] = append(roleIds[role.CommunityID], role.ID)
+	}
+
+	base := map[uuid.UUID]Permission{}
+	for _, community := range communities {
+		if isOwner(community, member) {
+			base[community.ID] = AllPermissions
+			continue
+		}
+		base[community.ID] = basePermissions(rolePermissions[community.ID])
+	}
+
+	channelOverwrites := map[uuid.UUID][]communitydb.ChannelOverwrite{}
+	for _, overwrite := range overwrites {
+		channelOverwrites[overwrite.ChannelID] = append(channelOverwrites[overwrite.ChannelID], overwrite)
+	}
+
+	counts := map[uuid.UUID]unreadCounts{}
+	for _, row := range rows {
+		communityBase, ok := base[row.CommunityID]
+		if !ok {
+			continue
+		}
+
+		permissions := resolveChannelPermissions(communityBase, channelOverwrites[row.ChannelID], roleIds[row.CommunityID], member.UserAddress)
+		if !permissions.Has(PermissionViewChannel) {
+			continue
+		}
+
+		count := counts[row.CommunityID]
+		count.Unread += row.UnreadCount
+		count.Mentions += int64(row.MentionCount)
+		counts[row.CommunityID] = count
+	}
+
+	return counts, nil
+}
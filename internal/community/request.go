@@ -0,0 +1,118 @@
+package community
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// communityMember is the authenticated caller of a community scoped route.
+type communityMember struct {
+	Auth        *AuthenticationResult
+	Member      communitydb.Member
+	Membership  communitydb.CommunityMember
+	CommunityID uuid.UUID
+}
+
+// authenticate authenticates the request, and writes the error response if it could not be authenticated.
+func (o *Routes) authenticate(w http.ResponseWriter, r *http.Request) (*AuthenticationResult, bool) {
+	auth, err := o.authenticator.Authenticate(r.Context(), r.Header.Get("Authorization"))
+	if errors.Is(err, UnauthenticatedError) {
+		slog.Info("community route unauthenticated", "error", err.Error())
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return nil, false
+	}
+	if err != nil {
+		slog.Info("failed to authenticate user", "error", err, "request_uri", r.RequestURI)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return nil, false
+	}
+
+	return auth, true
+}
+
+// authenticateCommunityMember authenticates the request and verifies that the caller is a member of the
+// community in the {communityId} path parameter. Communities the caller is not a member of are reported
+// as not found, so their existence isn't leaked.
+func (o *Routes) authenticateCommunityMember(w http.ResponseWriter, r *http.Request) (*communityMember, bool) {
+	auth, ok := o.authenticate(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	communityId, ok := pathUUID(w, r, "communityId")
+	if !ok {
+		return nil, false
+	}
+
+	member, err := o.communityDb.GetMemberByUserAddress(r.Context(), auth.UserAddress)
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return nil, false
+	}
+	if err != nil {
+		slog.Error("could not get member by user address", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return nil, false
+	}
+
+	membership, err := o.communityDb.GetCommunityMember(r.Context(), communitydb.GetCommunityMemberParams{
+		MemberID:    member.ID,
+		CommunityID: communityId,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return nil, false
+	}
+	if err != nil {
+		slog.Error("could not get community member", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return nil, false
+	}
+
+	return &communityMember{
+		Auth:        auth,
+		Member:      member,
+		Membership:  membership,
+		CommunityID: communityId,
+	}, true
+}
+
+// pathUUID parses a UUID path parameter, and writes a bad request response if it is invalid.
+func pathUUID(w http.ResponseWriter, r *http.Request, name string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(r.PathValue(name))
+	if err != nil {
+		slog.Info("invalid path parameter", "name", name, "error", err)
+		http.Error(w, "Bad request", http.StatusBadRequest)
+		return uuid.UUID{}, false
+	}
+
+	return id, true
+}
+
+// readProtoJson unmarshals the request body into m, and writes a bad request response if it is invalid.
+// Unknown fields are discarded to keep the API forward-compatible.
+func (o *Routes) readProtoJson(w http.ResponseWriter, r *http.Request, m proto.Message) bool {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		slog.Error("failed to read request body", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return false
+	}
+
+	err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(body, m)
+	if err != nil {
+		slog.Info("unable to read request body", "error", err, "request_uri", r.RequestURI)
+		http.Error(w, "Bad request", http.StatusBadRequest)
+		return false
+	}
+
+	return true
+}
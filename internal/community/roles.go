@@ -0,0 +1,406 @@
+package community
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+)
+
+const maxRoleNameLength = 100
+
+func (o *Routes) getRolesHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	roles, err := o.communityDb.GetCommunityRoles(r.Context(), caller.CommunityID)
+	if err != nil {
+		slog.Error("could not get community roles", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	rolesProto := []*communityserverv1.Role{}
+	for _, role := range roles {
+		rolesProto = append(rolesProto, roleToProto(role))
+	}
+
+	o.writeProtoJson(w, &communityserverv1.GetRolesResponse{
+		Roles: rolesProto,
+	})
+}
+
+func (o *Routes) createRoleHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	callerPermissions, ok := o.requirePermission(w, r, caller, PermissionManageRoles)
+	if !ok {
+		return
+	}
+
+	var req communityserverv1.CreateRoleRequest
+	if !o.readProtoJson(w, r, &req) {
+		return
+	}
+
+	name, permissions, ok := validateRole(w, req.Name, req.Permissions, callerPermissions)
+	if !ok {
+		return
+	}
+
+	role, err := o.communityDb.InsertRole(r.Context(), communitydb.InsertRoleParams{
+		ID:          uuid.New(),
+		CommunityID: caller.CommunityID,
+		Name:        name,
+		Permissions: int64(permissions),
+	})
+	if err != nil {
+		slog.Error("failed to insert role", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.publishEvent(r.Context(), caller.CommunityID, communityserverv1.Event_TYPE_ROLE_CREATED, &communityserverv1.RoleCreatedEvent{
+		Role: roleToProto(role),
+	})
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_ROLE_CREATE,
+		TargetID: role.ID.String(),
+		Reason:   auditReason(r),
+		After:    roleToProto(role),
+	})
+
+	o.writeProtoJson(w, &communityserverv1.CreateRoleResponse{
+		Role: roleToProto(role),
+	})
+}
+
+func (o *Routes) updateRoleHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	callerPermissions, ok := o.requirePermission(w, r, caller, PermissionManageRoles)
+	if !ok {
+		return
+	}
+
+	role, ok := o.getRole(w, r, caller)
+	if !ok {
+		return
+	}
+
+	// Roles with permissions the caller doesn't have are out of its reach
+	if !callerPermissions.Has(Permission(role.Permissions)) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	var req communityserverv1.UpdateRoleRequest
+	if !o.readProtoJson(w, r, &req) {
+		return
+	}
+
+	name, permissions, ok := validateRole(w, req.Name, req.Permissions, callerPermissions)
+	if !ok {
+		return
+	}
+
+	updatedRole, err := o.communityDb.UpdateRole(r.Context(), communitydb.UpdateRoleParams{
+		ID:          role.ID,
+		CommunityID: caller.CommunityID,
+		Name:        name,
+		Permissions: int64(permissions),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		slog.Error("failed to update role", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.publishEvent(r.Context(), caller.CommunityID, communityserverv1.Event_TYPE_ROLE_UPDATED, &communityserverv1.RoleUpdatedEvent{
+		Role: roleToProto(updatedRole),
+	})
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_ROLE_UPDATE,
+		TargetID: role.ID.String(),
+		Reason:   auditReason(r),
+		Before:   roleToProto(role),
+		After:    roleToProto(updatedRole),
+	})
+
+	o.writeProtoJson(w, &communityserverv1.UpdateRoleResponse{
+		Role: roleToProto(updatedRole),
+	})
+}
+
+func (o *Routes) deleteRoleHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	callerPermissions, ok := o.requirePermission(w, r, caller, PermissionManageRoles)
+	if !ok {
+		return
+	}
+
+	role, ok := o.getRole(w, r, caller)
+	if !ok {
+		return
+	}
+
+	if !callerPermissions.Has(Permission(role.Permissions)) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	deleted, err := o.communityDb.DeleteRole(r.Context(), communitydb.DeleteRoleParams{
+		ID:          role.ID,
+		CommunityID: caller.CommunityID,
+	})
+	if err != nil {
+		slog.Error("failed to delete role", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	if deleted == 0 {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	err = o.communityDb.DeleteTargetOverwrites(r.Context(), communitydb.DeleteTargetOverwritesParams{
+		TargetType: overwriteTargetRole,
+		Target:     role.ID.String(),
+	})
+	if err != nil {
+		// Overwrites of deleted roles no longer apply to anyone, so this only leaves stale rows behind
+		slog.Error("failed to delete role overwrites", "error", err)
+	}
+
+	o.publishEvent(r.Context(), caller.CommunityID, communityserverv1.Event_TYPE_ROLE_DELETED, &communityserverv1.RoleDeletedEvent{
+		RoleId: role.ID.String(),
+	})
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_ROLE_DELETE,
+		TargetID: role.ID.String(),
+		Reason:   auditReason(r),
+		Before:   roleToProto(role),
+	})
+
+	o.writeProtoJson(w, &communityserverv1.DeleteRoleResponse{})
+}
+
+func (o *Routes) assignRoleHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	callerPermissions, ok := o.requirePermission(w, r, caller, PermissionManageRoles)
+	if !ok {
+		return
+	}
+
+	role, ok := o.getRole(w, r, caller)
+	if !ok {
+		return
+	}
+
+	// Members can't hand out permissions they don't have themselves
+	if !callerPermissions.Has(Permission(role.Permissions)) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	target, ok := o.getTargetMember(w, r, caller, r.PathValue("userAddress"))
+	if !ok {
+		return
+	}
+
+	err := o.communityDb.AssignMemberRole(r.Context(), communitydb.AssignMemberRoleParams{
+		MemberID: target.ID,
+		RoleID:   role.ID,
+	})
+	if err != nil {
+		slog.Error("failed to assign member role", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.publishEvent(r.Context(), caller.CommunityID, communityserverv1.Event_TYPE_MEMBER_ROLE_ADDED, &communityserverv1.MemberRoleAddedEvent{
+		UserAddress: target.UserAddress,
+		RoleId:      role.ID.String(),
+	})
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_MEMBER_ROLE_ADD,
+		TargetID: target.UserAddress,
+		Reason:   auditReason(r),
+		After:    roleToProto(role),
+	})
+
+	o.writeProtoJson(w, &communityserverv1.AssignRoleResponse{})
+}
+
+func (o *Routes) unassignRoleHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	callerPermissions, ok := o.requirePermission(w, r, caller, PermissionManageRoles)
+	if !ok {
+		return
+	}
+
+	role, ok := o.getRole(w, r, caller)
+	if !ok {
+		return
+	}
+
+	if !callerPermissions.Has(Permission(role.Permissions)) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	target, ok := o.getTargetMember(w, r, caller, r.PathValue("userAddress"))
+	if !ok {
+		return
+	}
+
+	unassigned, err := o.communityDb.UnassignMemberRole(r.Context(), communitydb.UnassignMemberRoleParams{
+		MemberID: target.ID,
+		RoleID:   role.ID,
+	})
+	if err != nil {
+		slog.Error("failed to unassign member role", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	if unassigned == 0 {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	o.publishEvent(r.Context(), caller.CommunityID, communityserverv1.Event_TYPE_MEMBER_ROLE_REMOVED, &communityserverv1.MemberRoleRemovedEvent{
+		UserAddress: target.UserAddress,
+		RoleId:      role.ID.String(),
+	})
+
+	o.recordAuditLog(r.Context(), caller, auditLogEntry{
+		Action:   communityserverv1.AuditLogEntry_ACTION_MEMBER_ROLE_REMOVE,
+		TargetID: target.UserAddress,
+		Reason:   auditReason(r),
+		Before:   roleToProto(role),
+	})
+
+	o.writeProtoJson(w, &communityserverv1.UnassignRoleResponse{})
+}
+
+// getRole gets the role in the {roleId} path parameter, and writes the error response if it isn't a role of
+// the caller's community.
+func (o *Routes) getRole(w http.ResponseWriter, r *http.Request, caller *communityMember) (communitydb.Role, bool) {
+	roleId, ok := pathUUID(w, r, "roleId")
+	if !ok {
+		return communitydb.Role{}, false
+	}
+
+	role, err := o.communityDb.GetRole(r.Context(), communitydb.GetRoleParams{
+		ID:          roleId,
+		CommunityID: caller.CommunityID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return communitydb.Role{}, false
+	}
+	if err != nil {
+		slog.Error("could not get role", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return communitydb.Role{}, false
+	}
+
+	return role, true
+}
+
+// getTargetMember gets the member with the user address, and writes the error response if it isn't a member
+// of the caller's community.
+func (o *Routes) getTargetMember(w http.ResponseWriter, r *http.Request, caller *communityMember, userAddress string) (communitydb.Member, bool) {
+	member, err := o.communityDb.GetMemberByUserAddress(r.Context(), userAddress)
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return communitydb.Member{}, false
+	}
+	if err != nil {
+		slog.Error("could not get member by user address", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return communitydb.Member{}, false
+	}
+
+	_, err = o.communityDb.GetCommunityMember(r.Context(), communitydb.GetCommunityMemberParams{
+		MemberID:    member.ID,
+		CommunityID: caller.CommunityID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return communitydb.Member{}, false
+	}
+	if err != nil {
+		slog.Error("could not get community member", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return communitydb.Member{}, false
+	}
+
+	return member, true
+}
+
+// validateRole validates the name and permissions of a role, and writes the error response if they are
+// invalid. Roles can only be granted permissions the caller has itself.
+func validateRole(w http.ResponseWriter, name string, permissions int64, callerPermissions Permission) (string, Permission, bool) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxRoleNameLength {
+		http.Error(w, "Invalid role name", http.StatusBadRequest)
+		return "", 0, false
+	}
+
+	if !Permission(permissions).Valid() {
+		http.Error(w, "Invalid permissions", http.StatusBadRequest)
+		return "", 0, false
+	}
+
+	if !callerPermissions.Has(Permission(permissions)) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return "", 0, false
+	}
+
+	return name, Permission(permissions), true
+}
+
+func roleToProto(role communitydb.Role) *communityserverv1.Role {
+	return &communityserverv1.Role{
+		Id:          role.ID.String(),
+		Name:        role.Name,
+		Permissions: role.Permissions,
+	}
+}
@@ -6,9 +6,13 @@ import (
 	"net/http"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	"github.com/varsotech/prochat-server/internal/community/gateway"
 	"github.com/varsotech/prochat-server/internal/homeserver/oauth"
+	"github.com/varsotech/prochat-server/internal/imageproxy"
 	homeserverv1 "github.com/varsotech/prochat-server/internal/models/gen/homeserver/v1"
 	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+	"github.com/varsotech/prochat-server/internal/pkg/filestore"
 )
 
 type Authenticator interface {
@@ -23,19 +27,130 @@ type TemplateExecutor interface {
 	ExecuteTemplate(wr io.Writer, name string, data any) error
 }
 
+type URLSigner interface {
+	GenerateSignedURL(inputUrl string) string
+}
+
 type Routes struct {
-	authenticator Authenticator
-	communityDb   *communitydb.Queries
+	host           string
+	authenticator  Authenticator
+	postgresClient *pgxpool.Pool
+	communityDb    *communitydb.Queries
+	hub            *gateway.Hub
+	events         EventPublisher
+	eventBus       *gateway.Bus
+	urlSigner      URLSigner
+	creationPolicy *CreationPolicy
+	presence       *presenceStore
+	typing         *typingLimiter
+	fileStore      filestore.FileStore
+	notifier       *mentionNotifier
+	linkPreviews   *linkPreviewer
+	sendLimits     *sendLimiter
 }
 
-func NewRoutes(postgresClient *pgxpool.Pool) *Routes {
-	return &Routes{
-		authenticator: NewIdentityAuthenticator(),
-		communityDb:   communitydb.New(postgresClient),
+func NewRoutes(redisClient *redis.Client, postgresClient *pgxpool.Pool, imageProxyConfig *imageproxy.Config, creationPolicy *CreationPolicy, fileStore filestore.FileStore, host string, identityPrivateKey string, allowTokensWithoutAudience bool) *Routes {
+	hub := gateway.NewHub()
+	eventBus := gateway.NewBus(redisClient, hub)
+
+	o := &Routes{
+		host:           host,
+		authenticator:  NewIdentityAuthenticator(host, allowTokensWithoutAudience),
+		postgresClient: postgresClient,
+		communityDb:    communitydb.New(postgresClient),
+		hub:            hub,
+		events:         eventBus,
+		eventBus:       eventBus,
+		urlSigner:      imageproxy.NewSigner(imageProxyConfig),
+		creationPolicy: creationPolicy,
+		presence:       newPresenceStore(redisClient),
+		typing:         newTypingLimiter(redisClient),
+		fileStore:      fileStore,
+		notifier:       newMentionNotifier(host, identityPrivateKey),
+		linkPreviews:   newLinkPreviewer(redisClient),
+		sendLimits:     newSendLimiter(redisClient),
 	}
+
+	for range linkPreviewWorkers {
+		go o.updateQueuedLinkPreviews()
+	}
+
+	return o
+}
+
+// RunEventBus delivers community events published by every instance to the gateway clients connected to
+// this instance, until the context is cancelled.
+func (o *Routes) RunEventBus(ctx context.Context) error {
+	return o.eventBus.Run(ctx)
 }
 
 func (o *Routes) RegisterRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("POST /api/v1/community/server/join", o.joinServer)
+	mux.HandleFunc("POST /api/v1/community/server/leave", o.leaveServerHandler)
 	mux.HandleFunc("GET /api/v1/community/user_communities", o.getUserCommunitiesHandler)
+	mux.HandleFunc("GET /api/v1/community/ws", o.gatewayHandler)
+	mux.HandleFunc("GET /api/v1/community/server/invites/{code}", o.resolveInviteHandler)
+	mux.HandleFunc("POST /api/v1/community/server/communities", o.createCommunityHandler)
+	mux.HandleFunc("GET /api/v1/community/server/emojis/{emojiId}", o.getCustomEmojiImageHandler)
+	mux.HandleFunc("GET /api/v1/community/server/conversations", o.getConversationsHandler)
+	mux.HandleFunc("POST /api/v1/community/server/conversations", o.createConversationHandler)
+	mux.HandleFunc("GET /api/v1/community/server/conversations/{conversationId}/messages", o.getConversationMessagesHandler)
+	mux.HandleFunc("POST /api/v1/community/server/conversations/{conversationId}/messages", o.sendConversationMessageHandler)
+
+	mux.HandleFunc("GET /api/v1/community/{communityId}", o.getCommunityHandler)
+	mux.HandleFunc("PATCH /api/v1/community/{communityId}", o.updateCommunityHandler)
+	mux.HandleFunc("DELETE /api/v1/community/{communityId}", o.deleteCommunityHandler)
+	mux.HandleFunc("POST /api/v1/community/{communityId}/leave", o.leaveCommunityHandler)
+	mux.HandleFunc("GET /api/v1/community/{communityId}/presences", o.getPresencesHandler)
+	mux.HandleFunc("GET /api/v1/community/{communityId}/read_states", o.getReadStatesHandler)
+	mux.HandleFunc("GET /api/v1/community/{communityId}/messages/search", o.searchMessagesHandler)
+
+	mux.HandleFunc("GET /api/v1/community/{communityId}/channels", o.getChannelsHandler)
+	mux.HandleFunc("POST /api/v1/community/{communityId}/channels", o.createChannelHandler)
+	mux.HandleFunc("PATCH /api/v1/community/{communityId}/channels/{channelId}", o.updateChannelHandler)
+	mux.HandleFunc("DELETE /api/v1/community/{communityId}/channels/{channelId}", o.deleteChannelHandler)
+	mux.HandleFunc("PUT /api/v1/community/{communityId}/channels/{channelId}/slow_mode", o.updateSlowModeHandler)
+	mux.HandleFunc("GET /api/v1/community/{communityId}/channels/{channelId}/overwrites", o.getChannelOverwritesHandler)
+	mux.HandleFunc("PUT /api/v1/community/{communityId}/channels/{channelId}/overwrites", o.setChannelOverwriteHandler)
+
+	mux.HandleFunc("GET /api/v1/community/{communityId}/channels/{channelId}/messages", o.getMessagesHandler)
+	mux.HandleFunc("POST /api/v1/community/{communityId}/channels/{channelId}/messages", o.sendMessageHandler)
+	mux.HandleFunc("PATCH /api/v1/community/{communityId}/channels/{channelId}/messages/{messageId}", o.updateMessageHandler)
+	mux.HandleFunc("DELETE /api/v1/community/{communityId}/channels/{channelId}/messages/{messageId}", o.deleteMessageHandler)
+	mux.HandleFunc("GET /api/v1/community/{communityId}/channels/{channelId}/messages/{messageId}/revisions", o.getMessageRevisionsHandler)
+	mux.HandleFunc("GET /api/v1/community/{communityId}/channels/{channelId}/messages/{messageId}/thread", o.getThreadMessagesHandler)
+	mux.HandleFunc("POST /api/v1/community/{communityId}/channels/{channelId}/messages/{messageId}/thread", o.sendThreadMessageHandler)
+	mux.HandleFunc("PUT /api/v1/community/{communityId}/channels/{channelId}/messages/{messageId}/reactions/{emoji}", o.addReactionHandler)
+	mux.HandleFunc("DELETE /api/v1/community/{communityId}/channels/{channelId}/messages/{messageId}/reactions/{emoji}", o.removeReactionHandler)
+	mux.HandleFunc("GET /api/v1/community/{communityId}/channels/{channelId}/pins", o.getPinnedMessagesHandler)
+	mux.HandleFunc("PUT /api/v1/community/{communityId}/channels/{channelId}/pins/{messageId}", o.pinMessageHandler)
+	mux.HandleFunc("DELETE /api/v1/community/{communityId}/channels/{channelId}/pins/{messageId}", o.unpinMessageHandler)
+	mux.HandleFunc("POST /api/v1/community/{communityId}/channels/{channelId}/ack", o.ackChannelHandler)
+	mux.HandleFunc("POST /api/v1/community/{communityId}/channels/{channelId}/attachments", o.uploadAttachmentHandler)
+	mux.HandleFunc("GET /api/v1/community/{communityId}/channels/{channelId}/attachments/{attachmentId}", o.getAttachmentHandler)
+
+	mux.HandleFunc("GET /api/v1/community/{communityId}/roles", o.getRolesHandler)
+	mux.HandleFunc("POST /api/v1/community/{communityId}/roles", o.createRoleHandler)
+	mux.HandleFunc("PATCH /api/v1/community/{communityId}/roles/{roleId}", o.updateRoleHandler)
+	mux.HandleFunc("DELETE /api/v1/community/{communityId}/roles/{roleId}", o.deleteRoleHandler)
+	mux.HandleFunc("GET /api/v1/community/{communityId}/bans", o.getBansHandler)
+	mux.HandleFunc("POST /api/v1/community/{communityId}/bans", o.createBanHandler)
+	mux.HandleFunc("DELETE /api/v1/community/{communityId}/bans/{banId}", o.deleteBanHandler)
+	mux.HandleFunc("GET /api/v1/community/{communityId}/audit_log", o.getAuditLogHandler)
+	mux.HandleFunc("GET /api/v1/community/{communityId}/rate_limit_exemptions", o.getRateLimitExemptionsHandler)
+	mux.HandleFunc("PUT /api/v1/community/{communityId}/rate_limit_exemptions/{userAddress}", o.addRateLimitExemptionHandler)
+	mux.HandleFunc("DELETE /api/v1/community/{communityId}/rate_limit_exemptions/{userAddress}", o.removeRateLimitExemptionHandler)
+	mux.HandleFunc("GET /api/v1/community/{communityId}/emojis", o.getCustomEmojisHandler)
+	mux.HandleFunc("POST /api/v1/community/{communityId}/emojis", o.createCustomEmojiHandler)
+	mux.HandleFunc("DELETE /api/v1/community/{communityId}/emojis/{emojiId}", o.deleteCustomEmojiHandler)
+
+	mux.HandleFunc("GET /api/v1/community/{communityId}/invites", o.getInvitesHandler)
+	mux.HandleFunc("POST /api/v1/community/{communityId}/invites", o.createInviteHandler)
+	mux.HandleFunc("DELETE /api/v1/community/{communityId}/invites/{code}", o.revokeInviteHandler)
+
+	mux.HandleFunc("DELETE /api/v1/community/{communityId}/members/{userAddress}", o.kickMemberHandler)
+	mux.HandleFunc("PUT /api/v1/community/{communityId}/members/{userAddress}/mute", o.muteMemberHandler)
+	mux.HandleFunc("DELETE /api/v1/community/{communityId}/members/{userAddress}/mute", o.unmuteMemberHandler)
+	mux.HandleFunc("PUT /api/v1/community/{communityId}/members/{userAddress}/roles/{roleId}", o.assignRoleHandler)
+	mux.HandleFunc("DELETE /api/v1/community/{communityId}/members/{userAddress}/roles/{roleId}", o.unassignRoleHandler)
 }
@@ -0,0 +1,169 @@
+package community
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+)
+
+const maxSearchQueryLength = 256
+
+// searchMessagesHandler searches the messages of a community, newest first. The query uses web search syntax,
+// like "quoted phrases" and -excluded words, and can be narrowed down to a channel, an author (matched
+// case-insensitively), a date range and messages with attachments. Only channels the caller can view are
+// searched.
+//
+// Pages are selected with the "before" message ID cursor, like the channel history. Ordering by ID instead of
+// relevance lets the search stop after a page of matches, which keeps it fast in large communities.
+func (o *Routes) searchMessagesHandler(w http.ResponseWriter, r *http.Request) {
+	caller, ok := o.authenticateCommunityMember(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+
+	searchQuery := strings.TrimSpace(query.Get("q"))
+	if searchQuery == "" || utf8.RuneCountInString(searchQuery) > maxSearchQueryLength {
+		http.Error(w, "Invalid search query", http.StatusBadRequest)
+		return
+	}
+
+	limit, err := parsePageSize(query.Get("limit"))
+	if err != nil {
+		http.Error(w, "Invalid limit", http.StatusBadRequest)
+		return
+	}
+
+	channels, err := o.getVisibleChannels(r.Context(), caller)
+	if err != nil {
+		slog.Error("could not get visible channels", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	params := communitydb.SearchMessagesParams{
+		Query: searchQuery,
+		// Fetch one extra message to know whether there are more messages to page through
+		MaxResults: limit + 1,
+	}
+
+	for _, channel := range channels {
+		params.ChannelIds = append(params.ChannelIds, channel.ID)
+	}
+
+	if query.Has("channel_id") {
+		channelId, err := uuid.Parse(query.Get("channel_id"))
+		if err != nil {
+			http.Error(w, "Invalid channel id", http.StatusBadRequest)
+			return
+		}
+
+		// Channels the caller can't view are indistinguishable from channels that don't exist
+		if !slices.Contains(params.ChannelIds, channelId) {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+
+		params.ChannelIds = []uuid.UUID{channelId}
+	}
+
+	if query.Has("before") {
+		before, err := uuid.Parse(query.Get("before"))
+		if err != nil {
+			http.Error(w, "Invalid before cursor", http.StatusBadRequest)
+			return
+		}
+
+		params.Before = pgtype.UUID{Bytes: before, Valid: true}
+	}
+
+	if query.Has("author") {
+		params.UserAddress = pgtype.Text{String: strings.ToLower(query.Get("author")), Valid: true}
+	}
+
+	if query.Has("created_after") {
+		createdAfter, err := time.Parse(time.RFC3339, query.Get("created_after"))
+		if err != nil {
+			http.Error(w, "Invalid created_after", http.StatusBadRequest)
+			return
+		}
+
+		params.CreatedAfter = pgtype.Timestamptz{Time: createdAfter, Valid: true}
+	}
+
+	if query.Has("created_before") {
+		createdBefore, err := time.Parse(time.RFC3339, query.Get("created_before"))
+		if err != nil {
+			http.Error(w, "Invalid created_before", http.StatusBadRequest)
+			return
+		}
+
+		params.CreatedBefore = pgtype.Timestamptz{Time: createdBefore, Valid: true}
+	}
+
+	params.HasAttachments = query.Get("has_attachment") == "true"
+
+	messages := []communitydb.Message{}
+	if len(params.ChannelIds) > 0 {
+		messages, err = o.communityDb.SearchMessages(r.Context(), params)
+		if err != nil {
+			slog.Error("could not search messages", "error", err)
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	hasMore := len(messages) > int(limit)
+	if hasMore {
+		messages = messages[:limit]
+	}
+
+	messagesProto, err := o.searchResultsToProto(r.Context(), caller.Auth.UserAddress, messages)
+	if err != nil {
+		slog.Error("could not convert messages", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	o.writeProtoJson(w, &communityserverv1.SearchMessagesResponse{
+		Messages: messagesProto,
+		HasMore:  hasMore,
+	})
+}
+
+// searchResultsToProto converts messages from several channels to their protos, keeping their order.
+func (o *Routes) searchResultsToProto(ctx context.Context, userAddress string, messages []communitydb.Message) ([]*communityserverv1.Message, error) {
+	messagesByChannel := map[uuid.UUID][]communitydb.Message{}
+	for _, message := range messages {
+		messagesByChannel[message.ChannelID] = append(messagesByChannel[message.ChannelID], message)
+	}
+
+	messagesProtoById := map[string]*communityserverv1.Message{}
+	for channelId, channelMessages := range messagesByChannel {
+		channelMessagesProto, err := o.messagesToProto(ctx, channelId, userAddress, channelMessages)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, messageProto := range channelMessagesProto {
+			messagesProtoById[messageProto.Id] = messageProto
+		}
+	}
+
+	messagesProto := []*communityserverv1.Message{}
+	for _, message := range messages {
+		messagesProto = append(messagesProto, messagesProtoById[message.ID.String()])
+	}
+
+	return messagesProto, nil
+}
@@ -0,0 +1,136 @@
+package community
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/redis/go-redis/v9"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/communitydb"
+)
+
+const (
+	typingKeyPrefix = "community:typing:"
+
+	// typingRateLimit is the minimum interval between typing signals of a member in a channel. Clients are
+	// expected to resend the signal while the user keeps typing, so it must be shorter than typingTimeout.
+	typingRateLimit = 3 * time.Second
+
+	// maxUserTypingSignals is how many typing signals a user may send across all channels in each
+	// typingRateLimit interval, so signals for made up channels can't get past the limiter
+	maxUserTypingSignals = 5
+
+	// typingTimeout is how long clients show a member as typing after its last typing signal
+	typingTimeout = 8 * time.Second
+)
+
+// typingLimiter rate limits the typing signals of members in Redis, so it is shared by every instance of
+// the server. Typing signals are only published as events, and are never stored in the database.
+type typingLimiter struct {
+	redisClient *redis.Client
+}
+
+func newTypingLimiter(redisClient *redis.Client) *typingLimiter {
+	return &typingLimiter{
+		redisClient: redisClient,
+	}
+}
+
+// allow reports whether the member may send a typing signal in the channel, and starts a new rate limit
+// interval if it may. Signals are first counted per user regardless of the channel, as the channel id is
+// supplied by the client and isn't validated yet.
+func (l *typingLimiter) allow(ctx context.Context, channelId uuid.UUID, userAddress string) (bool, error) {
+	pipe := l.redisClient.TxPipeline()
+	count := pipe.Incr(ctx, typingUserKey(userAddress))
+	pipe.ExpireNX(ctx, typingUserKey(userAddress), typingRateLimit)
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to count typing signals: %w", err)
+	}
+
+	if count.Val() > maxUserTypingSignals {
+		return false, nil
+	}
+
+	allowed, err := l.redisClient.SetNX(ctx, typingKey(channelId, userAddress), 1, typingRateLimit).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to set typing rate limit: %w", err)
+	}
+
+	return allowed, nil
+}
+
+func typingKey(channelId uuid.UUID, userAddress string) string {
+	return typingKeyPrefix + channelId.String() + ":" + userAddress
+}
+
+func typingUserKey(userAddress string) string {
+	return typingKeyPrefix + "user:" + userAddress
+}
+
+// startTyping announces that the user is typing in a channel to the members that can view it. Signals sent
+// faster than typingRateLimit in a channel, or more than maxUserTypingSignals per interval across channels,
+// are dropped before the member and channel are looked up, so flooding typing signals doesn't reach the
+// database.
+func (o *Routes) startTyping(ctx context.Context, connection *gatewayConnection, command *communityserverv1.StartTypingCommand) error {
+	communityId, err := uuid.Parse(command.CommunityId)
+	if err != nil {
+		return errors.New("invalid community id")
+	}
+
+	channelId, err := uuid.Parse(command.ChannelId)
+	if err != nil {
+		return errors.New("invalid channel id")
+	}
+
+	allowed, err := o.typing.allow(ctx, channelId, connection.userAddress)
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
+		return nil
+	}
+
+	caller, err := o.getCommunityMemberByAddress(ctx, connection.userAddress, communityId)
+	if err != nil {
+		return err
+	}
+
+	if isMuted(caller.Membership, time.Now()) {
+		return errors.New("member is muted")
+	}
+
+	channel, err := o.communityDb.GetChannel(ctx, communitydb.GetChannelParams{
+		ID:          channelId,
+		CommunityID: communityId,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return errors.New("channel not found")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get channel: %w", err)
+	}
+
+	permissions, err := o.getChannelPermissions(ctx, caller, channel.ID)
+	if err != nil {
+		return err
+	}
+
+	if !permissions.Has(PermissionViewChannel | PermissionSendMessages) {
+		return errors.New("missing permissions to send messages in channel")
+	}
+
+	o.publishChannelEvent(ctx, communityId, channel.ID, communityserverv1.Event_TYPE_TYPING_STARTED, &communityserverv1.TypingStartedEvent{
+		ChannelId:   channel.ID.String(),
+		UserAddress: connection.userAddress,
+		ExpiresAt:   formatTimestamp(pgtype.Timestamptz{Time: time.Now().Add(typingTimeout), Valid: true}),
+	})
+
+	return nil
+}
@@ -44,11 +44,29 @@ func (o *Routes) getUserCommunitiesHandler(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	counts, err := o.getMemberUnreadCounts(r.Context(), member, communities)
+	if err != nil {
+		slog.Error("could not get unread counts", "error", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
 	var communitiesProto []*communityserverv1.GetUserCommunitiesResponse_Community
 	for _, community := range communities {
+		online, err := o.presence.onlineCount(r.Context(), community.ID)
+		if err != nil {
+			slog.Error("could not count online members", "error", err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
 		communitiesProto = append(communitiesProto, &communityserverv1.GetUserCommunitiesResponse_Community{
-			Id:   community.ID.String(),
-			Name: community.Name,
+			Id:           community.ID.String(),
+			Name:         community.Name,
+			IconUrl:      o.iconUrl(community),
+			Online:       online,
+			UnreadCount:  counts[community.ID].Unread,
+			MentionCount: counts[community.ID].Mentions,
 		})
 	}
 
@@ -0,0 +1,63 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	homeserverv1 "github.com/varsotech/prochat-server/internal/models/gen/homeserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/httputil"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// ParseIssuer parses the issuer of a JWT as a URL. Issuers are hosts, which are served over HTTPS unless a
+// scheme is given.
+func ParseIssuer(issuer string) (*url.URL, error) {
+	if !strings.HasPrefix(issuer, "http://") && !strings.HasPrefix(issuer, "https://") {
+		issuer = "https://" + issuer
+	}
+
+	issuerUrl, err := url.Parse(issuer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse issuer url: %w", err)
+	}
+
+	return issuerUrl, nil
+}
+
+// FetchWellKnown fetches the well known document of an issuer, which holds the public key its JWTs are
+// signed with.
+func FetchWellKnown(ctx context.Context, httpClient *httputil.Client, issuerUrl *url.URL) (*homeserverv1.WellKnown, error) {
+	wellKnownUrl := issuerUrl.JoinPath("/.well-known/prochat.json").String()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", wellKnownUrl, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error executing request: %d %s", resp.StatusCode, resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	var wellKnown homeserverv1.WellKnown
+	err = protojson.Unmarshal(body, &wellKnown)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling well known response: %w", err)
+	}
+
+	return &wellKnown, nil
+}
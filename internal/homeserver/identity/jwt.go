@@ -3,6 +3,8 @@ package identity
 import (
 	"crypto/rsa"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -16,29 +18,54 @@ type Claims struct {
 
 const identityTokenExpiration = 15 * time.Minute
 
-func NewClaims(host string, userId uuid.UUID) *Claims {
+// NewClaims returns the identity claims of a user of the homeserver at host. The token is only accepted by
+// the server it is addressed to, so servers can't impersonate the user to other servers with it.
+func NewClaims(host string, audience string, userId uuid.UUID) *Claims {
 	return &Claims{
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    host,
+			Audience:  jwt.ClaimStrings{audience},
 			Subject:   userId.String(),
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(identityTokenExpiration)),
 		},
 	}
 }
 
-func Parse(tokenString string, publicKeyStr string) (*jwt.Token, error) {
+// Audience returns the audience of identity tokens addressed to a server, which is its lowercase host.
+func Audience(server string) (string, error) {
+	serverUrl, err := ParseIssuer(server)
+	if err != nil {
+		return "", err
+	}
+
+	if serverUrl.Host == "" {
+		return "", fmt.Errorf("invalid server: %s", server)
+	}
+
+	return strings.ToLower(serverUrl.Host), nil
+}
+
+// Parse validates an identity token signed with the given public key, and addressed to audience. Tokens
+// without an audience are only accepted if allowMissingAudience is set, for homeservers that don't address
+// their tokens yet.
+func Parse(tokenString string, publicKeyStr string, audience string, allowMissingAudience bool) (*jwt.Token, error) {
 	publicKey, err := getRSAPublicKey([]byte(publicKeyStr))
 	if err != nil {
 		return nil, fmt.Errorf("error parsing public key: %v", err)
 	}
 
+	options := []jwt.ParserOption{jwt.WithExpirationRequired(), jwt.WithValidMethods([]string{"RS256"})}
+	if !allowMissingAudience {
+		options = append(options, jwt.WithAudience(audience))
+	}
+
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		// Validate alg is RSA
 		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
 			return nil, fmt.Errorf("unexpected signing method")
 		}
 		return publicKey, nil
-	}, jwt.WithExpirationRequired(), jwt.WithValidMethods([]string{"RS256"}))
+	}, options...)
 
 	if err != nil {
 		return nil, fmt.Errorf("token parsing error: %w", err)
@@ -48,6 +75,18 @@ func Parse(tokenString string, publicKeyStr string) (*jwt.Token, error) {
 		return nil, fmt.Errorf("invalid token")
 	}
 
+	// Tokens that have an audience must still be addressed to this server
+	if allowMissingAudience {
+		tokenAudience, err := token.Claims.GetAudience()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get audience: %w", err)
+		}
+
+		if len(tokenAudience) > 0 && !slices.Contains(tokenAudience, audience) {
+			return nil, fmt.Errorf("token is addressed to another server")
+		}
+	}
+
 	return token, nil
 }
 
@@ -71,7 +110,11 @@ func GetUnverifiedIssuer(tokenString string) (string, error) {
 }
 
 func (i *Claims) Sign(privateKey string) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodRS256, i)
+	return sign(i, privateKey)
+}
+
+func sign(claims jwt.Claims, privateKey string) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
 
 	privKey, err := getRSAPrivateKey([]byte(privateKey))
 	if err != nil {
@@ -0,0 +1,57 @@
+package identity
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestParseAudience(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	privateKey := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+
+	sshPublicKey, err := ssh.NewPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("failed to convert public key: %v", err)
+	}
+	publicKey := string(ssh.MarshalAuthorizedKey(sshPublicKey))
+
+	tests := []struct {
+		name                 string
+		audience             []string
+		allowMissingAudience bool
+		wantErr              bool
+	}{
+		{name: "addressed to server", audience: []string{"community.example.com"}},
+		{name: "addressed to another server", audience: []string{"other.example.com"}, wantErr: true},
+		{name: "no audience", wantErr: true},
+		{name: "no audience allowed", allowMissingAudience: true},
+		{name: "addressed to another server with no audience allowed", audience: []string{"other.example.com"}, allowMissingAudience: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := NewClaims("https://home.example.com", "", uuid.New())
+			claims.Audience = tt.audience
+
+			token, err := sign(claims, privateKey)
+			if err != nil {
+				t.Fatalf("failed to sign token: %v", err)
+			}
+
+			_, err = Parse(token, publicKey, "community.example.com", tt.allowMissingAudience)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package identity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	notificationTokenExpiration = 5 * time.Minute
+
+	// MaxNotificationRecipients caps the recipients of a single notification token. Senders split larger
+	// batches into several tokens.
+	MaxNotificationRecipients = 1000
+
+	// MaxNotificationBodyLength caps the message excerpt of a notification, in runes
+	MaxNotificationBodyLength = 200
+)
+
+// NotificationClaims are the claims of a notification pushed by a server to the homeserver of its recipients.
+// The issuer is the host of the sending server and the audience is the host of the homeserver, so a token
+// can't be delivered to other homeservers.
+type NotificationClaims struct {
+	jwt.RegisteredClaims
+
+	// Recipients are the ids of the users of the homeserver that receive the notification
+	Recipients []string `json:"recipients"`
+
+	// Notification is the protobuf encoded homeserverv1.Notification
+	Notification []byte `json:"notification"`
+}
+
+func NewNotificationClaims(host string, homeserverHost string, recipients []string, notification []byte) *NotificationClaims {
+	return &NotificationClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    host,
+			Audience:  jwt.ClaimStrings{homeserverHost},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(notificationTokenExpiration)),
+		},
+		Recipients:   recipients,
+		Notification: notification,
+	}
+}
+
+func (c *NotificationClaims) Sign(privateKey string) (string, error) {
+	return sign(c, privateKey)
+}
+
+// ParseNotification validates a notification token addressed to the homeserver at host, and returns its claims.
+func ParseNotification(tokenString string, publicKeyStr string, host string) (*NotificationClaims, error) {
+	publicKey, err := getRSAPublicKey([]byte(publicKeyStr))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %v", err)
+	}
+
+	var claims NotificationClaims
+	token, err := jwt.ParseWithClaims(tokenString, &claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, fmt.Errorf("unexpected signing method")
+		}
+		return publicKey, nil
+	}, jwt.WithExpirationRequired(), jwt.WithValidMethods([]string{"RS256"}), jwt.WithAudience(host))
+	if err != nil {
+		return nil, fmt.Errorf("token parsing error: %w", err)
+	}
+
+	if !token.Valid {
+		return nil, fmt.Errorf("invalid token")
+	}
+
+	return &claims, nil
+}
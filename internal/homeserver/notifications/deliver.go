@@ -0,0 +1,220 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/varsotech/prochat-server/internal/homeserver/identity"
+	homeserverv1 "github.com/varsotech/prochat-server/internal/models/gen/homeserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/homeserverdb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	maxDeliverRequestSize = 1 << 20
+
+	// maxNotificationsPerUser caps the inbox of a user. The oldest notifications are dropped first.
+	maxNotificationsPerUser = 500
+
+	maxNotificationFieldLength = 256
+
+	// maxConversationHostsPerUser caps the servers hosting direct message conversations of a user
+	maxConversationHostsPerUser = 200
+)
+
+// deliverNotificationsHandler stores a notification pushed by another server in the inbox of its recipients.
+// The notification is a JWT signed by the sending server, which is verified with the public key in the well
+// known document of its issuer. Mentions are only stored for users that added the sending server, so
+// servers can't notify arbitrary users about communities. Direct messages may come from any server, which is
+// then recorded as a conversation host of its recipients. Hosts are pending until the user accepts them,
+// unless the user added the server, and direct messages are only stored from accepted hosts.
+func (s *Routes) deliverNotificationsHandler(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxDeliverRequestSize))
+	if err != nil {
+		http.Error(w, "Bad request", http.StatusBadRequest)
+		return
+	}
+
+	var req homeserverv1.DeliverNotificationsRequest
+	err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(body, &req)
+	if err != nil {
+		http.Error(w, "Bad request", http.StatusBadRequest)
+		return
+	}
+
+	claims, err := s.verify(r.Context(), req.Token)
+	if err != nil {
+		slog.Info("failed to verify notification", "error", err)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var notification homeserverv1.Notification
+	err = proto.Unmarshal(claims.Notification, &notification)
+	if err != nil {
+		http.Error(w, "Invalid notification", http.StatusBadRequest)
+		return
+	}
+
+	userIds, err := validateNotification(claims, &notification)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var delivered int64
+	if notification.Type == homeserverv1.Notification_TYPE_DIRECT_MESSAGE {
+		delivered, err = s.deliverDirectMessage(r.Context(), claims.Issuer, &notification, userIds)
+	} else {
+		delivered, err = s.postgresClient.InsertNotifications(r.Context(), homeserverdb.InsertNotificationsParams{
+			Type:          int16(notification.Type),
+			Host:          claims.Issuer,
+			CommunityID:   notification.CommunityId,
+			CommunityName: notification.CommunityName,
+			ChannelID:     notification.ChannelId,
+			ChannelName:   notification.ChannelName,
+			MessageID:     notification.MessageId,
+			AuthorAddress: notification.AuthorAddress,
+			Body:          notification.Body,
+			UserIds:       userIds,
+		})
+	}
+	if err != nil {
+		slog.Error("failed to insert notifications", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	if delivered > 0 {
+		err = s.postgresClient.DeleteExcessNotifications(r.Context(), homeserverdb.DeleteExcessNotificationsParams{
+			UserIds:          userIds,
+			MaxNotifications: maxNotificationsPerUser,
+		})
+		if err != nil {
+			slog.Error("failed to delete excess notifications", "error", err)
+		}
+	}
+
+	data, err := protojson.Marshal(&homeserverv1.DeliverNotificationsResponse{
+		Delivered: delivered,
+	})
+	if err != nil {
+		slog.Error("failed to marshal deliver notifications response", "error", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, err = w.Write(data)
+	if err != nil {
+		slog.Info("failed to write deliver notifications response", "error", err)
+	}
+}
+
+// deliverDirectMessage records the issuer as a conversation host of the recipients, and stores the direct
+// message notification in the inbox of the recipients that accepted it. Blocked hosts stay blocked.
+func (s *Routes) deliverDirectMessage(ctx context.Context, host string, notification *homeserverv1.Notification, userIds []uuid.UUID) (int64, error) {
+	err := s.postgresClient.InsertUserConversationHosts(ctx, homeserverdb.InsertUserConversationHostsParams{
+		Host:     host,
+		UserIds:  userIds,
+		MaxHosts: maxConversationHostsPerUser,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert user conversation hosts: %w", err)
+	}
+
+	return s.postgresClient.InsertDirectMessageNotifications(ctx, homeserverdb.InsertDirectMessageNotificationsParams{
+		Type:           int16(notification.Type),
+		Host:           host,
+		MessageID:      notification.MessageId,
+		AuthorAddress:  notification.AuthorAddress,
+		Body:           notification.Body,
+		ConversationID: notification.ConversationId,
+		UserIds:        userIds,
+	})
+}
+
+// verify validates the signature of a notification token with the public key of its issuer, and that it is
+// addressed to this homeserver. Well known documents are cached for wellKnownTTL.
+func (s *Routes) verify(ctx context.Context, token string) (*identity.NotificationClaims, error) {
+	unverifiedIssuer, err := identity.GetUnverifiedIssuer(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unverified issuer: %w", err)
+	}
+
+	wellKnown, ok := s.wellKnowns.get(unverifiedIssuer, time.Now())
+	if !ok {
+		issuerUrl, err := identity.ParseIssuer(unverifiedIssuer)
+		if err != nil {
+			return nil, err
+		}
+
+		wellKnown, err = identity.FetchWellKnown(ctx, s.httpClient, issuerUrl)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get well known issuer: %w", err)
+		}
+
+		s.wellKnowns.set(unverifiedIssuer, wellKnown, time.Now())
+	}
+
+	return identity.ParseNotification(token, wellKnown.PublicKey, s.host)
+}
+
+// validateNotification validates the content of a notification, and returns the ids of its recipients.
+func validateNotification(claims *identity.NotificationClaims, notification *homeserverv1.Notification) ([]uuid.UUID, error) {
+	requiredFields := []string{notification.MessageId, notification.AuthorAddress}
+	switch notification.Type {
+	case homeserverv1.Notification_TYPE_USER_MENTION,
+		homeserverv1.Notification_TYPE_ROLE_MENTION,
+		homeserverv1.Notification_TYPE_EVERYONE_MENTION:
+		if notification.ConversationId != "" {
+			return nil, errors.New("invalid notification")
+		}
+
+		requiredFields = append(requiredFields, notification.CommunityId, notification.ChannelId)
+	case homeserverv1.Notification_TYPE_DIRECT_MESSAGE:
+		if notification.CommunityId != "" || notification.CommunityName != "" || notification.ChannelId != "" || notification.ChannelName != "" {
+			return nil, errors.New("invalid notification")
+		}
+
+		requiredFields = append(requiredFields, notification.ConversationId)
+	default:
+		return nil, errors.New("invalid notification type")
+	}
+
+	for _, field := range requiredFields {
+		if field == "" || len(field) > maxNotificationFieldLength {
+			return nil, errors.New("invalid notification")
+		}
+	}
+
+	if utf8.RuneCountInString(notification.CommunityName) > maxNotificationFieldLength ||
+		utf8.RuneCountInString(notification.ChannelName) > maxNotificationFieldLength ||
+		utf8.RuneCountInString(notification.Body) > identity.MaxNotificationBodyLength {
+		return nil, errors.New("invalid notification")
+	}
+
+	if len(claims.Recipients) == 0 || len(claims.Recipients) > identity.MaxNotificationRecipients {
+		return nil, errors.New("invalid notification recipients")
+	}
+
+	userIds := make([]uuid.UUID, 0, len(claims.Recipients))
+	for _, recipient := range claims.Recipients {
+		userId, err := uuid.Parse(recipient)
+		if err != nil {
+			return nil, fmt.Errorf("invalid notification recipient: %s", recipient)
+		}
+
+		userIds = append(userIds, userId)
+	}
+
+	return userIds, nil
+}
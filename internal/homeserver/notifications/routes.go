@@ -0,0 +1,30 @@
+package notifications
+
+import (
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/varsotech/prochat-server/internal/pkg/homeserverdb"
+	"github.com/varsotech/prochat-server/internal/pkg/httputil"
+)
+
+type Routes struct {
+	httpClient     *httputil.Client
+	wellKnowns     *wellKnownCache
+	postgresClient *homeserverdb.Queries
+	host           string
+}
+
+// NewRoutes exposes the routes other servers use to push notifications to the users of the homeserver.
+func NewRoutes(postgresClient *pgxpool.Pool, host string) *Routes {
+	return &Routes{
+		httpClient:     httputil.NewClient(),
+		wellKnowns:     newWellKnownCache(),
+		postgresClient: homeserverdb.New(postgresClient),
+		host:           host,
+	}
+}
+
+func (s *Routes) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/v1/homeserver/notifications", s.deliverNotificationsHandler)
+}
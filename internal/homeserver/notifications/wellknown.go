@@ -0,0 +1,70 @@
+package notifications
+
+import (
+	"sync"
+	"time"
+
+	homeserverv1 "github.com/varsotech/prochat-server/internal/models/gen/homeserver/v1"
+)
+
+const (
+	// wellKnownTTL bounds how long the well known document of an issuer is trusted, so rotated keys are
+	// picked up
+	wellKnownTTL = 10 * time.Minute
+
+	// maxWellKnownCacheEntries caps the issuers with a cached well known document
+	maxWellKnownCacheEntries = 1000
+)
+
+// wellKnownCache caches the well known documents of notification issuers, so delivering a notification
+// doesn't fetch the document of its issuer every time. It is safe for concurrent use by multiple Go routines.
+type wellKnownCache struct {
+	mu      sync.Mutex
+	entries map[string]wellKnownEntry
+}
+
+type wellKnownEntry struct {
+	wellKnown *homeserverv1.WellKnown
+	expiresAt time.Time
+}
+
+func newWellKnownCache() *wellKnownCache {
+	return &wellKnownCache{
+		entries: map[string]wellKnownEntry{},
+	}
+}
+
+func (c *wellKnownCache) get(issuer string, now time.Time) (*homeserverv1.WellKnown, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[issuer]
+	if !ok || now.After(entry.expiresAt) {
+		return nil, false
+	}
+
+	return entry.wellKnown, true
+}
+
+// set caches the well known document of an issuer. Expired documents are dropped when the cache is full, and
+// the whole cache is cleared if that doesn't make room.
+func (c *wellKnownCache) set(issuer string, wellKnown *homeserverv1.WellKnown, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= maxWellKnownCacheEntries {
+		for cachedIssuer, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, cachedIssuer)
+			}
+		}
+		if len(c.entries) >= maxWellKnownCacheEntries {
+			clear(c.entries)
+		}
+	}
+
+	c.entries[issuer] = wellKnownEntry{
+		wellKnown: wellKnown,
+		expiresAt: now.Add(wellKnownTTL),
+	}
+}
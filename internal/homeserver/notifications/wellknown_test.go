@@ -0,0 +1,32 @@
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	homeserverv1 "github.com/varsotech/prochat-server/internal/models/gen/homeserver/v1"
+)
+
+func TestWellKnownCache(t *testing.T) {
+	now := time.Now()
+	wellKnown := &homeserverv1.WellKnown{PublicKey: "key"}
+
+	cache := newWellKnownCache()
+	if _, ok := cache.get("example.com", now); ok {
+		t.Fatal("empty cache returned a well known document")
+	}
+
+	cache.set("example.com", wellKnown, now)
+
+	if cached, ok := cache.get("example.com", now.Add(wellKnownTTL-time.Second)); !ok || cached != wellKnown {
+		t.Errorf("get() = %v, %v, want %v, true", cached, ok, wellKnown)
+	}
+
+	if _, ok := cache.get("other.example.com", now); ok {
+		t.Error("cache returned the well known document of another issuer")
+	}
+
+	if _, ok := cache.get("example.com", now.Add(wellKnownTTL+time.Second)); ok {
+		t.Error("expired cache returned a well known document")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	authhttp "github.com/varsotech/prochat-server/internal/homeserver/auth/http"
 	"github.com/varsotech/prochat-server/internal/homeserver/html"
 	"github.com/varsotech/prochat-server/internal/homeserver/identity"
+	"github.com/varsotech/prochat-server/internal/homeserver/notifications"
 	"github.com/varsotech/prochat-server/internal/homeserver/oauth"
 	"github.com/varsotech/prochat-server/internal/homeserver/websocket"
 	"github.com/varsotech/prochat-server/internal/imageproxy"
@@ -32,22 +33,24 @@ type Routes struct {
 	authorizer Authorizer
 	handlers   Handlers
 
-	authService     *authhttp.Routes
-	htmlService     *html.Routes
-	oauthService    *oauth.Routes
-	identityService *identity.Routes
+	authService         *authhttp.Routes
+	htmlService         *html.Routes
+	oauthService        *oauth.Routes
+	identityService     *identity.Routes
+	notificationService *notifications.Routes
 }
 
 // NewRoutes exposes HTTP routes struct for the homeserver WebSocket API.
 // These routes are accessed by clients with OAuth credentials.
 func NewRoutes(redisClient *redis.Client, postgresClient *pgxpool.Pool, htmlTemplate TemplateExecutor, imageProxyConfig *imageproxy.Config, host, identityPrivateKey, identityPublicKey string) *Routes {
 	return &Routes{
-		authorizer:      oauth.NewAuthorizer(redisClient),
-		handlers:        websocket.New(postgresClient, host, identityPrivateKey),
-		authService:     authhttp.New(postgresClient, redisClient, host),
-		htmlService:     html.NewRoutes(htmlTemplate, redisClient),
-		oauthService:    oauth.NewRoutes(redisClient, htmlTemplate, imageProxyConfig),
-		identityService: identity.NewRoutes(host, identityPublicKey, identityPrivateKey),
+		authorizer:          oauth.NewAuthorizer(redisClient),
+		handlers:            websocket.New(postgresClient, host, identityPrivateKey),
+		authService:         authhttp.New(postgresClient, redisClient, host),
+		htmlService:         html.NewRoutes(htmlTemplate, redisClient),
+		oauthService:        oauth.NewRoutes(redisClient, htmlTemplate, imageProxyConfig),
+		identityService:     identity.NewRoutes(host, identityPublicKey, identityPrivateKey),
+		notificationService: notifications.NewRoutes(postgresClient, host),
 	}
 }
 
@@ -56,6 +59,7 @@ func (o *Routes) RegisterRoutes(mux *http.ServeMux) {
 	o.htmlService.RegisterRoutes(mux)
 	o.oauthService.RegisterRoutes(mux)
 	o.identityService.RegisterRoutes(mux)
+	o.notificationService.RegisterRoutes(mux)
 
 	mux.HandleFunc("GET /api/v1/homeserver/ws", o.ws)
 }
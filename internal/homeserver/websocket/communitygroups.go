@@ -0,0 +1,258 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/varsotech/prochat-server/internal/homeserver/oauth"
+	homeserverv1 "github.com/varsotech/prochat-server/internal/models/gen/homeserver/v1"
+	prochatv1 "github.com/varsotech/prochat-server/internal/models/gen/prochat/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/homeserverdb"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	maxCommunityGroups           = 100
+	maxCommunityGroupNameLength  = 100
+	maxCommunityGroupCommunities = 200
+)
+
+// GetCommunityGroups returns the community groups of the user, in the order the user arranged them.
+func (h *Handlers) GetCommunityGroups(ctx context.Context, auth *oauth.AuthorizeResult, message *homeserverv1.Message) *homeserverv1.Message {
+	groups, err := getCommunityGroups(ctx, h.postgresClient, auth.UserId)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	payload, err := proto.Marshal(&prochatv1.GetCommunityGroupsResponse{
+		Groups: groups,
+	})
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	return &homeserverv1.Message{
+		Payload: payload,
+	}
+}
+
+// SetCommunityGroups replaces the community groups of the user. Groups and their communities are ordered
+// as given, and groups without an id are assigned one.
+func (h *Handlers) SetCommunityGroups(ctx context.Context, auth *oauth.AuthorizeResult, message *homeserverv1.Message) *homeserverv1.Message {
+	var req prochatv1.SetCommunityGroupsRequest
+	err := proto.Unmarshal(message.Payload, &req)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	userServers, err := h.postgresClient.GetUserServers(ctx, auth.UserId)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	err = normalizeCommunityGroups(req.Groups, userServers)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	groups, err := h.replaceCommunityGroups(ctx, auth.UserId, req.Groups)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	payload, err := proto.Marshal(&prochatv1.SetCommunityGroupsResponse{
+		Groups: groups,
+	})
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	return &homeserverv1.Message{
+		Payload: payload,
+	}
+}
+
+// replaceCommunityGroups replaces the community groups of the user in a single transaction, so other devices
+// never observe a partial update.
+func (h *Handlers) replaceCommunityGroups(ctx context.Context, userId uuid.UUID, groups []*prochatv1.CommunityGroup) ([]*prochatv1.CommunityGroup, error) {
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := homeserverdb.New(tx)
+
+	err = queries.DeleteCommunityGroups(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete community groups: %w", err)
+	}
+
+	for groupPosition, group := range groups {
+		groupId, err := uuid.Parse(group.Id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid community group id: %s", group.Id)
+		}
+
+		err = queries.InsertCommunityGroup(ctx, homeserverdb.InsertCommunityGroupParams{
+			ID:       groupId,
+			UserID:   userId,
+			Name:     group.Name,
+			Position: int32(groupPosition),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert community group: %w", err)
+		}
+
+		for position, community := range group.Communities {
+			err = queries.InsertCommunityGroupEntry(ctx, homeserverdb.InsertCommunityGroupEntryParams{
+				GroupID:     groupId,
+				UserID:      userId,
+				Host:        community.Host,
+				CommunityID: community.Id,
+				Position:    int32(position),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to insert community group entry: %w", err)
+			}
+		}
+	}
+
+	storedGroups, err := getCommunityGroups(ctx, queries, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return storedGroups, nil
+}
+
+func getCommunityGroups(ctx context.Context, queries *homeserverdb.Queries, userId uuid.UUID) ([]*prochatv1.CommunityGroup, error) {
+	groups, err := queries.GetCommunityGroups(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get community groups: %w", err)
+	}
+
+	entries, err := queries.GetCommunityGroupEntries(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get community group entries: %w", err)
+	}
+
+	groupsProto := []*prochatv1.CommunityGroup{}
+	groupsById := map[uuid.UUID]*prochatv1.CommunityGroup{}
+	for _, group := range groups {
+		groupProto := &prochatv1.CommunityGroup{
+			Id:          group.ID.String(),
+			Name:        group.Name,
+			Communities: []*prochatv1.Community{},
+		}
+		groupsProto = append(groupsProto, groupProto)
+		groupsById[group.ID] = groupProto
+	}
+
+	// Entries are ordered by position, so appending keeps the order within each group
+	for _, entry := range entries {
+		groupProto, ok := groupsById[entry.GroupID]
+		if !ok {
+			continue
+		}
+
+		groupProto.Communities = append(groupProto.Communities, &prochatv1.Community{
+			Id:   entry.CommunityID,
+			Host: entry.Host,
+		})
+	}
+
+	return groupsProto, nil
+}
+
+// normalizeCommunityGroups validates community groups in place, trimming their names and assigning ids to
+// new groups. A community can only be in one group, and must be on one of the servers of the user.
+func normalizeCommunityGroups(groups []*prochatv1.CommunityGroup, userServers []string) error {
+	if len(groups) > maxCommunityGroups {
+		return errors.New("too many community groups")
+	}
+
+	groupIds := map[string]struct{}{}
+	communities := map[string]struct{}{}
+	for _, group := range groups {
+		if group.Id == "" {
+			group.Id = uuid.NewString()
+		}
+
+		groupId, err := uuid.Parse(group.Id)
+		if err != nil {
+			return fmt.Errorf("invalid community group id: %s", group.Id)
+		}
+		group.Id = groupId.String()
+
+		if _, ok := groupIds[group.Id]; ok {
+			return fmt.Errorf("duplicate community group id: %s", group.Id)
+		}
+		groupIds[group.Id] = struct{}{}
+
+		group.Name = strings.TrimSpace(group.Name)
+		if group.Name == "" || utf8.RuneCountInString(group.Name) > maxCommunityGroupNameLength {
+			return errors.New("invalid community group name")
+		}
+
+		if len(group.Communities) > maxCommunityGroupCommunities {
+			return errors.New("too many communities in community group")
+		}
+
+		for _, community := range group.Communities {
+			if community.Host == "" || community.Id == "" {
+				return errors.New("community group communities must have a host and an id")
+			}
+
+			if !slices.Contains(userServers, community.Host) {
+				return fmt.Errorf("community server %s isn't a server of the user", community.Host)
+			}
+
+			key := community.Host + "/" + community.Id
+			if _, ok := communities[key]; ok {
+				return fmt.Errorf("community %s is in more than one community group", key)
+			}
+			communities[key] = struct{}{}
+		}
+	}
+
+	return nil
+}
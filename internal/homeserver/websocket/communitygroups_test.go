@@ -0,0 +1,85 @@
+package websocket
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	prochatv1 "github.com/varsotech/prochat-server/internal/models/gen/prochat/v1"
+)
+
+func TestNormalizeCommunityGroups(t *testing.T) {
+	community := func(host, communityId string) *prochatv1.Community {
+		return &prochatv1.Community{Host: host, Id: communityId}
+	}
+
+	groupId := uuid.NewString()
+
+	tests := []struct {
+		name    string
+		groups  []*prochatv1.CommunityGroup
+		wantErr bool
+	}{
+		{
+			name: "valid groups",
+			groups: []*prochatv1.CommunityGroup{
+				{Id: groupId, Name: " Work ", Communities: []*prochatv1.Community{community("a.example.com", "1"), community("b.example.com", "1")}},
+				{Name: "Friends", Communities: []*prochatv1.Community{community("a.example.com", "2")}},
+			},
+		},
+		{
+			name:    "empty name",
+			groups:  []*prochatv1.CommunityGroup{{Name: " "}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid id",
+			groups:  []*prochatv1.CommunityGroup{{Id: "folder", Name: "Work"}},
+			wantErr: true,
+		},
+		{
+			name:    "duplicate id",
+			groups:  []*prochatv1.CommunityGroup{{Id: groupId, Name: "Work"}, {Id: groupId, Name: "Friends"}},
+			wantErr: true,
+		},
+		{
+			name:    "missing host",
+			groups:  []*prochatv1.CommunityGroup{{Name: "Work", Communities: []*prochatv1.Community{community("", "1")}}},
+			wantErr: true,
+		},
+		{
+			name:    "server of another user",
+			groups:  []*prochatv1.CommunityGroup{{Name: "Work", Communities: []*prochatv1.Community{community("c.example.com", "1")}}},
+			wantErr: true,
+		},
+		{
+			name: "community in two groups",
+			groups: []*prochatv1.CommunityGroup{
+				{Name: "Work", Communities: []*prochatv1.Community{community("a.example.com", "1")}},
+				{Name: "Friends", Communities: []*prochatv1.Community{community("a.example.com", "1")}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := normalizeCommunityGroups(tt.groups, []string{"a.example.com", "b.example.com"})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("normalizeCommunityGroups() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			for _, group := range tt.groups {
+				if _, err := uuid.Parse(group.Id); err != nil {
+					t.Errorf("group %q has invalid id %q", group.Name, group.Id)
+				}
+			}
+
+			if tt.groups[0].Name != "Work" {
+				t.Errorf("group name = %q, want %q", tt.groups[0].Name, "Work")
+			}
+		})
+	}
+}
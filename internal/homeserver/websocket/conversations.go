@@ -0,0 +1,383 @@
+package websocket
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/varsotech/prochat-server/internal/homeserver/oauth"
+	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
+	homeserverv1 "github.com/varsotech/prochat-server/internal/models/gen/homeserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/homeserverdb"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// conversationsTimeout bounds how long listing conversations waits for the servers hosting them
+	conversationsTimeout = 10 * time.Second
+
+	// maxConcurrentConversationRequests bounds the servers asked for conversations at once
+	maxConcurrentConversationRequests = 16
+)
+
+// GetConversations returns the direct message conversations of the user, most recently active first.
+// Conversations are hosted by the homeserver of their creator, so they are fetched in parallel from this
+// server and from every conversation host the user accepted. Each server gets an identity token addressed to
+// it only. Servers that can't be reached within conversationsTimeout are skipped.
+//
+// Conversations aren't pushed over the homeserver connection: clients list them again when a direct message
+// notification arrives, and read and send messages through the conversation routes of the hosting server.
+func (h *Handlers) GetConversations(ctx context.Context, auth *oauth.AuthorizeResult, message *homeserverv1.Message) *homeserverv1.Message {
+	var req homeserverv1.GetConversationsRequest
+	err := proto.Unmarshal(message.Payload, &req)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	conversationHosts, err := h.postgresClient.GetAcceptedUserConversationHosts(ctx, auth.UserId)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	hosts := []string{h.host}
+	for _, host := range conversationHosts {
+		if !slices.Contains(hosts, host) {
+			hosts = append(hosts, host)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, conversationsTimeout)
+	defer cancel()
+
+	hostConversations := make([][]*homeserverv1.Conversation, len(hosts))
+	var group errgroup.Group
+	group.SetLimit(maxConcurrentConversationRequests)
+	for i, host := range hosts {
+		group.Go(func() error {
+			identityJwt, err := h.signIdentityToken(auth.UserId, host)
+			if err != nil {
+				slog.Info("failed to sign identity token", "error", err, "host", host)
+				return nil
+			}
+
+			var resp communityserverv1.GetConversationsResponse
+			err = h.conversationRequest(ctx, identityJwt, host, "GET", "/api/v1/community/server/conversations", nil, &resp)
+			if err != nil {
+				slog.Info("failed to get conversations", "error", err, "host", host)
+				return nil
+			}
+
+			for _, conversation := range resp.Conversations {
+				hostConversations[i] = append(hostConversations[i], conversationToProto(host, conversation))
+			}
+			return nil
+		})
+	}
+	_ = group.Wait()
+
+	conversations := []*homeserverv1.Conversation{}
+	for _, c := range hostConversations {
+		conversations = append(conversations, c...)
+	}
+
+	slices.SortStableFunc(conversations, func(a, b *homeserverv1.Conversation) int {
+		return conversationActivity(b).Compare(conversationActivity(a))
+	})
+
+	payload, err := proto.Marshal(&homeserverv1.GetConversationsResponse{
+		Conversations: conversations,
+	})
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	return &homeserverv1.Message{
+		Payload: payload,
+	}
+}
+
+// GetConversationHosts returns the servers hosting conversations of the user, and whether the user accepted
+// or blocked them. Servers the user hasn't decided on yet are pending.
+func (h *Handlers) GetConversationHosts(ctx context.Context, auth *oauth.AuthorizeResult, message *homeserverv1.Message) *homeserverv1.Message {
+	conversationHosts, err := h.postgresClient.GetUserConversationHosts(ctx, auth.UserId)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	hosts := make([]*homeserverv1.ConversationHost, 0, len(conversationHosts))
+	for _, conversationHost := range conversationHosts {
+		hosts = append(hosts, &homeserverv1.ConversationHost{
+			Host:      conversationHost.Host,
+			Status:    homeserverv1.ConversationHost_Status(conversationHost.Status),
+			CreatedAt: conversationHost.CreatedAt.Time.UTC().Format(time.RFC3339Nano),
+		})
+	}
+
+	payload, err := proto.Marshal(&homeserverv1.GetConversationHostsResponse{
+		Hosts: hosts,
+	})
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	return &homeserverv1.Message{
+		Payload: payload,
+	}
+}
+
+// SetConversationHostStatus accepts or blocks a conversation host of the user. Conversations are only listed
+// from accepted hosts, and only accepted hosts may notify the user of direct messages.
+func (h *Handlers) SetConversationHostStatus(ctx context.Context, auth *oauth.AuthorizeResult, message *homeserverv1.Message) *homeserverv1.Message {
+	var req homeserverv1.SetConversationHostStatusRequest
+	err := proto.Unmarshal(message.Payload, &req)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	if req.Status != homeserverv1.ConversationHost_STATUS_ACCEPTED && req.Status != homeserverv1.ConversationHost_STATUS_BLOCKED {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: "status must be accepted or blocked",
+			},
+		}
+	}
+
+	updated, err := h.postgresClient.UpdateUserConversationHostStatus(ctx, homeserverdb.UpdateUserConversationHostStatusParams{
+		UserID: auth.UserId,
+		Host:   req.Host,
+		Status: int16(req.Status),
+	})
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	if updated == 0 {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: "unknown conversation host",
+			},
+		}
+	}
+
+	payload, err := proto.Marshal(&homeserverv1.SetConversationHostStatusResponse{})
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	return &homeserverv1.Message{
+		Payload: payload,
+	}
+}
+
+// RemoveConversationHost forgets a conversation host of the user. The host becomes pending again if it later
+// notifies the user of a direct message, so blocking is the way to keep a host out for good.
+func (h *Handlers) RemoveConversationHost(ctx context.Context, auth *oauth.AuthorizeResult, message *homeserverv1.Message) *homeserverv1.Message {
+	var req homeserverv1.RemoveConversationHostRequest
+	err := proto.Unmarshal(message.Payload, &req)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	removed, err := h.postgresClient.DeleteUserConversationHost(ctx, homeserverdb.DeleteUserConversationHostParams{
+		UserID: auth.UserId,
+		Host:   req.Host,
+	})
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	if removed == 0 {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: "unknown conversation host",
+			},
+		}
+	}
+
+	payload, err := proto.Marshal(&homeserverv1.RemoveConversationHostResponse{})
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	return &homeserverv1.Message{
+		Payload: payload,
+	}
+}
+
+// CreateConversation creates a direct message conversation between the user and the given users, hosted by
+// this server.
+func (h *Handlers) CreateConversation(ctx context.Context, auth *oauth.AuthorizeResult, message *homeserverv1.Message) *homeserverv1.Message {
+	var req homeserverv1.CreateConversationRequest
+	err := proto.Unmarshal(message.Payload, &req)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	identityJwt, err := h.signIdentityToken(auth.UserId, h.host)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	var resp communityserverv1.CreateConversationResponse
+	err = h.conversationRequest(ctx, identityJwt, h.host, "POST", "/api/v1/community/server/conversations", &communityserverv1.CreateConversationRequest{
+		ParticipantAddresses: req.ParticipantAddresses,
+	}, &resp)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	payload, err := proto.Marshal(&homeserverv1.CreateConversationResponse{
+		Conversation: conversationToProto(h.host, resp.Conversation),
+	})
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	return &homeserverv1.Message{
+		Payload: payload,
+	}
+}
+
+// conversationRequest sends a request to the conversation routes of the server at host on behalf of the user,
+// and unmarshals its response into resp. A nil reqProto sends no body.
+func (h *Handlers) conversationRequest(ctx context.Context, identityJWT string, server string, method string, path string, reqProto proto.Message, resp proto.Message) error {
+	if !strings.HasPrefix(server, "http://") && !strings.HasPrefix(server, "https://") {
+		server = "https://" + server
+	}
+
+	u, err := url.Parse(server)
+	if err != nil {
+		return fmt.Errorf("invalid server url: %s", server)
+	}
+
+	var reqBody io.Reader
+	if reqProto != nil {
+		reqBytes, err := protojson.Marshal(reqProto)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request: %w", err)
+		}
+
+		reqBody = bytes.NewReader(reqBytes)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.JoinPath(path).String(), reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Add("Authorization", "Bearer "+identityJWT)
+
+	httpResp, err := h.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute conversation request: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return fmt.Errorf("server returned bad status: %d", httpResp.StatusCode)
+	}
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(body, resp)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	return nil
+}
+
+func conversationToProto(host string, conversation *communityserverv1.Conversation) *homeserverv1.Conversation {
+	return &homeserverv1.Conversation{
+		Id:                   conversation.GetId(),
+		Host:                 host,
+		ParticipantAddresses: conversation.GetParticipantAddresses(),
+		CreatedBy:            conversation.GetCreatedBy(),
+		CreatedAt:            conversation.GetCreatedAt(),
+		LastMessageAt:        conversation.GetLastMessageAt(),
+	}
+}
+
+// conversationActivity returns the time of the last message of a conversation, or its creation time if it has
+// no messages.
+func conversationActivity(conversation *homeserverv1.Conversation) time.Time {
+	activity := conversation.LastMessageAt
+	if activity == "" {
+		activity = conversation.CreatedAt
+	}
+
+	t, _ := time.Parse(time.RFC3339Nano, activity)
+	return t
+}
@@ -14,6 +14,7 @@ type handlerFunc = func(context.Context, *oauth.AuthorizeResult, *homeserverv1.M
 
 type Handlers struct {
 	httpClient         *httputil.Client
+	pool               *pgxpool.Pool
 	postgresClient     *homeserverdb.Queries
 	host               string
 	identityPrivateKey string
@@ -23,16 +24,27 @@ type Handlers struct {
 func New(postgresClient *pgxpool.Pool, host, identityPrivateKey string) *Handlers {
 	h := Handlers{
 		httpClient:         httputil.NewClient(),
+		pool:               postgresClient,
 		postgresClient:     homeserverdb.New(postgresClient),
 		host:               host,
 		identityPrivateKey: identityPrivateKey,
 	}
 
 	h.handlerMap = map[homeserverv1.Message_Type]handlerFunc{
-		homeserverv1.Message_TYPE_ADD_USER_SERVER:       h.AddUserServerRequest,
-		homeserverv1.Message_TYPE_GET_USER_COMMUNITIES:  h.GetUserCommunitiesRequest,
-		homeserverv1.Message_TYPE_GET_IDENTITY_TOKEN:    h.GetIdentityToken,
-		homeserverv1.Message_TYPE_JOIN_COMMUNITY_SERVER: h.JoinCommunityServer,
+		homeserverv1.Message_TYPE_ADD_USER_SERVER:              h.AddUserServerRequest,
+		homeserverv1.Message_TYPE_GET_USER_COMMUNITIES:         h.GetUserCommunitiesRequest,
+		homeserverv1.Message_TYPE_GET_IDENTITY_TOKEN:           h.GetIdentityToken,
+		homeserverv1.Message_TYPE_JOIN_COMMUNITY_SERVER:        h.JoinCommunityServer,
+		homeserverv1.Message_TYPE_LEAVE_COMMUNITY_SERVER:       h.LeaveCommunityServer,
+		homeserverv1.Message_TYPE_GET_COMMUNITY_GROUPS:         h.GetCommunityGroups,
+		homeserverv1.Message_TYPE_SET_COMMUNITY_GROUPS:         h.SetCommunityGroups,
+		homeserverv1.Message_TYPE_GET_NOTIFICATIONS:            h.GetNotifications,
+		homeserverv1.Message_TYPE_ACK_NOTIFICATIONS:            h.AckNotifications,
+		homeserverv1.Message_TYPE_GET_CONVERSATIONS:            h.GetConversations,
+		homeserverv1.Message_TYPE_CREATE_CONVERSATION:          h.CreateConversation,
+		homeserverv1.Message_TYPE_GET_CONVERSATION_HOSTS:       h.GetConversationHosts,
+		homeserverv1.Message_TYPE_SET_CONVERSATION_HOST_STATUS: h.SetConversationHostStatus,
+		homeserverv1.Message_TYPE_REMOVE_CONVERSATION_HOST:     h.RemoveConversationHost,
 	}
 
 	return &h
@@ -3,16 +3,34 @@ package websocket
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/varsotech/prochat-server/internal/homeserver/identity"
 	"github.com/varsotech/prochat-server/internal/homeserver/oauth"
 	homeserverv1 "github.com/varsotech/prochat-server/internal/models/gen/homeserver/v1"
 	"google.golang.org/protobuf/proto"
 )
 
+// GetIdentityToken returns an identity token of the user, addressed to the server at the requested host.
 func (h *Handlers) GetIdentityToken(ctx context.Context, auth *oauth.AuthorizeResult, message *homeserverv1.Message) *homeserverv1.Message {
-	claims := identity.NewClaims(h.host, auth.UserId)
+	var req homeserverv1.GetIdentityTokenRequest
+	err := proto.Unmarshal(message.Payload, &req)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	if req.Host == "" {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: "host is required, identity tokens are addressed to the server they are used with",
+			},
+		}
+	}
 
-	token, err := claims.Sign(h.identityPrivateKey)
+	token, err := h.signIdentityToken(auth.UserId, req.Host)
 	if err != nil {
 		return &homeserverv1.Message{
 			Error: &homeserverv1.Message_Error{
@@ -36,3 +54,13 @@ func (h *Handlers) GetIdentityToken(ctx context.Context, auth *oauth.AuthorizeRe
 		Payload: payload,
 	}
 }
+
+// signIdentityToken signs an identity token of the user, which only the server at host accepts.
+func (h *Handlers) signIdentityToken(userId uuid.UUID, host string) (string, error) {
+	audience, err := identity.Audience(host)
+	if err != nil {
+		return "", err
+	}
+
+	return identity.NewClaims(h.host, audience, userId).Sign(h.identityPrivateKey)
+}
@@ -0,0 +1,207 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/varsotech/prochat-server/internal/homeserver/oauth"
+	homeserverv1 "github.com/varsotech/prochat-server/internal/models/gen/homeserver/v1"
+	"github.com/varsotech/prochat-server/internal/pkg/homeserverdb"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultNotificationsLimit = 50
+	maxNotificationsLimit     = 100
+	maxAckNotificationIds     = 100
+)
+
+// GetNotifications returns the notifications in the inbox of the user, newest first. Older notifications are
+// paginated by passing the id of the oldest notification received as before.
+func (h *Handlers) GetNotifications(ctx context.Context, auth *oauth.AuthorizeResult, message *homeserverv1.Message) *homeserverv1.Message {
+	var req homeserverv1.GetNotificationsRequest
+	err := proto.Unmarshal(message.Payload, &req)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	notifications, err := h.getNotifications(ctx, auth.UserId, req.Before, req.Limit)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	unreadCount, err := h.postgresClient.CountUnreadNotifications(ctx, auth.UserId)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	notificationsProto := []*homeserverv1.Notification{}
+	for _, notification := range notifications {
+		notificationsProto = append(notificationsProto, notificationToProto(notification))
+	}
+
+	payload, err := proto.Marshal(&homeserverv1.GetNotificationsResponse{
+		Notifications: notificationsProto,
+		UnreadCount:   unreadCount,
+	})
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	return &homeserverv1.Message{
+		Payload: payload,
+	}
+}
+
+// AckNotifications marks notifications of the user as read, or every notification if all is set.
+func (h *Handlers) AckNotifications(ctx context.Context, auth *oauth.AuthorizeResult, message *homeserverv1.Message) *homeserverv1.Message {
+	var req homeserverv1.AckNotificationsRequest
+	err := proto.Unmarshal(message.Payload, &req)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	err = h.ackNotifications(ctx, auth.UserId, req.NotificationIds, req.All)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	unreadCount, err := h.postgresClient.CountUnreadNotifications(ctx, auth.UserId)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	payload, err := proto.Marshal(&homeserverv1.AckNotificationsResponse{
+		UnreadCount: unreadCount,
+	})
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	return &homeserverv1.Message{
+		Payload: payload,
+	}
+}
+
+func (h *Handlers) getNotifications(ctx context.Context, userId uuid.UUID, before string, limit int32) ([]homeserverdb.Notification, error) {
+	if limit <= 0 {
+		limit = defaultNotificationsLimit
+	}
+	limit = min(limit, maxNotificationsLimit)
+
+	if before == "" {
+		notifications, err := h.postgresClient.GetLatestNotifications(ctx, homeserverdb.GetLatestNotificationsParams{
+			UserID: userId,
+			Limit:  limit,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get notifications: %w", err)
+		}
+
+		return notifications, nil
+	}
+
+	beforeId, err := uuid.Parse(before)
+	if err != nil {
+		return nil, fmt.Errorf("invalid notification id: %s", before)
+	}
+
+	notifications, err := h.postgresClient.GetNotificationsBefore(ctx, homeserverdb.GetNotificationsBeforeParams{
+		Before:     beforeId,
+		UserID:     userId,
+		MaxResults: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notifications: %w", err)
+	}
+
+	return notifications, nil
+}
+
+func (h *Handlers) ackNotifications(ctx context.Context, userId uuid.UUID, notificationIds []string, all bool) error {
+	if all {
+		err := h.postgresClient.MarkAllNotificationsRead(ctx, userId)
+		if err != nil {
+			return fmt.Errorf("failed to mark notifications as read: %w", err)
+		}
+
+		return nil
+	}
+
+	if len(notificationIds) == 0 || len(notificationIds) > maxAckNotificationIds {
+		return errors.New("invalid amount of notification ids")
+	}
+
+	ids := make([]uuid.UUID, 0, len(notificationIds))
+	for _, notificationId := range notificationIds {
+		id, err := uuid.Parse(notificationId)
+		if err != nil {
+			return fmt.Errorf("invalid notification id: %s", notificationId)
+		}
+
+		ids = append(ids, id)
+	}
+
+	err := h.postgresClient.MarkNotificationsRead(ctx, homeserverdb.MarkNotificationsReadParams{
+		UserID: userId,
+		Ids:    ids,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark notifications as read: %w", err)
+	}
+
+	return nil
+}
+
+func notificationToProto(notification homeserverdb.Notification) *homeserverv1.Notification {
+	return &homeserverv1.Notification{
+		Id:             notification.ID.String(),
+		Type:           homeserverv1.Notification_Type(notification.Type),
+		Host:           notification.Host,
+		CommunityId:    notification.CommunityID,
+		CommunityName:  notification.CommunityName,
+		ChannelId:      notification.ChannelID,
+		ChannelName:    notification.ChannelName,
+		MessageId:      notification.MessageID,
+		AuthorAddress:  notification.AuthorAddress,
+		Body:           notification.Body,
+		ConversationId: notification.ConversationID,
+		CreatedAt:      notification.CreatedAt.Time.UTC().Format(time.RFC3339Nano),
+		Read:           notification.ReadAt.Valid,
+	}
+}
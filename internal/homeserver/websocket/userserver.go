@@ -5,11 +5,11 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
 
-	"github.com/varsotech/prochat-server/internal/homeserver/identity"
 	"github.com/varsotech/prochat-server/internal/homeserver/oauth"
 	communityserverv1 "github.com/varsotech/prochat-server/internal/models/gen/communityserver/v1"
 	homeserverv1 "github.com/varsotech/prochat-server/internal/models/gen/homeserver/v1"
@@ -77,19 +77,18 @@ func (h *Handlers) GetUserCommunitiesRequest(ctx context.Context, auth *oauth.Au
 		}
 	}
 
-	identityClaims := identity.NewClaims(h.host, auth.UserId)
-
-	identityJwt, err := identityClaims.Sign(h.identityPrivateKey)
-	if err != nil {
-		return &homeserverv1.Message{
-			Error: &homeserverv1.Message_Error{
-				Message: err.Error(),
-			},
-		}
-	}
-
 	var userCommunities []*homeserverv1.GetUserCommunitiesResponse_Community
+	var unreadCount, mentionCount int64
 	for _, server := range userServers {
+		identityJwt, err := h.signIdentityToken(auth.UserId, server)
+		if err != nil {
+			return &homeserverv1.Message{
+				Error: &homeserverv1.Message_Error{
+					Message: err.Error(),
+				},
+			}
+		}
+
 		communities, err := h.getUserCommunitiesForServer(ctx, identityJwt, server)
 		if err != nil {
 			return &homeserverv1.Message{
@@ -101,14 +100,23 @@ func (h *Handlers) GetUserCommunitiesRequest(ctx context.Context, auth *oauth.Au
 
 		for _, community := range communities.Communities {
 			userCommunities = append(userCommunities, &homeserverv1.GetUserCommunitiesResponse_Community{
-				Id:   community.Id,
-				Name: community.Name,
+				Id:           community.Id,
+				Name:         community.Name,
+				Online:       community.Online,
+				Host:         server,
+				UnreadCount:  community.UnreadCount,
+				MentionCount: community.MentionCount,
 			})
+
+			unreadCount += community.UnreadCount
+			mentionCount += community.MentionCount
 		}
 	}
 
 	payload, err := proto.Marshal(&homeserverv1.GetUserCommunitiesResponse{
-		Communities: userCommunities,
+		Communities:  userCommunities,
+		UnreadCount:  unreadCount,
+		MentionCount: mentionCount,
 	})
 	if err != nil {
 		return &homeserverv1.Message{
@@ -175,9 +183,7 @@ func (h *Handlers) JoinCommunityServer(ctx context.Context, auth *oauth.Authoriz
 		}
 	}
 
-	identityClaims := identity.NewClaims(h.host, auth.UserId)
-
-	identityJwt, err := identityClaims.Sign(h.identityPrivateKey)
+	identityJwt, err := h.signIdentityToken(auth.UserId, req.Host)
 	if err != nil {
 		return &homeserverv1.Message{
 			Error: &homeserverv1.Message_Error{
@@ -186,7 +192,7 @@ func (h *Handlers) JoinCommunityServer(ctx context.Context, auth *oauth.Authoriz
 		}
 	}
 
-	_, err = h.joinCommunityServer(ctx, identityJwt, req.Host, req.JoinDefaultCommunity)
+	_, err = h.joinCommunityServer(ctx, identityJwt, req.Host, req.JoinDefaultCommunity, req.InviteCode)
 	if err != nil {
 		return &homeserverv1.Message{
 			Error: &homeserverv1.Message_Error{
@@ -209,7 +215,7 @@ func (h *Handlers) JoinCommunityServer(ctx context.Context, auth *oauth.Authoriz
 	}
 }
 
-func (h *Handlers) joinCommunityServer(ctx context.Context, identityJWT string, server string, joinDefaultCommunity bool) (*communityserverv1.JoinServerResponse, error) {
+func (h *Handlers) joinCommunityServer(ctx context.Context, identityJWT string, server string, joinDefaultCommunity bool, inviteCode string) (*communityserverv1.JoinServerResponse, error) {
 	if !strings.HasPrefix(server, "http://") && !strings.HasPrefix(server, "https://") {
 		server = "https://" + server
 	}
@@ -221,6 +227,7 @@ func (h *Handlers) joinCommunityServer(ctx context.Context, identityJWT string,
 
 	reqProto := communityserverv1.JoinServerRequest{
 		JoinDefaultCommunity: joinDefaultCommunity,
+		InviteCode:           inviteCode,
 	}
 
 	reqBytes, err := protojson.Marshal(&reqProto)
@@ -258,3 +265,113 @@ func (h *Handlers) joinCommunityServer(ctx context.Context, identityJWT string,
 
 	return &protoResp, nil
 }
+
+// LeaveCommunityServer leaves every community of a community server, and removes the server from the
+// servers of the user. Servers that can't be reached or no longer know the user are removed regardless, so
+// servers that are gone can be removed from the list.
+func (h *Handlers) LeaveCommunityServer(ctx context.Context, auth *oauth.AuthorizeResult, message *homeserverv1.Message) *homeserverv1.Message {
+	var req homeserverv1.LeaveCommunityServerRequest
+	err := proto.Unmarshal(message.Payload, &req)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	identityJwt, err := h.signIdentityToken(auth.UserId, req.Host)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	err = h.leaveCommunityServer(ctx, identityJwt, req.Host)
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	_, err = h.postgresClient.DeleteUserServer(ctx, homeserverdb.DeleteUserServerParams{
+		UserID: auth.UserId,
+		Host:   req.Host,
+	})
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	// Communities of the server are no longer shown, so they are removed from the groups of the user
+	err = h.postgresClient.DeleteHostCommunityGroupEntries(ctx, homeserverdb.DeleteHostCommunityGroupEntriesParams{
+		UserID: auth.UserId,
+		Host:   req.Host,
+	})
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	payload, err := proto.Marshal(&homeserverv1.LeaveCommunityServerResponse{})
+	if err != nil {
+		return &homeserverv1.Message{
+			Error: &homeserverv1.Message_Error{
+				Message: err.Error(),
+			},
+		}
+	}
+
+	return &homeserverv1.Message{
+		Payload: payload,
+	}
+}
+
+// leaveCommunityServer leaves every community of a community server. Only errors returned by the server are
+// reported, since servers that can't be reached can't keep the user as a member.
+func (h *Handlers) leaveCommunityServer(ctx context.Context, identityJWT string, server string) error {
+	if !strings.HasPrefix(server, "http://") && !strings.HasPrefix(server, "https://") {
+		server = "https://" + server
+	}
+
+	u, err := url.Parse(server)
+	if err != nil {
+		slog.Info("invalid community server url", "error", err, "server", server)
+		return nil
+	}
+
+	reqBytes, err := protojson.Marshal(&communityserverv1.LeaveServerRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", u.JoinPath("/api/v1/community/server/leave").String(), bytes.NewReader(reqBytes))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Add("Authorization", "Bearer "+identityJWT)
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		slog.Info("failed to execute leave server request", "error", err, "server", server)
+		return nil
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("server returned bad status: %d", resp.StatusCode)
+	}
+
+	return nil
+}
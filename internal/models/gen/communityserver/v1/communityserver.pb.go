@@ -21,6 +21,526 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Permission int32
+
+const (
+	Permission_PERMISSION_UNSPECIFIED      Permission = 0
+	Permission_PERMISSION_MANAGE_CHANNELS  Permission = 1
+	Permission_PERMISSION_MANAGE_MESSAGES  Permission = 2
+	Permission_PERMISSION_KICK_MEMBERS     Permission = 4
+	Permission_PERMISSION_BAN_MEMBERS      Permission = 8
+	Permission_PERMISSION_MANAGE_ROLES     Permission = 16
+	Permission_PERMISSION_ADMINISTRATOR    Permission = 32
+	Permission_PERMISSION_VIEW_CHANNEL     Permission = 64
+	Permission_PERMISSION_SEND_MESSAGES    Permission = 128
+	Permission_PERMISSION_MANAGE_INVITES   Permission = 256
+	Permission_PERMISSION_MUTE_MEMBERS     Permission = 512
+	Permission_PERMISSION_VIEW_AUDIT_LOG   Permission = 1024
+	Permission_PERMISSION_MANAGE_COMMUNITY Permission = 2048
+	Permission_PERMISSION_MANAGE_EMOJIS    Permission = 4096
+	Permission_PERMISSION_MENTION_EVERYONE Permission = 8192
+)
+
+// Enum value maps for Permission.
+var (
+	Permission_name = map[int32]string{
+		0:    "PERMISSION_UNSPECIFIED",
+		1:    "PERMISSION_MANAGE_CHANNELS",
+		2:    "PERMISSION_MANAGE_MESSAGES",
+		4:    "PERMISSION_KICK_MEMBERS",
+		8:    "PERMISSION_BAN_MEMBERS",
+		16:   "PERMISSION_MANAGE_ROLES",
+		32:   "PERMISSION_ADMINISTRATOR",
+		64:   "PERMISSION_VIEW_CHANNEL",
+		128:  "PERMISSION_SEND_MESSAGES",
+		256:  "PERMISSION_MANAGE_INVITES",
+		512:  "PERMISSION_MUTE_MEMBERS",
+		1024: "PERMISSION_VIEW_AUDIT_LOG",
+		2048: "PERMISSION_MANAGE_COMMUNITY",
+		4096: "PERMISSION_MANAGE_EMOJIS",
+		8192: "PERMISSION_MENTION_EVERYONE",
+	}
+	Permission_value = map[string]int32{
+		"PERMISSION_UNSPECIFIED":      0,
+		"PERMISSION_MANAGE_CHANNELS":  1,
+		"PERMISSION_MANAGE_MESSAGES":  2,
+		"PERMISSION_KICK_MEMBERS":     4,
+		"PERMISSION_BAN_MEMBERS":      8,
+		"PERMISSION_MANAGE_ROLES":     16,
+		"PERMISSION_ADMINISTRATOR":    32,
+		"PERMISSION_VIEW_CHANNEL":     64,
+		"PERMISSION_SEND_MESSAGES":    128,
+		"PERMISSION_MANAGE_INVITES":   256,
+		"PERMISSION_MUTE_MEMBERS":     512,
+		"PERMISSION_VIEW_AUDIT_LOG":   1024,
+		"PERMISSION_MANAGE_COMMUNITY": 2048,
+		"PERMISSION_MANAGE_EMOJIS":    4096,
+		"PERMISSION_MENTION_EVERYONE": 8192,
+	}
+)
+
+func (x Permission) Enum() *Permission {
+	p := new(Permission)
+	*p = x
+	return p
+}
+
+func (x Permission) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Permission) Descriptor() protoreflect.EnumDescriptor {
+	return file_communityserver_v1_communityserver_proto_enumTypes[0].Descriptor()
+}
+
+func (Permission) Type() protoreflect.EnumType {
+	return &file_communityserver_v1_communityserver_proto_enumTypes[0]
+}
+
+func (x Permission) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Permission.Descriptor instead.
+func (Permission) EnumDescriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{0}
+}
+
+type PresenceStatus int32
+
+const (
+	PresenceStatus_PRESENCE_STATUS_UNSPECIFIED    PresenceStatus = 0
+	PresenceStatus_PRESENCE_STATUS_ONLINE         PresenceStatus = 1
+	PresenceStatus_PRESENCE_STATUS_IDLE           PresenceStatus = 2
+	PresenceStatus_PRESENCE_STATUS_DO_NOT_DISTURB PresenceStatus = 3
+	PresenceStatus_PRESENCE_STATUS_OFFLINE        PresenceStatus = 4
+)
+
+// Enum value maps for PresenceStatus.
+var (
+	PresenceStatus_name = map[int32]string{
+		0: "PRESENCE_STATUS_UNSPECIFIED",
+		1: "PRESENCE_STATUS_ONLINE",
+		2: "PRESENCE_STATUS_IDLE",
+		3: "PRESENCE_STATUS_DO_NOT_DISTURB",
+		4: "PRESENCE_STATUS_OFFLINE",
+	}
+	PresenceStatus_value = map[string]int32{
+		"PRESENCE_STATUS_UNSPECIFIED":    0,
+		"PRESENCE_STATUS_ONLINE":         1,
+		"PRESENCE_STATUS_IDLE":           2,
+		"PRESENCE_STATUS_DO_NOT_DISTURB": 3,
+		"PRESENCE_STATUS_OFFLINE":        4,
+	}
+)
+
+func (x PresenceStatus) Enum() *PresenceStatus {
+	p := new(PresenceStatus)
+	*p = x
+	return p
+}
+
+func (x PresenceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PresenceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_communityserver_v1_communityserver_proto_enumTypes[1].Descriptor()
+}
+
+func (PresenceStatus) Type() protoreflect.EnumType {
+	return &file_communityserver_v1_communityserver_proto_enumTypes[1]
+}
+
+func (x PresenceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PresenceStatus.Descriptor instead.
+func (PresenceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{1}
+}
+
+type Event_Type int32
+
+const (
+	Event_TYPE_UNSPECIFIED          Event_Type = 0
+	Event_TYPE_MESSAGE_CREATED      Event_Type = 1
+	Event_TYPE_MEMBER_JOINED        Event_Type = 2
+	Event_TYPE_CHANNEL_CREATED      Event_Type = 3
+	Event_TYPE_CHANNEL_UPDATED      Event_Type = 4
+	Event_TYPE_CHANNEL_DELETED      Event_Type = 5
+	Event_TYPE_MEMBER_REMOVED       Event_Type = 6
+	Event_TYPE_MEMBER_MUTED         Event_Type = 7
+	Event_TYPE_COMMUNITY_UPDATED    Event_Type = 8
+	Event_TYPE_COMMUNITY_DELETED    Event_Type = 9
+	Event_TYPE_PRESENCE_UPDATED     Event_Type = 10
+	Event_TYPE_TYPING_STARTED       Event_Type = 11
+	Event_TYPE_MESSAGE_UPDATED      Event_Type = 12
+	Event_TYPE_MESSAGE_DELETED      Event_Type = 13
+	Event_TYPE_REACTION_ADDED       Event_Type = 14
+	Event_TYPE_REACTION_REMOVED     Event_Type = 15
+	Event_TYPE_CUSTOM_EMOJI_CREATED Event_Type = 16
+	Event_TYPE_CUSTOM_EMOJI_DELETED Event_Type = 17
+	Event_TYPE_MESSAGE_PINNED       Event_Type = 18
+	Event_TYPE_MESSAGE_UNPINNED     Event_Type = 19
+	Event_TYPE_ROLE_CREATED         Event_Type = 20
+	Event_TYPE_ROLE_UPDATED         Event_Type = 21
+	Event_TYPE_ROLE_DELETED         Event_Type = 22
+	Event_TYPE_MEMBER_ROLE_ADDED    Event_Type = 23
+	Event_TYPE_MEMBER_ROLE_REMOVED  Event_Type = 24
+)
+
+// Enum value maps for Event_Type.
+var (
+	Event_Type_name = map[int32]string{
+		0:  "TYPE_UNSPECIFIED",
+		1:  "TYPE_MESSAGE_CREATED",
+		2:  "TYPE_MEMBER_JOINED",
+		3:  "TYPE_CHANNEL_CREATED",
+		4:  "TYPE_CHANNEL_UPDATED",
+		5:  "TYPE_CHANNEL_DELETED",
+		6:  "TYPE_MEMBER_REMOVED",
+		7:  "TYPE_MEMBER_MUTED",
+		8:  "TYPE_COMMUNITY_UPDATED",
+		9:  "TYPE_COMMUNITY_DELETED",
+		10: "TYPE_PRESENCE_UPDATED",
+		11: "TYPE_TYPING_STARTED",
+		12: "TYPE_MESSAGE_UPDATED",
+		13: "TYPE_MESSAGE_DELETED",
+		14: "TYPE_REACTION_ADDED",
+		15: "TYPE_REACTION_REMOVED",
+		16: "TYPE_CUSTOM_EMOJI_CREATED",
+		17: "TYPE_CUSTOM_EMOJI_DELETED",
+		18: "TYPE_MESSAGE_PINNED",
+		19: "TYPE_MESSAGE_UNPINNED",
+		20: "TYPE_ROLE_CREATED",
+		21: "TYPE_ROLE_UPDATED",
+		22: "TYPE_ROLE_DELETED",
+		23: "TYPE_MEMBER_ROLE_ADDED",
+		24: "TYPE_MEMBER_ROLE_REMOVED",
+	}
+	Event_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":          0,
+		"TYPE_MESSAGE_CREATED":      1,
+		"TYPE_MEMBER_JOINED":        2,
+		"TYPE_CHANNEL_CREATED":      3,
+		"TYPE_CHANNEL_UPDATED":      4,
+		"TYPE_CHANNEL_DELETED":      5,
+		"TYPE_MEMBER_REMOVED":       6,
+		"TYPE_MEMBER_MUTED":         7,
+		"TYPE_COMMUNITY_UPDATED":    8,
+		"TYPE_COMMUNITY_DELETED":    9,
+		"TYPE_PRESENCE_UPDATED":     10,
+		"TYPE_TYPING_STARTED":       11,
+		"TYPE_MESSAGE_UPDATED":      12,
+		"TYPE_MESSAGE_DELETED":      13,
+		"TYPE_REACTION_ADDED":       14,
+		"TYPE_REACTION_REMOVED":     15,
+		"TYPE_CUSTOM_EMOJI_CREATED": 16,
+		"TYPE_CUSTOM_EMOJI_DELETED": 17,
+		"TYPE_MESSAGE_PINNED":       18,
+		"TYPE_MESSAGE_UNPINNED":     19,
+		"TYPE_ROLE_CREATED":         20,
+		"TYPE_ROLE_UPDATED":         21,
+		"TYPE_ROLE_DELETED":         22,
+		"TYPE_MEMBER_ROLE_ADDED":    23,
+		"TYPE_MEMBER_ROLE_REMOVED":  24,
+	}
+)
+
+func (x Event_Type) Enum() *Event_Type {
+	p := new(Event_Type)
+	*p = x
+	return p
+}
+
+func (x Event_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Event_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_communityserver_v1_communityserver_proto_enumTypes[2].Descriptor()
+}
+
+func (Event_Type) Type() protoreflect.EnumType {
+	return &file_communityserver_v1_communityserver_proto_enumTypes[2]
+}
+
+func (x Event_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Event_Type.Descriptor instead.
+func (Event_Type) EnumDescriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{22, 0}
+}
+
+type PermissionOverwrite_TargetType int32
+
+const (
+	PermissionOverwrite_TARGET_TYPE_UNSPECIFIED PermissionOverwrite_TargetType = 0
+	PermissionOverwrite_TARGET_TYPE_EVERYONE    PermissionOverwrite_TargetType = 1
+	PermissionOverwrite_TARGET_TYPE_ROLE        PermissionOverwrite_TargetType = 2
+	PermissionOverwrite_TARGET_TYPE_MEMBER      PermissionOverwrite_TargetType = 3
+)
+
+// Enum value maps for PermissionOverwrite_TargetType.
+var (
+	PermissionOverwrite_TargetType_name = map[int32]string{
+		0: "TARGET_TYPE_UNSPECIFIED",
+		1: "TARGET_TYPE_EVERYONE",
+		2: "TARGET_TYPE_ROLE",
+		3: "TARGET_TYPE_MEMBER",
+	}
+	PermissionOverwrite_TargetType_value = map[string]int32{
+		"TARGET_TYPE_UNSPECIFIED": 0,
+		"TARGET_TYPE_EVERYONE":    1,
+		"TARGET_TYPE_ROLE":        2,
+		"TARGET_TYPE_MEMBER":      3,
+	}
+)
+
+func (x PermissionOverwrite_TargetType) Enum() *PermissionOverwrite_TargetType {
+	p := new(PermissionOverwrite_TargetType)
+	*p = x
+	return p
+}
+
+func (x PermissionOverwrite_TargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PermissionOverwrite_TargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_communityserver_v1_communityserver_proto_enumTypes[3].Descriptor()
+}
+
+func (PermissionOverwrite_TargetType) Type() protoreflect.EnumType {
+	return &file_communityserver_v1_communityserver_proto_enumTypes[3]
+}
+
+func (x PermissionOverwrite_TargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PermissionOverwrite_TargetType.Descriptor instead.
+func (PermissionOverwrite_TargetType) EnumDescriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{48, 0}
+}
+
+type AuditLogEntry_Action int32
+
+const (
+	AuditLogEntry_ACTION_UNSPECIFIED                 AuditLogEntry_Action = 0
+	AuditLogEntry_ACTION_CHANNEL_CREATE              AuditLogEntry_Action = 1
+	AuditLogEntry_ACTION_CHANNEL_UPDATE              AuditLogEntry_Action = 2
+	AuditLogEntry_ACTION_CHANNEL_DELETE              AuditLogEntry_Action = 3
+	AuditLogEntry_ACTION_CHANNEL_OVERWRITE_UPDATE    AuditLogEntry_Action = 4
+	AuditLogEntry_ACTION_ROLE_CREATE                 AuditLogEntry_Action = 5
+	AuditLogEntry_ACTION_ROLE_UPDATE                 AuditLogEntry_Action = 6
+	AuditLogEntry_ACTION_ROLE_DELETE                 AuditLogEntry_Action = 7
+	AuditLogEntry_ACTION_MEMBER_ROLE_ADD             AuditLogEntry_Action = 8
+	AuditLogEntry_ACTION_MEMBER_ROLE_REMOVE          AuditLogEntry_Action = 9
+	AuditLogEntry_ACTION_MEMBER_KICK                 AuditLogEntry_Action = 10
+	AuditLogEntry_ACTION_MEMBER_MUTE                 AuditLogEntry_Action = 11
+	AuditLogEntry_ACTION_MEMBER_UNMUTE               AuditLogEntry_Action = 12
+	AuditLogEntry_ACTION_BAN_CREATE                  AuditLogEntry_Action = 13
+	AuditLogEntry_ACTION_BAN_DELETE                  AuditLogEntry_Action = 14
+	AuditLogEntry_ACTION_INVITE_REVOKE               AuditLogEntry_Action = 15
+	AuditLogEntry_ACTION_COMMUNITY_UPDATE            AuditLogEntry_Action = 16
+	AuditLogEntry_ACTION_MESSAGE_DELETE              AuditLogEntry_Action = 17
+	AuditLogEntry_ACTION_CUSTOM_EMOJI_CREATE         AuditLogEntry_Action = 18
+	AuditLogEntry_ACTION_CUSTOM_EMOJI_DELETE         AuditLogEntry_Action = 19
+	AuditLogEntry_ACTION_MESSAGE_PIN                 AuditLogEntry_Action = 20
+	AuditLogEntry_ACTION_MESSAGE_UNPIN               AuditLogEntry_Action = 21
+	AuditLogEntry_ACTION_RATE_LIMIT_EXEMPTION_CREATE AuditLogEntry_Action = 22
+	AuditLogEntry_ACTION_RATE_LIMIT_EXEMPTION_DELETE AuditLogEntry_Action = 23
+	AuditLogEntry_ACTION_BAN_MEMBER_REMOVE           AuditLogEntry_Action = 24
+)
+
+// Enum value maps for AuditLogEntry_Action.
+var (
+	AuditLogEntry_Action_name = map[int32]string{
+		0:  "ACTION_UNSPECIFIED",
+		1:  "ACTION_CHANNEL_CREATE",
+		2:  "ACTION_CHANNEL_UPDATE",
+		3:  "ACTION_CHANNEL_DELETE",
+		4:  "ACTION_CHANNEL_OVERWRITE_UPDATE",
+		5:  "ACTION_ROLE_CREATE",
+		6:  "ACTION_ROLE_UPDATE",
+		7:  "ACTION_ROLE_DELETE",
+		8:  "ACTION_MEMBER_ROLE_ADD",
+		9:  "ACTION_MEMBER_ROLE_REMOVE",
+		10: "ACTION_MEMBER_KICK",
+		11: "ACTION_MEMBER_MUTE",
+		12: "ACTION_MEMBER_UNMUTE",
+		13: "ACTION_BAN_CREATE",
+		14: "ACTION_BAN_DELETE",
+		15: "ACTION_INVITE_REVOKE",
+		16: "ACTION_COMMUNITY_UPDATE",
+		17: "ACTION_MESSAGE_DELETE",
+		18: "ACTION_CUSTOM_EMOJI_CREATE",
+		19: "ACTION_CUSTOM_EMOJI_DELETE",
+		20: "ACTION_MESSAGE_PIN",
+		21: "ACTION_MESSAGE_UNPIN",
+		22: "ACTION_RATE_LIMIT_EXEMPTION_CREATE",
+		23: "ACTION_RATE_LIMIT_EXEMPTION_DELETE",
+		24: "ACTION_BAN_MEMBER_REMOVE",
+	}
+	AuditLogEntry_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED":                 0,
+		"ACTION_CHANNEL_CREATE":              1,
+		"ACTION_CHANNEL_UPDATE":              2,
+		"ACTION_CHANNEL_DELETE":              3,
+		"ACTION_CHANNEL_OVERWRITE_UPDATE":    4,
+		"ACTION_ROLE_CREATE":                 5,
+		"ACTION_ROLE_UPDATE":                 6,
+		"ACTION_ROLE_DELETE":                 7,
+		"ACTION_MEMBER_ROLE_ADD":             8,
+		"ACTION_MEMBER_ROLE_REMOVE":          9,
+		"ACTION_MEMBER_KICK":                 10,
+		"ACTION_MEMBER_MUTE":                 11,
+		"ACTION_MEMBER_UNMUTE":               12,
+		"ACTION_BAN_CREATE":                  13,
+		"ACTION_BAN_DELETE":                  14,
+		"ACTION_INVITE_REVOKE":               15,
+		"ACTION_COMMUNITY_UPDATE":            16,
+		"ACTION_MESSAGE_DELETE":              17,
+		"ACTION_CUSTOM_EMOJI_CREATE":         18,
+		"ACTION_CUSTOM_EMOJI_DELETE":         19,
+		"ACTION_MESSAGE_PIN":                 20,
+		"ACTION_MESSAGE_UNPIN":               21,
+		"ACTION_RATE_LIMIT_EXEMPTION_CREATE": 22,
+		"ACTION_RATE_LIMIT_EXEMPTION_DELETE": 23,
+		"ACTION_BAN_MEMBER_REMOVE":           24,
+	}
+)
+
+func (x AuditLogEntry_Action) Enum() *AuditLogEntry_Action {
+	p := new(AuditLogEntry_Action)
+	*p = x
+	return p
+}
+
+func (x AuditLogEntry_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditLogEntry_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_communityserver_v1_communityserver_proto_enumTypes[4].Descriptor()
+}
+
+func (AuditLogEntry_Action) Type() protoreflect.EnumType {
+	return &file_communityserver_v1_communityserver_proto_enumTypes[4]
+}
+
+func (x AuditLogEntry_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditLogEntry_Action.Descriptor instead.
+func (AuditLogEntry_Action) EnumDescriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{77, 0}
+}
+
+type GatewayCommand_Type int32
+
+const (
+	GatewayCommand_TYPE_UNSPECIFIED     GatewayCommand_Type = 0
+	GatewayCommand_TYPE_UPDATE_PRESENCE GatewayCommand_Type = 1
+	GatewayCommand_TYPE_START_TYPING    GatewayCommand_Type = 2
+)
+
+// Enum value maps for GatewayCommand_Type.
+var (
+	GatewayCommand_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_UPDATE_PRESENCE",
+		2: "TYPE_START_TYPING",
+	}
+	GatewayCommand_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":     0,
+		"TYPE_UPDATE_PRESENCE": 1,
+		"TYPE_START_TYPING":    2,
+	}
+)
+
+func (x GatewayCommand_Type) Enum() *GatewayCommand_Type {
+	p := new(GatewayCommand_Type)
+	*p = x
+	return p
+}
+
+func (x GatewayCommand_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GatewayCommand_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_communityserver_v1_communityserver_proto_enumTypes[5].Descriptor()
+}
+
+func (GatewayCommand_Type) Type() protoreflect.EnumType {
+	return &file_communityserver_v1_communityserver_proto_enumTypes[5]
+}
+
+func (x GatewayCommand_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GatewayCommand_Type.Descriptor instead.
+func (GatewayCommand_Type) EnumDescriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{99, 0}
+}
+
+type RateLimitedError_Scope int32
+
+const (
+	RateLimitedError_SCOPE_UNSPECIFIED RateLimitedError_Scope = 0
+	RateLimitedError_SCOPE_SLOW_MODE   RateLimitedError_Scope = 1
+	RateLimitedError_SCOPE_BURST       RateLimitedError_Scope = 2
+)
+
+// Enum value maps for RateLimitedError_Scope.
+var (
+	RateLimitedError_Scope_name = map[int32]string{
+		0: "SCOPE_UNSPECIFIED",
+		1: "SCOPE_SLOW_MODE",
+		2: "SCOPE_BURST",
+	}
+	RateLimitedError_Scope_value = map[string]int32{
+		"SCOPE_UNSPECIFIED": 0,
+		"SCOPE_SLOW_MODE":   1,
+		"SCOPE_BURST":       2,
+	}
+)
+
+func (x RateLimitedError_Scope) Enum() *RateLimitedError_Scope {
+	p := new(RateLimitedError_Scope)
+	*p = x
+	return p
+}
+
+func (x RateLimitedError_Scope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RateLimitedError_Scope) Descriptor() protoreflect.EnumDescriptor {
+	return file_communityserver_v1_communityserver_proto_enumTypes[6].Descriptor()
+}
+
+func (RateLimitedError_Scope) Type() protoreflect.EnumType {
+	return &file_communityserver_v1_communityserver_proto_enumTypes[6]
+}
+
+func (x RateLimitedError_Scope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RateLimitedError_Scope.Descriptor instead.
+func (RateLimitedError_Scope) EnumDescriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{142, 0}
+}
+
 type GetUserCommunitiesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -104,6 +624,7 @@ func (x *GetUserCommunitiesResponse) GetCommunities() []*GetUserCommunitiesRespo
 type JoinServerRequest struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	JoinDefaultCommunity bool                   `protobuf:"varint,1,opt,name=join_default_community,json=joinDefaultCommunity,proto3" json:"join_default_community,omitempty"`
+	InviteCode           string                 `protobuf:"bytes,2,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -145,8 +666,17 @@ func (x *JoinServerRequest) GetJoinDefaultCommunity() bool {
 	return false
 }
 
+func (x *JoinServerRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
 type JoinServerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -181,28 +711,43 @@ func (*JoinServerResponse) Descriptor() ([]byte, []int) {
 	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{3}
 }
 
-type GetUserCommunitiesResponse_Community struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *JoinServerResponse) GetCommunityId() string {
+	if x != nil {
+		return x.CommunityId
+	}
+	return ""
 }
 
-func (x *GetUserCommunitiesResponse_Community) Reset() {
-	*x = GetUserCommunitiesResponse_Community{}
+func (x *JoinServerResponse) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type Channel struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SlowModeSeconds int32                  `protobuf:"varint,3,opt,name=slow_mode_seconds,json=slowModeSeconds,proto3" json:"slow_mode_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Channel) Reset() {
+	*x = Channel{}
 	mi := &file_communityserver_v1_communityserver_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserCommunitiesResponse_Community) String() string {
+func (x *Channel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserCommunitiesResponse_Community) ProtoMessage() {}
+func (*Channel) ProtoMessage() {}
 
-func (x *GetUserCommunitiesResponse_Community) ProtoReflect() protoreflect.Message {
+func (x *Channel) ProtoReflect() protoreflect.Message {
 	mi := &file_communityserver_v1_communityserver_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -214,39 +759,8060 @@ func (x *GetUserCommunitiesResponse_Community) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserCommunitiesResponse_Community.ProtoReflect.Descriptor instead.
-func (*GetUserCommunitiesResponse_Community) Descriptor() ([]byte, []int) {
-	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use Channel.ProtoReflect.Descriptor instead.
+func (*Channel) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetUserCommunitiesResponse_Community) GetId() string {
+func (x *Channel) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GetUserCommunitiesResponse_Community) GetName() string {
+func (x *Channel) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-var File_communityserver_v1_communityserver_proto protoreflect.FileDescriptor
+func (x *Channel) GetSlowModeSeconds() int32 {
+	if x != nil {
+		return x.SlowModeSeconds
+	}
+	return 0
+}
 
-const file_communityserver_v1_communityserver_proto_rawDesc = "" +
-	"\n" +
-	"(communityserver/v1/communityserver.proto\x12\x12communityserver.v1\"\x1b\n" +
-	"\x19GetUserCommunitiesRequest\"\xa9\x01\n" +
-	"\x1aGetUserCommunitiesResponse\x12Z\n" +
-	"\vcommunities\x18\x01 \x03(\v28.communityserver.v1.GetUserCommunitiesResponse.CommunityR\vcommunities\x1a/\n" +
-	"\tCommunity\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"I\n" +
-	"\x11JoinServerRequest\x124\n" +
-	"\x16join_default_community\x18\x01 \x01(\bR\x14joinDefaultCommunity\"\x14\n" +
-	"\x12JoinServerResponseB\xf2\x01\n" +
+type GetChannelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChannelsRequest) Reset() {
+	*x = GetChannelsRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelsRequest) ProtoMessage() {}
+
+func (x *GetChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelsRequest.ProtoReflect.Descriptor instead.
+func (*GetChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{5}
+}
+
+type GetChannelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channels      []*Channel             `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChannelsResponse) Reset() {
+	*x = GetChannelsResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelsResponse) ProtoMessage() {}
+
+func (x *GetChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelsResponse.ProtoReflect.Descriptor instead.
+func (*GetChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetChannelsResponse) GetChannels() []*Channel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type CreateChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChannelRequest) Reset() {
+	*x = CreateChannelRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChannelRequest) ProtoMessage() {}
+
+func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChannelRequest.ProtoReflect.Descriptor instead.
+func (*CreateChannelRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateChannelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *Channel               `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChannelResponse) Reset() {
+	*x = CreateChannelResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChannelResponse) ProtoMessage() {}
+
+func (x *CreateChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChannelResponse.ProtoReflect.Descriptor instead.
+func (*CreateChannelResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateChannelResponse) GetChannel() *Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type UpdateChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateChannelRequest) Reset() {
+	*x = UpdateChannelRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChannelRequest) ProtoMessage() {}
+
+func (x *UpdateChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChannelRequest.ProtoReflect.Descriptor instead.
+func (*UpdateChannelRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateChannelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *Channel               `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateChannelResponse) Reset() {
+	*x = UpdateChannelResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChannelResponse) ProtoMessage() {}
+
+func (x *UpdateChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChannelResponse.ProtoReflect.Descriptor instead.
+func (*UpdateChannelResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateChannelResponse) GetChannel() *Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type DeleteChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChannelRequest) Reset() {
+	*x = DeleteChannelRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChannelRequest) ProtoMessage() {}
+
+func (x *DeleteChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChannelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChannelRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{11}
+}
+
+type DeleteChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChannelResponse) Reset() {
+	*x = DeleteChannelResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChannelResponse) ProtoMessage() {}
+
+func (x *DeleteChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChannelResponse.ProtoReflect.Descriptor instead.
+func (*DeleteChannelResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{12}
+}
+
+type Message struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChannelId            string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserAddress          string                 `protobuf:"bytes,3,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	Body                 string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt            string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Deleted              bool                   `protobuf:"varint,7,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	ReplyToMessageId     string                 `protobuf:"bytes,8,opt,name=reply_to_message_id,json=replyToMessageId,proto3" json:"reply_to_message_id,omitempty"`
+	ReplyTo              *MessageReference      `protobuf:"bytes,9,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+	ThreadId             string                 `protobuf:"bytes,10,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	ThreadReplyCount     int32                  `protobuf:"varint,11,opt,name=thread_reply_count,json=threadReplyCount,proto3" json:"thread_reply_count,omitempty"`
+	ThreadLastActivityAt string                 `protobuf:"bytes,12,opt,name=thread_last_activity_at,json=threadLastActivityAt,proto3" json:"thread_last_activity_at,omitempty"`
+	Reactions            []*Reaction            `protobuf:"bytes,13,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	Attachments          []*Attachment          `protobuf:"bytes,14,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	LinkPreviews         []*LinkPreview         `protobuf:"bytes,15,rep,name=link_previews,json=linkPreviews,proto3" json:"link_previews,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Message) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Message) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *Message) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *Message) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Message) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Message) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Message) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *Message) GetReplyToMessageId() string {
+	if x != nil {
+		return x.ReplyToMessageId
+	}
+	return ""
+}
+
+func (x *Message) GetReplyTo() *MessageReference {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return nil
+}
+
+func (x *Message) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *Message) GetThreadReplyCount() int32 {
+	if x != nil {
+		return x.ThreadReplyCount
+	}
+	return 0
+}
+
+func (x *Message) GetThreadLastActivityAt() string {
+	if x != nil {
+		return x.ThreadLastActivityAt
+	}
+	return ""
+}
+
+func (x *Message) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *Message) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Message) GetLinkPreviews() []*LinkPreview {
+	if x != nil {
+		return x.LinkPreviews
+	}
+	return nil
+}
+
+type Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Width         int32                  `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Url           string                 `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Attachment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attachment) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Attachment) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Attachment) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type LinkPreview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	SiteName      string                 `protobuf:"bytes,4,opt,name=site_name,json=siteName,proto3" json:"site_name,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkPreview) Reset() {
+	*x = LinkPreview{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkPreview) ProtoMessage() {}
+
+func (x *LinkPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkPreview.ProtoReflect.Descriptor instead.
+func (*LinkPreview) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LinkPreview) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetSiteName() string {
+	if x != nil {
+		return x.SiteName
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+type Reaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Emoji         string                 `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	CustomEmojiId string                 `protobuf:"bytes,2,opt,name=custom_emoji_id,json=customEmojiId,proto3" json:"custom_emoji_id,omitempty"`
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Me            bool                   `protobuf:"varint,4,opt,name=me,proto3" json:"me,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reaction) Reset() {
+	*x = Reaction{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reaction) ProtoMessage() {}
+
+func (x *Reaction) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Reaction) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *Reaction) GetCustomEmojiId() string {
+	if x != nil {
+		return x.CustomEmojiId
+	}
+	return ""
+}
+
+func (x *Reaction) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Reaction) GetMe() bool {
+	if x != nil {
+		return x.Me
+	}
+	return false
+}
+
+type MessageReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserAddress   string                 `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Deleted       bool                   `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageReference) Reset() {
+	*x = MessageReference{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageReference) ProtoMessage() {}
+
+func (x *MessageReference) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageReference.ProtoReflect.Descriptor instead.
+func (*MessageReference) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MessageReference) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MessageReference) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *MessageReference) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *MessageReference) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type GetMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessagesRequest) Reset() {
+	*x = GetMessagesRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessagesRequest) ProtoMessage() {}
+
+func (x *GetMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{18}
+}
+
+type GetMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessagesResponse) Reset() {
+	*x = GetMessagesResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessagesResponse) ProtoMessage() {}
+
+func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetMessagesResponse) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *GetMessagesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type SendMessageRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Body             string                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	ReplyToMessageId string                 `protobuf:"bytes,2,opt,name=reply_to_message_id,json=replyToMessageId,proto3" json:"reply_to_message_id,omitempty"`
+	AttachmentIds    []string               `protobuf:"bytes,3,rep,name=attachment_ids,json=attachmentIds,proto3" json:"attachment_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SendMessageRequest) Reset() {
+	*x = SendMessageRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageRequest) ProtoMessage() {}
+
+func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendMessageRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SendMessageRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetReplyToMessageId() string {
+	if x != nil {
+		return x.ReplyToMessageId
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetAttachmentIds() []string {
+	if x != nil {
+		return x.AttachmentIds
+	}
+	return nil
+}
+
+type SendMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMessageResponse) Reset() {
+	*x = SendMessageResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageResponse) ProtoMessage() {}
+
+func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendMessageResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SendMessageResponse) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Event struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          Event_Type             `protobuf:"varint,1,opt,name=type,proto3,enum=communityserver.v1.Event_Type" json:"type,omitempty"`
+	CommunityId   string                 `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Event) GetType() Event_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Event_TYPE_UNSPECIFIED
+}
+
+func (x *Event) GetCommunityId() string {
+	if x != nil {
+		return x.CommunityId
+	}
+	return ""
+}
+
+func (x *Event) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Event) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type MessageCreatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageCreatedEvent) Reset() {
+	*x = MessageCreatedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageCreatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageCreatedEvent) ProtoMessage() {}
+
+func (x *MessageCreatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageCreatedEvent.ProtoReflect.Descriptor instead.
+func (*MessageCreatedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MessageCreatedEvent) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type MessageUpdatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageUpdatedEvent) Reset() {
+	*x = MessageUpdatedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageUpdatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageUpdatedEvent) ProtoMessage() {}
+
+func (x *MessageUpdatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageUpdatedEvent.ProtoReflect.Descriptor instead.
+func (*MessageUpdatedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MessageUpdatedEvent) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type MessageDeletedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageDeletedEvent) Reset() {
+	*x = MessageDeletedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageDeletedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageDeletedEvent) ProtoMessage() {}
+
+func (x *MessageDeletedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageDeletedEvent.ProtoReflect.Descriptor instead.
+func (*MessageDeletedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MessageDeletedEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *MessageDeletedEvent) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type MemberJoinedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserAddress   string                 `protobuf:"bytes,1,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberJoinedEvent) Reset() {
+	*x = MemberJoinedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberJoinedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberJoinedEvent) ProtoMessage() {}
+
+func (x *MemberJoinedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberJoinedEvent.ProtoReflect.Descriptor instead.
+func (*MemberJoinedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MemberJoinedEvent) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+type ChannelCreatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *Channel               `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelCreatedEvent) Reset() {
+	*x = ChannelCreatedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelCreatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelCreatedEvent) ProtoMessage() {}
+
+func (x *ChannelCreatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelCreatedEvent.ProtoReflect.Descriptor instead.
+func (*ChannelCreatedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ChannelCreatedEvent) GetChannel() *Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type ChannelUpdatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *Channel               `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelUpdatedEvent) Reset() {
+	*x = ChannelUpdatedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelUpdatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelUpdatedEvent) ProtoMessage() {}
+
+func (x *ChannelUpdatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelUpdatedEvent.ProtoReflect.Descriptor instead.
+func (*ChannelUpdatedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ChannelUpdatedEvent) GetChannel() *Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type ChannelDeletedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelDeletedEvent) Reset() {
+	*x = ChannelDeletedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelDeletedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelDeletedEvent) ProtoMessage() {}
+
+func (x *ChannelDeletedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelDeletedEvent.ProtoReflect.Descriptor instead.
+func (*ChannelDeletedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ChannelDeletedEvent) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type Role struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Permissions   int64                  `protobuf:"varint,3,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Role) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Role) GetPermissions() int64 {
+	if x != nil {
+		return x.Permissions
+	}
+	return 0
+}
+
+type RoleCreatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleCreatedEvent) Reset() {
+	*x = RoleCreatedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleCreatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleCreatedEvent) ProtoMessage() {}
+
+func (x *RoleCreatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleCreatedEvent.ProtoReflect.Descriptor instead.
+func (*RoleCreatedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RoleCreatedEvent) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type RoleUpdatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleUpdatedEvent) Reset() {
+	*x = RoleUpdatedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleUpdatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleUpdatedEvent) ProtoMessage() {}
+
+func (x *RoleUpdatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleUpdatedEvent.ProtoReflect.Descriptor instead.
+func (*RoleUpdatedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RoleUpdatedEvent) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type RoleDeletedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleDeletedEvent) Reset() {
+	*x = RoleDeletedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleDeletedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleDeletedEvent) ProtoMessage() {}
+
+func (x *RoleDeletedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleDeletedEvent.ProtoReflect.Descriptor instead.
+func (*RoleDeletedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RoleDeletedEvent) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+type MemberRoleAddedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserAddress   string                 `protobuf:"bytes,1,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	RoleId        string                 `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberRoleAddedEvent) Reset() {
+	*x = MemberRoleAddedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberRoleAddedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberRoleAddedEvent) ProtoMessage() {}
+
+func (x *MemberRoleAddedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberRoleAddedEvent.ProtoReflect.Descriptor instead.
+func (*MemberRoleAddedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MemberRoleAddedEvent) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *MemberRoleAddedEvent) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+type MemberRoleRemovedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserAddress   string                 `protobuf:"bytes,1,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	RoleId        string                 `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberRoleRemovedEvent) Reset() {
+	*x = MemberRoleRemovedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberRoleRemovedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberRoleRemovedEvent) ProtoMessage() {}
+
+func (x *MemberRoleRemovedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberRoleRemovedEvent.ProtoReflect.Descriptor instead.
+func (*MemberRoleRemovedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MemberRoleRemovedEvent) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *MemberRoleRemovedEvent) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+type GetRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRolesRequest) Reset() {
+	*x = GetRolesRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRolesRequest) ProtoMessage() {}
+
+func (x *GetRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRolesRequest.ProtoReflect.Descriptor instead.
+func (*GetRolesRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{36}
+}
+
+type GetRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []*Role                `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRolesResponse) Reset() {
+	*x = GetRolesResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRolesResponse) ProtoMessage() {}
+
+func (x *GetRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRolesResponse.ProtoReflect.Descriptor instead.
+func (*GetRolesResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetRolesResponse) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type CreateRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Permissions   int64                  `protobuf:"varint,2,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoleRequest) Reset() {
+	*x = CreateRoleRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleRequest) ProtoMessage() {}
+
+func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoleRequest) GetPermissions() int64 {
+	if x != nil {
+		return x.Permissions
+	}
+	return 0
+}
+
+type CreateRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoleResponse) Reset() {
+	*x = CreateRoleResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleResponse) ProtoMessage() {}
+
+func (x *CreateRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoleResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateRoleResponse) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type UpdateRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Permissions   int64                  `protobuf:"varint,2,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoleRequest) Reset() {
+	*x = UpdateRoleRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleRequest) ProtoMessage() {}
+
+func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetPermissions() int64 {
+	if x != nil {
+		return x.Permissions
+	}
+	return 0
+}
+
+type UpdateRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoleResponse) Reset() {
+	*x = UpdateRoleResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleResponse) ProtoMessage() {}
+
+func (x *UpdateRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRoleResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateRoleResponse) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type DeleteRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleRequest) Reset() {
+	*x = DeleteRoleRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleRequest) ProtoMessage() {}
+
+func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{42}
+}
+
+type DeleteRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleResponse) Reset() {
+	*x = DeleteRoleResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleResponse) ProtoMessage() {}
+
+func (x *DeleteRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRoleResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{43}
+}
+
+type AssignRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignRoleRequest) Reset() {
+	*x = AssignRoleRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRoleRequest) ProtoMessage() {}
+
+func (x *AssignRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRoleRequest.ProtoReflect.Descriptor instead.
+func (*AssignRoleRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{44}
+}
+
+type AssignRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignRoleResponse) Reset() {
+	*x = AssignRoleResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRoleResponse) ProtoMessage() {}
+
+func (x *AssignRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRoleResponse.ProtoReflect.Descriptor instead.
+func (*AssignRoleResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{45}
+}
+
+type UnassignRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnassignRoleRequest) Reset() {
+	*x = UnassignRoleRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignRoleRequest) ProtoMessage() {}
+
+func (x *UnassignRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnassignRoleRequest.ProtoReflect.Descriptor instead.
+func (*UnassignRoleRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{46}
+}
+
+type UnassignRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnassignRoleResponse) Reset() {
+	*x = UnassignRoleResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignRoleResponse) ProtoMessage() {}
+
+func (x *UnassignRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnassignRoleResponse.ProtoReflect.Descriptor instead.
+func (*UnassignRoleResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{47}
+}
+
+type PermissionOverwrite struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	TargetType    PermissionOverwrite_TargetType `protobuf:"varint,1,opt,name=target_type,json=targetType,proto3,enum=communityserver.v1.PermissionOverwrite_TargetType" json:"target_type,omitempty"`
+	TargetId      string                         `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Allow         int64                          `protobuf:"varint,3,opt,name=allow,proto3" json:"allow,omitempty"`
+	Deny          int64                          `protobuf:"varint,4,opt,name=deny,proto3" json:"deny,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PermissionOverwrite) Reset() {
+	*x = PermissionOverwrite{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermissionOverwrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionOverwrite) ProtoMessage() {}
+
+func (x *PermissionOverwrite) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionOverwrite.ProtoReflect.Descriptor instead.
+func (*PermissionOverwrite) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PermissionOverwrite) GetTargetType() PermissionOverwrite_TargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return PermissionOverwrite_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *PermissionOverwrite) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *PermissionOverwrite) GetAllow() int64 {
+	if x != nil {
+		return x.Allow
+	}
+	return 0
+}
+
+func (x *PermissionOverwrite) GetDeny() int64 {
+	if x != nil {
+		return x.Deny
+	}
+	return 0
+}
+
+type GetChannelOverwritesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChannelOverwritesRequest) Reset() {
+	*x = GetChannelOverwritesRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChannelOverwritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelOverwritesRequest) ProtoMessage() {}
+
+func (x *GetChannelOverwritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelOverwritesRequest.ProtoReflect.Descriptor instead.
+func (*GetChannelOverwritesRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{49}
+}
+
+type GetChannelOverwritesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overwrites    []*PermissionOverwrite `protobuf:"bytes,1,rep,name=overwrites,proto3" json:"overwrites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChannelOverwritesResponse) Reset() {
+	*x = GetChannelOverwritesResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChannelOverwritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelOverwritesResponse) ProtoMessage() {}
+
+func (x *GetChannelOverwritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelOverwritesResponse.ProtoReflect.Descriptor instead.
+func (*GetChannelOverwritesResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetChannelOverwritesResponse) GetOverwrites() []*PermissionOverwrite {
+	if x != nil {
+		return x.Overwrites
+	}
+	return nil
+}
+
+type SetChannelOverwriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overwrite     *PermissionOverwrite   `protobuf:"bytes,1,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChannelOverwriteRequest) Reset() {
+	*x = SetChannelOverwriteRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChannelOverwriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChannelOverwriteRequest) ProtoMessage() {}
+
+func (x *SetChannelOverwriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChannelOverwriteRequest.ProtoReflect.Descriptor instead.
+func (*SetChannelOverwriteRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SetChannelOverwriteRequest) GetOverwrite() *PermissionOverwrite {
+	if x != nil {
+		return x.Overwrite
+	}
+	return nil
+}
+
+type SetChannelOverwriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChannelOverwriteResponse) Reset() {
+	*x = SetChannelOverwriteResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChannelOverwriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChannelOverwriteResponse) ProtoMessage() {}
+
+func (x *SetChannelOverwriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChannelOverwriteResponse.ProtoReflect.Descriptor instead.
+func (*SetChannelOverwriteResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{52}
+}
+
+type Invite struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Code               string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	CommunityId        string                 `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	ChannelId          string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	CreatorUserAddress string                 `protobuf:"bytes,4,opt,name=creator_user_address,json=creatorUserAddress,proto3" json:"creator_user_address,omitempty"`
+	MaxUses            int32                  `protobuf:"varint,5,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	Uses               int32                  `protobuf:"varint,6,opt,name=uses,proto3" json:"uses,omitempty"`
+	ExpiresAt          string                 `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt          string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Invite) Reset() {
+	*x = Invite{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invite) ProtoMessage() {}
+
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invite.ProtoReflect.Descriptor instead.
+func (*Invite) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Invite) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Invite) GetCommunityId() string {
+	if x != nil {
+		return x.CommunityId
+	}
+	return ""
+}
+
+func (x *Invite) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *Invite) GetCreatorUserAddress() string {
+	if x != nil {
+		return x.CreatorUserAddress
+	}
+	return ""
+}
+
+func (x *Invite) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *Invite) GetUses() int32 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *Invite) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *Invite) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetInvitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvitesRequest) Reset() {
+	*x = GetInvitesRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitesRequest) ProtoMessage() {}
+
+func (x *GetInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvitesRequest.ProtoReflect.Descriptor instead.
+func (*GetInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{54}
+}
+
+type GetInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*Invite              `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvitesResponse) Reset() {
+	*x = GetInvitesResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitesResponse) ProtoMessage() {}
+
+func (x *GetInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvitesResponse.ProtoReflect.Descriptor instead.
+func (*GetInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetInvitesResponse) GetInvites() []*Invite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+type CreateInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MaxUses       int32                  `protobuf:"varint,2,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	MaxAgeSeconds int64                  `protobuf:"varint,3,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteRequest) Reset() {
+	*x = CreateInviteRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteRequest) ProtoMessage() {}
+
+func (x *CreateInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateInviteRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *CreateInviteRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateInviteRequest) GetMaxAgeSeconds() int64 {
+	if x != nil {
+		return x.MaxAgeSeconds
+	}
+	return 0
+}
+
+type CreateInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteResponse) Reset() {
+	*x = CreateInviteResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteResponse) ProtoMessage() {}
+
+func (x *CreateInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteResponse.ProtoReflect.Descriptor instead.
+func (*CreateInviteResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateInviteResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type RevokeInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteRequest) Reset() {
+	*x = RevokeInviteRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteRequest) ProtoMessage() {}
+
+func (x *RevokeInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInviteRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{58}
+}
+
+type RevokeInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteResponse) Reset() {
+	*x = RevokeInviteResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteResponse) ProtoMessage() {}
+
+func (x *RevokeInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInviteResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{59}
+}
+
+type ResolveInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveInviteRequest) Reset() {
+	*x = ResolveInviteRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveInviteRequest) ProtoMessage() {}
+
+func (x *ResolveInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveInviteRequest.ProtoReflect.Descriptor instead.
+func (*ResolveInviteRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{60}
+}
+
+type ResolveInviteResponse struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Invite        *Invite                          `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	Community     *ResolveInviteResponse_Community `protobuf:"bytes,2,opt,name=community,proto3" json:"community,omitempty"`
+	Channel       *Channel                         `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveInviteResponse) Reset() {
+	*x = ResolveInviteResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveInviteResponse) ProtoMessage() {}
+
+func (x *ResolveInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveInviteResponse.ProtoReflect.Descriptor instead.
+func (*ResolveInviteResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ResolveInviteResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+func (x *ResolveInviteResponse) GetCommunity() *ResolveInviteResponse_Community {
+	if x != nil {
+		return x.Community
+	}
+	return nil
+}
+
+func (x *ResolveInviteResponse) GetChannel() *Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type MemberRemovedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserAddress   string                 `protobuf:"bytes,1,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberRemovedEvent) Reset() {
+	*x = MemberRemovedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberRemovedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberRemovedEvent) ProtoMessage() {}
+
+func (x *MemberRemovedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberRemovedEvent.ProtoReflect.Descriptor instead.
+func (*MemberRemovedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *MemberRemovedEvent) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+type MemberMutedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserAddress   string                 `protobuf:"bytes,1,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	MutedUntil    string                 `protobuf:"bytes,2,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberMutedEvent) Reset() {
+	*x = MemberMutedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberMutedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberMutedEvent) ProtoMessage() {}
+
+func (x *MemberMutedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberMutedEvent.ProtoReflect.Descriptor instead.
+func (*MemberMutedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *MemberMutedEvent) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *MemberMutedEvent) GetMutedUntil() string {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return ""
+}
+
+type KickMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickMemberRequest) Reset() {
+	*x = KickMemberRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickMemberRequest) ProtoMessage() {}
+
+func (x *KickMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickMemberRequest.ProtoReflect.Descriptor instead.
+func (*KickMemberRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{64}
+}
+
+type KickMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickMemberResponse) Reset() {
+	*x = KickMemberResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickMemberResponse) ProtoMessage() {}
+
+func (x *KickMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickMemberResponse.ProtoReflect.Descriptor instead.
+func (*KickMemberResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{65}
+}
+
+type MuteMemberRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DurationSeconds int64                  `protobuf:"varint,1,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MuteMemberRequest) Reset() {
+	*x = MuteMemberRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteMemberRequest) ProtoMessage() {}
+
+func (x *MuteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteMemberRequest.ProtoReflect.Descriptor instead.
+func (*MuteMemberRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *MuteMemberRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type MuteMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MutedUntil    string                 `protobuf:"bytes,1,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteMemberResponse) Reset() {
+	*x = MuteMemberResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteMemberResponse) ProtoMessage() {}
+
+func (x *MuteMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteMemberResponse.ProtoReflect.Descriptor instead.
+func (*MuteMemberResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *MuteMemberResponse) GetMutedUntil() string {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return ""
+}
+
+type UnmuteMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteMemberRequest) Reset() {
+	*x = UnmuteMemberRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteMemberRequest) ProtoMessage() {}
+
+func (x *UnmuteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteMemberRequest.ProtoReflect.Descriptor instead.
+func (*UnmuteMemberRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{68}
+}
+
+type UnmuteMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteMemberResponse) Reset() {
+	*x = UnmuteMemberResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteMemberResponse) ProtoMessage() {}
+
+func (x *UnmuteMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteMemberResponse.ProtoReflect.Descriptor instead.
+func (*UnmuteMemberResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{69}
+}
+
+type Ban struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserAddress   string                 `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	Host          string                 `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	BannedBy      string                 `protobuf:"bytes,5,opt,name=banned_by,json=bannedBy,proto3" json:"banned_by,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ban) Reset() {
+	*x = Ban{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ban) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ban) ProtoMessage() {}
+
+func (x *Ban) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ban.ProtoReflect.Descriptor instead.
+func (*Ban) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Ban) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Ban) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *Ban) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Ban) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Ban) GetBannedBy() string {
+	if x != nil {
+		return x.BannedBy
+	}
+	return ""
+}
+
+func (x *Ban) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *Ban) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetBansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBansRequest) Reset() {
+	*x = GetBansRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBansRequest) ProtoMessage() {}
+
+func (x *GetBansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBansRequest.ProtoReflect.Descriptor instead.
+func (*GetBansRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{71}
+}
+
+type GetBansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bans          []*Ban                 `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBansResponse) Reset() {
+	*x = GetBansResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBansResponse) ProtoMessage() {}
+
+func (x *GetBansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBansResponse.ProtoReflect.Descriptor instead.
+func (*GetBansResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetBansResponse) GetBans() []*Ban {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
+type CreateBanRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserAddress     string                 `protobuf:"bytes,1,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	Host            string                 `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Reason          string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,4,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateBanRequest) Reset() {
+	*x = CreateBanRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBanRequest) ProtoMessage() {}
+
+func (x *CreateBanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBanRequest.ProtoReflect.Descriptor instead.
+func (*CreateBanRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CreateBanRequest) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *CreateBanRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *CreateBanRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CreateBanRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type CreateBanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ban           *Ban                   `protobuf:"bytes,1,opt,name=ban,proto3" json:"ban,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBanResponse) Reset() {
+	*x = CreateBanResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBanResponse) ProtoMessage() {}
+
+func (x *CreateBanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBanResponse.ProtoReflect.Descriptor instead.
+func (*CreateBanResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CreateBanResponse) GetBan() *Ban {
+	if x != nil {
+		return x.Ban
+	}
+	return nil
+}
+
+type DeleteBanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBanRequest) Reset() {
+	*x = DeleteBanRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBanRequest) ProtoMessage() {}
+
+func (x *DeleteBanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBanRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBanRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{75}
+}
+
+type DeleteBanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBanResponse) Reset() {
+	*x = DeleteBanResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBanResponse) ProtoMessage() {}
+
+func (x *DeleteBanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBanResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBanResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{76}
+}
+
+type AuditLogEntry struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorUserAddress string                 `protobuf:"bytes,2,opt,name=actor_user_address,json=actorUserAddress,proto3" json:"actor_user_address,omitempty"`
+	Action           AuditLogEntry_Action   `protobuf:"varint,3,opt,name=action,proto3,enum=communityserver.v1.AuditLogEntry_Action" json:"action,omitempty"`
+	TargetId         string                 `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Reason           string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Before           string                 `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After            string                 `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AuditLogEntry) Reset() {
+	*x = AuditLogEntry{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogEntry) ProtoMessage() {}
+
+func (x *AuditLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogEntry.ProtoReflect.Descriptor instead.
+func (*AuditLogEntry) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AuditLogEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetActorUserAddress() string {
+	if x != nil {
+		return x.ActorUserAddress
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetAction() AuditLogEntry_Action {
+	if x != nil {
+		return x.Action
+	}
+	return AuditLogEntry_ACTION_UNSPECIFIED
+}
+
+func (x *AuditLogEntry) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditLogRequest) Reset() {
+	*x = GetAuditLogRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditLogRequest) ProtoMessage() {}
+
+func (x *GetAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{78}
+}
+
+type GetAuditLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditLogEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditLogResponse) Reset() {
+	*x = GetAuditLogResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditLogResponse) ProtoMessage() {}
+
+func (x *GetAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*GetAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetAuditLogResponse) GetEntries() []*AuditLogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetAuditLogResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type Community struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IconUrl       string                 `protobuf:"bytes,3,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Community) Reset() {
+	*x = Community{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Community) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Community) ProtoMessage() {}
+
+func (x *Community) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Community.ProtoReflect.Descriptor instead.
+func (*Community) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Community) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Community) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Community) GetIconUrl() string {
+	if x != nil {
+		return x.IconUrl
+	}
+	return ""
+}
+
+func (x *Community) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type GetCommunityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommunityRequest) Reset() {
+	*x = GetCommunityRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommunityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommunityRequest) ProtoMessage() {}
+
+func (x *GetCommunityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommunityRequest.ProtoReflect.Descriptor instead.
+func (*GetCommunityRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{81}
+}
+
+type GetCommunityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Community     *Community             `protobuf:"bytes,1,opt,name=community,proto3" json:"community,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommunityResponse) Reset() {
+	*x = GetCommunityResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommunityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommunityResponse) ProtoMessage() {}
+
+func (x *GetCommunityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommunityResponse.ProtoReflect.Descriptor instead.
+func (*GetCommunityResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetCommunityResponse) GetCommunity() *Community {
+	if x != nil {
+		return x.Community
+	}
+	return nil
+}
+
+type CreateCommunityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IconUrl       string                 `protobuf:"bytes,2,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommunityRequest) Reset() {
+	*x = CreateCommunityRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommunityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommunityRequest) ProtoMessage() {}
+
+func (x *CreateCommunityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommunityRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommunityRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *CreateCommunityRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCommunityRequest) GetIconUrl() string {
+	if x != nil {
+		return x.IconUrl
+	}
+	return ""
+}
+
+type CreateCommunityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Community     *Community             `protobuf:"bytes,1,opt,name=community,proto3" json:"community,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommunityResponse) Reset() {
+	*x = CreateCommunityResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommunityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommunityResponse) ProtoMessage() {}
+
+func (x *CreateCommunityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommunityResponse.ProtoReflect.Descriptor instead.
+func (*CreateCommunityResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CreateCommunityResponse) GetCommunity() *Community {
+	if x != nil {
+		return x.Community
+	}
+	return nil
+}
+
+type UpdateCommunityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IconUrl       string                 `protobuf:"bytes,2,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCommunityRequest) Reset() {
+	*x = UpdateCommunityRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommunityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommunityRequest) ProtoMessage() {}
+
+func (x *UpdateCommunityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommunityRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCommunityRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *UpdateCommunityRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCommunityRequest) GetIconUrl() string {
+	if x != nil {
+		return x.IconUrl
+	}
+	return ""
+}
+
+type UpdateCommunityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Community     *Community             `protobuf:"bytes,1,opt,name=community,proto3" json:"community,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCommunityResponse) Reset() {
+	*x = UpdateCommunityResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommunityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommunityResponse) ProtoMessage() {}
+
+func (x *UpdateCommunityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommunityResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCommunityResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *UpdateCommunityResponse) GetCommunity() *Community {
+	if x != nil {
+		return x.Community
+	}
+	return nil
+}
+
+type DeleteCommunityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommunityRequest) Reset() {
+	*x = DeleteCommunityRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommunityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommunityRequest) ProtoMessage() {}
+
+func (x *DeleteCommunityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommunityRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommunityRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{87}
+}
+
+type DeleteCommunityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommunityResponse) Reset() {
+	*x = DeleteCommunityResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommunityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommunityResponse) ProtoMessage() {}
+
+func (x *DeleteCommunityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommunityResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommunityResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{88}
+}
+
+type CommunityUpdatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Community     *Community             `protobuf:"bytes,1,opt,name=community,proto3" json:"community,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommunityUpdatedEvent) Reset() {
+	*x = CommunityUpdatedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommunityUpdatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommunityUpdatedEvent) ProtoMessage() {}
+
+func (x *CommunityUpdatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommunityUpdatedEvent.ProtoReflect.Descriptor instead.
+func (*CommunityUpdatedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *CommunityUpdatedEvent) GetCommunity() *Community {
+	if x != nil {
+		return x.Community
+	}
+	return nil
+}
+
+type CommunityDeletedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommunityDeletedEvent) Reset() {
+	*x = CommunityDeletedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommunityDeletedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommunityDeletedEvent) ProtoMessage() {}
+
+func (x *CommunityDeletedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommunityDeletedEvent.ProtoReflect.Descriptor instead.
+func (*CommunityDeletedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *CommunityDeletedEvent) GetCommunityId() string {
+	if x != nil {
+		return x.CommunityId
+	}
+	return ""
+}
+
+type LeaveCommunityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveCommunityRequest) Reset() {
+	*x = LeaveCommunityRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveCommunityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveCommunityRequest) ProtoMessage() {}
+
+func (x *LeaveCommunityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveCommunityRequest.ProtoReflect.Descriptor instead.
+func (*LeaveCommunityRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{91}
+}
+
+type LeaveCommunityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveCommunityResponse) Reset() {
+	*x = LeaveCommunityResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveCommunityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveCommunityResponse) ProtoMessage() {}
+
+func (x *LeaveCommunityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveCommunityResponse.ProtoReflect.Descriptor instead.
+func (*LeaveCommunityResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{92}
+}
+
+type LeaveServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveServerRequest) Reset() {
+	*x = LeaveServerRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveServerRequest) ProtoMessage() {}
+
+func (x *LeaveServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveServerRequest.ProtoReflect.Descriptor instead.
+func (*LeaveServerRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{93}
+}
+
+type LeaveServerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveServerResponse) Reset() {
+	*x = LeaveServerResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveServerResponse) ProtoMessage() {}
+
+func (x *LeaveServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveServerResponse.ProtoReflect.Descriptor instead.
+func (*LeaveServerResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{94}
+}
+
+type Presence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserAddress   string                 `protobuf:"bytes,1,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	Status        PresenceStatus         `protobuf:"varint,2,opt,name=status,proto3,enum=communityserver.v1.PresenceStatus" json:"status,omitempty"`
+	CustomStatus  string                 `protobuf:"bytes,3,opt,name=custom_status,json=customStatus,proto3" json:"custom_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Presence) Reset() {
+	*x = Presence{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Presence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Presence) ProtoMessage() {}
+
+func (x *Presence) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Presence.ProtoReflect.Descriptor instead.
+func (*Presence) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *Presence) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *Presence) GetStatus() PresenceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PresenceStatus_PRESENCE_STATUS_UNSPECIFIED
+}
+
+func (x *Presence) GetCustomStatus() string {
+	if x != nil {
+		return x.CustomStatus
+	}
+	return ""
+}
+
+type PresenceUpdatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Presence      *Presence              `protobuf:"bytes,1,opt,name=presence,proto3" json:"presence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresenceUpdatedEvent) Reset() {
+	*x = PresenceUpdatedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresenceUpdatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresenceUpdatedEvent) ProtoMessage() {}
+
+func (x *PresenceUpdatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresenceUpdatedEvent.ProtoReflect.Descriptor instead.
+func (*PresenceUpdatedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *PresenceUpdatedEvent) GetPresence() *Presence {
+	if x != nil {
+		return x.Presence
+	}
+	return nil
+}
+
+type GetPresencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPresencesRequest) Reset() {
+	*x = GetPresencesRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPresencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPresencesRequest) ProtoMessage() {}
+
+func (x *GetPresencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPresencesRequest.ProtoReflect.Descriptor instead.
+func (*GetPresencesRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{97}
+}
+
+type GetPresencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Presences     []*Presence            `protobuf:"bytes,1,rep,name=presences,proto3" json:"presences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPresencesResponse) Reset() {
+	*x = GetPresencesResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPresencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPresencesResponse) ProtoMessage() {}
+
+func (x *GetPresencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPresencesResponse.ProtoReflect.Descriptor instead.
+func (*GetPresencesResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetPresencesResponse) GetPresences() []*Presence {
+	if x != nil {
+		return x.Presences
+	}
+	return nil
+}
+
+type GatewayCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          GatewayCommand_Type    `protobuf:"varint,1,opt,name=type,proto3,enum=communityserver.v1.GatewayCommand_Type" json:"type,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GatewayCommand) Reset() {
+	*x = GatewayCommand{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayCommand) ProtoMessage() {}
+
+func (x *GatewayCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayCommand.ProtoReflect.Descriptor instead.
+func (*GatewayCommand) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GatewayCommand) GetType() GatewayCommand_Type {
+	if x != nil {
+		return x.Type
+	}
+	return GatewayCommand_TYPE_UNSPECIFIED
+}
+
+func (x *GatewayCommand) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type UpdatePresenceCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        PresenceStatus         `protobuf:"varint,1,opt,name=status,proto3,enum=communityserver.v1.PresenceStatus" json:"status,omitempty"`
+	CustomStatus  string                 `protobuf:"bytes,2,opt,name=custom_status,json=customStatus,proto3" json:"custom_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePresenceCommand) Reset() {
+	*x = UpdatePresenceCommand{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePresenceCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePresenceCommand) ProtoMessage() {}
+
+func (x *UpdatePresenceCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePresenceCommand.ProtoReflect.Descriptor instead.
+func (*UpdatePresenceCommand) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *UpdatePresenceCommand) GetStatus() PresenceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PresenceStatus_PRESENCE_STATUS_UNSPECIFIED
+}
+
+func (x *UpdatePresenceCommand) GetCustomStatus() string {
+	if x != nil {
+		return x.CustomStatus
+	}
+	return ""
+}
+
+type StartTypingCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommunityId   string                 `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTypingCommand) Reset() {
+	*x = StartTypingCommand{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTypingCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTypingCommand) ProtoMessage() {}
+
+func (x *StartTypingCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTypingCommand.ProtoReflect.Descriptor instead.
+func (*StartTypingCommand) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *StartTypingCommand) GetCommunityId() string {
+	if x != nil {
+		return x.CommunityId
+	}
+	return ""
+}
+
+func (x *StartTypingCommand) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type TypingStartedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserAddress   string                 `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypingStartedEvent) Reset() {
+	*x = TypingStartedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypingStartedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypingStartedEvent) ProtoMessage() {}
+
+func (x *TypingStartedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypingStartedEvent.ProtoReflect.Descriptor instead.
+func (*TypingStartedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *TypingStartedEvent) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *TypingStartedEvent) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *TypingStartedEvent) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type ReadState struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId         string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	LastReadMessageId string                 `protobuf:"bytes,2,opt,name=last_read_message_id,json=lastReadMessageId,proto3" json:"last_read_message_id,omitempty"`
+	UnreadCount       int64                  `protobuf:"varint,3,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	MentionCount      int64                  `protobuf:"varint,4,opt,name=mention_count,json=mentionCount,proto3" json:"mention_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReadState) Reset() {
+	*x = ReadState{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadState) ProtoMessage() {}
+
+func (x *ReadState) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadState.ProtoReflect.Descriptor instead.
+func (*ReadState) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ReadState) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ReadState) GetLastReadMessageId() string {
+	if x != nil {
+		return x.LastReadMessageId
+	}
+	return ""
+}
+
+func (x *ReadState) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *ReadState) GetMentionCount() int64 {
+	if x != nil {
+		return x.MentionCount
+	}
+	return 0
+}
+
+type GetReadStatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReadStatesRequest) Reset() {
+	*x = GetReadStatesRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReadStatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadStatesRequest) ProtoMessage() {}
+
+func (x *GetReadStatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadStatesRequest.ProtoReflect.Descriptor instead.
+func (*GetReadStatesRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{104}
+}
+
+type GetReadStatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReadStates    []*ReadState           `protobuf:"bytes,1,rep,name=read_states,json=readStates,proto3" json:"read_states,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReadStatesResponse) Reset() {
+	*x = GetReadStatesResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReadStatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadStatesResponse) ProtoMessage() {}
+
+func (x *GetReadStatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadStatesResponse.ProtoReflect.Descriptor instead.
+func (*GetReadStatesResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetReadStatesResponse) GetReadStates() []*ReadState {
+	if x != nil {
+		return x.ReadStates
+	}
+	return nil
+}
+
+type AckChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AckChannelRequest) Reset() {
+	*x = AckChannelRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckChannelRequest) ProtoMessage() {}
+
+func (x *AckChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckChannelRequest.ProtoReflect.Descriptor instead.
+func (*AckChannelRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *AckChannelRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type AckChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReadState     *ReadState             `protobuf:"bytes,1,opt,name=read_state,json=readState,proto3" json:"read_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AckChannelResponse) Reset() {
+	*x = AckChannelResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckChannelResponse) ProtoMessage() {}
+
+func (x *AckChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckChannelResponse.ProtoReflect.Descriptor instead.
+func (*AckChannelResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *AckChannelResponse) GetReadState() *ReadState {
+	if x != nil {
+		return x.ReadState
+	}
+	return nil
+}
+
+type UpdateMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Body          string                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMessageRequest) Reset() {
+	*x = UpdateMessageRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMessageRequest) ProtoMessage() {}
+
+func (x *UpdateMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMessageRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMessageRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *UpdateMessageRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type UpdateMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMessageResponse) Reset() {
+	*x = UpdateMessageResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMessageResponse) ProtoMessage() {}
+
+func (x *UpdateMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMessageResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMessageResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *UpdateMessageResponse) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type DeleteMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMessageRequest) Reset() {
+	*x = DeleteMessageRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMessageRequest) ProtoMessage() {}
+
+func (x *DeleteMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMessageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMessageRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{110}
+}
+
+type DeleteMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMessageResponse) Reset() {
+	*x = DeleteMessageResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMessageResponse) ProtoMessage() {}
+
+func (x *DeleteMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMessageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMessageResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{111}
+}
+
+type MessageRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageRevision) Reset() {
+	*x = MessageRevision{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageRevision) ProtoMessage() {}
+
+func (x *MessageRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageRevision.ProtoReflect.Descriptor instead.
+func (*MessageRevision) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *MessageRevision) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MessageRevision) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *MessageRevision) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetMessageRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessageRevisionsRequest) Reset() {
+	*x = GetMessageRevisionsRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageRevisionsRequest) ProtoMessage() {}
+
+func (x *GetMessageRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*GetMessageRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{113}
+}
+
+type GetMessageRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*MessageRevision     `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessageRevisionsResponse) Reset() {
+	*x = GetMessageRevisionsResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageRevisionsResponse) ProtoMessage() {}
+
+func (x *GetMessageRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*GetMessageRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetMessageRevisionsResponse) GetRevisions() []*MessageRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type CustomEmoji struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomEmoji) Reset() {
+	*x = CustomEmoji{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomEmoji) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomEmoji) ProtoMessage() {}
+
+func (x *CustomEmoji) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomEmoji.ProtoReflect.Descriptor instead.
+func (*CustomEmoji) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *CustomEmoji) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CustomEmoji) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomEmoji) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CustomEmoji) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CustomEmoji) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetCustomEmojisRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomEmojisRequest) Reset() {
+	*x = GetCustomEmojisRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomEmojisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomEmojisRequest) ProtoMessage() {}
+
+func (x *GetCustomEmojisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomEmojisRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomEmojisRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{116}
+}
+
+type GetCustomEmojisResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Emojis        []*CustomEmoji         `protobuf:"bytes,1,rep,name=emojis,proto3" json:"emojis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomEmojisResponse) Reset() {
+	*x = GetCustomEmojisResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomEmojisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomEmojisResponse) ProtoMessage() {}
+
+func (x *GetCustomEmojisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomEmojisResponse.ProtoReflect.Descriptor instead.
+func (*GetCustomEmojisResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetCustomEmojisResponse) GetEmojis() []*CustomEmoji {
+	if x != nil {
+		return x.Emojis
+	}
+	return nil
+}
+
+type CreateCustomEmojiResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Emoji         *CustomEmoji           `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCustomEmojiResponse) Reset() {
+	*x = CreateCustomEmojiResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCustomEmojiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomEmojiResponse) ProtoMessage() {}
+
+func (x *CreateCustomEmojiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomEmojiResponse.ProtoReflect.Descriptor instead.
+func (*CreateCustomEmojiResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *CreateCustomEmojiResponse) GetEmoji() *CustomEmoji {
+	if x != nil {
+		return x.Emoji
+	}
+	return nil
+}
+
+type DeleteCustomEmojiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomEmojiRequest) Reset() {
+	*x = DeleteCustomEmojiRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomEmojiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomEmojiRequest) ProtoMessage() {}
+
+func (x *DeleteCustomEmojiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomEmojiRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCustomEmojiRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{119}
+}
+
+type DeleteCustomEmojiResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomEmojiResponse) Reset() {
+	*x = DeleteCustomEmojiResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomEmojiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomEmojiResponse) ProtoMessage() {}
+
+func (x *DeleteCustomEmojiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomEmojiResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCustomEmojiResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{120}
+}
+
+type CustomEmojiCreatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Emoji         *CustomEmoji           `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomEmojiCreatedEvent) Reset() {
+	*x = CustomEmojiCreatedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomEmojiCreatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomEmojiCreatedEvent) ProtoMessage() {}
+
+func (x *CustomEmojiCreatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomEmojiCreatedEvent.ProtoReflect.Descriptor instead.
+func (*CustomEmojiCreatedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *CustomEmojiCreatedEvent) GetEmoji() *CustomEmoji {
+	if x != nil {
+		return x.Emoji
+	}
+	return nil
+}
+
+type CustomEmojiDeletedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmojiId       string                 `protobuf:"bytes,1,opt,name=emoji_id,json=emojiId,proto3" json:"emoji_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomEmojiDeletedEvent) Reset() {
+	*x = CustomEmojiDeletedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomEmojiDeletedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomEmojiDeletedEvent) ProtoMessage() {}
+
+func (x *CustomEmojiDeletedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomEmojiDeletedEvent.ProtoReflect.Descriptor instead.
+func (*CustomEmojiDeletedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *CustomEmojiDeletedEvent) GetEmojiId() string {
+	if x != nil {
+		return x.EmojiId
+	}
+	return ""
+}
+
+type AddReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddReactionRequest) Reset() {
+	*x = AddReactionRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReactionRequest) ProtoMessage() {}
+
+func (x *AddReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReactionRequest.ProtoReflect.Descriptor instead.
+func (*AddReactionRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{123}
+}
+
+type AddReactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddReactionResponse) Reset() {
+	*x = AddReactionResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReactionResponse) ProtoMessage() {}
+
+func (x *AddReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReactionResponse.ProtoReflect.Descriptor instead.
+func (*AddReactionResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{124}
+}
+
+type RemoveReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveReactionRequest) Reset() {
+	*x = RemoveReactionRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionRequest) ProtoMessage() {}
+
+func (x *RemoveReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveReactionRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{125}
+}
+
+type RemoveReactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveReactionResponse) Reset() {
+	*x = RemoveReactionResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionResponse) ProtoMessage() {}
+
+func (x *RemoveReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveReactionResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{126}
+}
+
+type ReactionAddedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserAddress   string                 `protobuf:"bytes,3,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	Emoji         string                 `protobuf:"bytes,4,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	CustomEmojiId string                 `protobuf:"bytes,5,opt,name=custom_emoji_id,json=customEmojiId,proto3" json:"custom_emoji_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionAddedEvent) Reset() {
+	*x = ReactionAddedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionAddedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionAddedEvent) ProtoMessage() {}
+
+func (x *ReactionAddedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionAddedEvent.ProtoReflect.Descriptor instead.
+func (*ReactionAddedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ReactionAddedEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ReactionAddedEvent) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ReactionAddedEvent) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *ReactionAddedEvent) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *ReactionAddedEvent) GetCustomEmojiId() string {
+	if x != nil {
+		return x.CustomEmojiId
+	}
+	return ""
+}
+
+type ReactionRemovedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserAddress   string                 `protobuf:"bytes,3,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	Emoji         string                 `protobuf:"bytes,4,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	CustomEmojiId string                 `protobuf:"bytes,5,opt,name=custom_emoji_id,json=customEmojiId,proto3" json:"custom_emoji_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionRemovedEvent) Reset() {
+	*x = ReactionRemovedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionRemovedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionRemovedEvent) ProtoMessage() {}
+
+func (x *ReactionRemovedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionRemovedEvent.ProtoReflect.Descriptor instead.
+func (*ReactionRemovedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ReactionRemovedEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ReactionRemovedEvent) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ReactionRemovedEvent) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *ReactionRemovedEvent) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *ReactionRemovedEvent) GetCustomEmojiId() string {
+	if x != nil {
+		return x.CustomEmojiId
+	}
+	return ""
+}
+
+type SearchMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMessagesResponse) Reset() {
+	*x = SearchMessagesResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessagesResponse) ProtoMessage() {}
+
+func (x *SearchMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessagesResponse.ProtoReflect.Descriptor instead.
+func (*SearchMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *SearchMessagesResponse) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *SearchMessagesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type UploadAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *UploadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type PinnedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	PinnedBy      string                 `protobuf:"bytes,2,opt,name=pinned_by,json=pinnedBy,proto3" json:"pinned_by,omitempty"`
+	PinnedAt      string                 `protobuf:"bytes,3,opt,name=pinned_at,json=pinnedAt,proto3" json:"pinned_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinnedMessage) Reset() {
+	*x = PinnedMessage{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinnedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinnedMessage) ProtoMessage() {}
+
+func (x *PinnedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinnedMessage.ProtoReflect.Descriptor instead.
+func (*PinnedMessage) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *PinnedMessage) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *PinnedMessage) GetPinnedBy() string {
+	if x != nil {
+		return x.PinnedBy
+	}
+	return ""
+}
+
+func (x *PinnedMessage) GetPinnedAt() string {
+	if x != nil {
+		return x.PinnedAt
+	}
+	return ""
+}
+
+type GetPinnedMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPinnedMessagesRequest) Reset() {
+	*x = GetPinnedMessagesRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPinnedMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPinnedMessagesRequest) ProtoMessage() {}
+
+func (x *GetPinnedMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPinnedMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetPinnedMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{132}
+}
+
+type GetPinnedMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pins          []*PinnedMessage       `protobuf:"bytes,1,rep,name=pins,proto3" json:"pins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPinnedMessagesResponse) Reset() {
+	*x = GetPinnedMessagesResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPinnedMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPinnedMessagesResponse) ProtoMessage() {}
+
+func (x *GetPinnedMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPinnedMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetPinnedMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *GetPinnedMessagesResponse) GetPins() []*PinnedMessage {
+	if x != nil {
+		return x.Pins
+	}
+	return nil
+}
+
+type PinMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinMessageRequest) Reset() {
+	*x = PinMessageRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMessageRequest) ProtoMessage() {}
+
+func (x *PinMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMessageRequest.ProtoReflect.Descriptor instead.
+func (*PinMessageRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{134}
+}
+
+type PinMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pin           *PinnedMessage         `protobuf:"bytes,1,opt,name=pin,proto3" json:"pin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinMessageResponse) Reset() {
+	*x = PinMessageResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMessageResponse) ProtoMessage() {}
+
+func (x *PinMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMessageResponse.ProtoReflect.Descriptor instead.
+func (*PinMessageResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *PinMessageResponse) GetPin() *PinnedMessage {
+	if x != nil {
+		return x.Pin
+	}
+	return nil
+}
+
+type UnpinMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinMessageRequest) Reset() {
+	*x = UnpinMessageRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMessageRequest) ProtoMessage() {}
+
+func (x *UnpinMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMessageRequest.ProtoReflect.Descriptor instead.
+func (*UnpinMessageRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{136}
+}
+
+type UnpinMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinMessageResponse) Reset() {
+	*x = UnpinMessageResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMessageResponse) ProtoMessage() {}
+
+func (x *UnpinMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMessageResponse.ProtoReflect.Descriptor instead.
+func (*UnpinMessageResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{137}
+}
+
+type MessagePinnedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pin           *PinnedMessage         `protobuf:"bytes,1,opt,name=pin,proto3" json:"pin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessagePinnedEvent) Reset() {
+	*x = MessagePinnedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessagePinnedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessagePinnedEvent) ProtoMessage() {}
+
+func (x *MessagePinnedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessagePinnedEvent.ProtoReflect.Descriptor instead.
+func (*MessagePinnedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *MessagePinnedEvent) GetPin() *PinnedMessage {
+	if x != nil {
+		return x.Pin
+	}
+	return nil
+}
+
+type MessageUnpinnedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageUnpinnedEvent) Reset() {
+	*x = MessageUnpinnedEvent{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageUnpinnedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageUnpinnedEvent) ProtoMessage() {}
+
+func (x *MessageUnpinnedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageUnpinnedEvent.ProtoReflect.Descriptor instead.
+func (*MessageUnpinnedEvent) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *MessageUnpinnedEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *MessageUnpinnedEvent) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type UpdateSlowModeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SlowModeSeconds int32                  `protobuf:"varint,1,opt,name=slow_mode_seconds,json=slowModeSeconds,proto3" json:"slow_mode_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateSlowModeRequest) Reset() {
+	*x = UpdateSlowModeRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSlowModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSlowModeRequest) ProtoMessage() {}
+
+func (x *UpdateSlowModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSlowModeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSlowModeRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *UpdateSlowModeRequest) GetSlowModeSeconds() int32 {
+	if x != nil {
+		return x.SlowModeSeconds
+	}
+	return 0
+}
+
+type UpdateSlowModeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *Channel               `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSlowModeResponse) Reset() {
+	*x = UpdateSlowModeResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSlowModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSlowModeResponse) ProtoMessage() {}
+
+func (x *UpdateSlowModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSlowModeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSlowModeResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *UpdateSlowModeResponse) GetChannel() *Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type RateLimitedError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scope         RateLimitedError_Scope `protobuf:"varint,1,opt,name=scope,proto3,enum=communityserver.v1.RateLimitedError_Scope" json:"scope,omitempty"`
+	RetryAfterMs  int64                  `protobuf:"varint,2,opt,name=retry_after_ms,json=retryAfterMs,proto3" json:"retry_after_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitedError) Reset() {
+	*x = RateLimitedError{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitedError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitedError) ProtoMessage() {}
+
+func (x *RateLimitedError) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitedError.ProtoReflect.Descriptor instead.
+func (*RateLimitedError) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *RateLimitedError) GetScope() RateLimitedError_Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return RateLimitedError_SCOPE_UNSPECIFIED
+}
+
+func (x *RateLimitedError) GetRetryAfterMs() int64 {
+	if x != nil {
+		return x.RetryAfterMs
+	}
+	return 0
+}
+
+type RateLimitExemption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserAddress   string                 `protobuf:"bytes,1,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitExemption) Reset() {
+	*x = RateLimitExemption{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitExemption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitExemption) ProtoMessage() {}
+
+func (x *RateLimitExemption) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitExemption.ProtoReflect.Descriptor instead.
+func (*RateLimitExemption) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *RateLimitExemption) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *RateLimitExemption) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *RateLimitExemption) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetRateLimitExemptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateLimitExemptionsRequest) Reset() {
+	*x = GetRateLimitExemptionsRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateLimitExemptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateLimitExemptionsRequest) ProtoMessage() {}
+
+func (x *GetRateLimitExemptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateLimitExemptionsRequest.ProtoReflect.Descriptor instead.
+func (*GetRateLimitExemptionsRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{144}
+}
+
+type GetRateLimitExemptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exemptions    []*RateLimitExemption  `protobuf:"bytes,1,rep,name=exemptions,proto3" json:"exemptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateLimitExemptionsResponse) Reset() {
+	*x = GetRateLimitExemptionsResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateLimitExemptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateLimitExemptionsResponse) ProtoMessage() {}
+
+func (x *GetRateLimitExemptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateLimitExemptionsResponse.ProtoReflect.Descriptor instead.
+func (*GetRateLimitExemptionsResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *GetRateLimitExemptionsResponse) GetExemptions() []*RateLimitExemption {
+	if x != nil {
+		return x.Exemptions
+	}
+	return nil
+}
+
+type AddRateLimitExemptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRateLimitExemptionRequest) Reset() {
+	*x = AddRateLimitExemptionRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRateLimitExemptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRateLimitExemptionRequest) ProtoMessage() {}
+
+func (x *AddRateLimitExemptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRateLimitExemptionRequest.ProtoReflect.Descriptor instead.
+func (*AddRateLimitExemptionRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{146}
+}
+
+type AddRateLimitExemptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRateLimitExemptionResponse) Reset() {
+	*x = AddRateLimitExemptionResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRateLimitExemptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRateLimitExemptionResponse) ProtoMessage() {}
+
+func (x *AddRateLimitExemptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRateLimitExemptionResponse.ProtoReflect.Descriptor instead.
+func (*AddRateLimitExemptionResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{147}
+}
+
+type RemoveRateLimitExemptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveRateLimitExemptionRequest) Reset() {
+	*x = RemoveRateLimitExemptionRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRateLimitExemptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRateLimitExemptionRequest) ProtoMessage() {}
+
+func (x *RemoveRateLimitExemptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRateLimitExemptionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRateLimitExemptionRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{148}
+}
+
+type RemoveRateLimitExemptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveRateLimitExemptionResponse) Reset() {
+	*x = RemoveRateLimitExemptionResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRateLimitExemptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRateLimitExemptionResponse) ProtoMessage() {}
+
+func (x *RemoveRateLimitExemptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRateLimitExemptionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveRateLimitExemptionResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{149}
+}
+
+type Conversation struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParticipantAddresses []string               `protobuf:"bytes,2,rep,name=participant_addresses,json=participantAddresses,proto3" json:"participant_addresses,omitempty"`
+	CreatedBy            string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt            string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastMessageAt        string                 `protobuf:"bytes,5,opt,name=last_message_at,json=lastMessageAt,proto3" json:"last_message_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Conversation) Reset() {
+	*x = Conversation{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Conversation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Conversation) ProtoMessage() {}
+
+func (x *Conversation) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Conversation.ProtoReflect.Descriptor instead.
+func (*Conversation) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *Conversation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Conversation) GetParticipantAddresses() []string {
+	if x != nil {
+		return x.ParticipantAddresses
+	}
+	return nil
+}
+
+func (x *Conversation) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Conversation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Conversation) GetLastMessageAt() string {
+	if x != nil {
+		return x.LastMessageAt
+	}
+	return ""
+}
+
+type ConversationMessage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserAddress    string                 `protobuf:"bytes,3,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	Body           string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConversationMessage) Reset() {
+	*x = ConversationMessage{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationMessage) ProtoMessage() {}
+
+func (x *ConversationMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationMessage.ProtoReflect.Descriptor instead.
+func (*ConversationMessage) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *ConversationMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConversationMessage) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ConversationMessage) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *ConversationMessage) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *ConversationMessage) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateConversationRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantAddresses []string               `protobuf:"bytes,1,rep,name=participant_addresses,json=participantAddresses,proto3" json:"participant_addresses,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateConversationRequest) Reset() {
+	*x = CreateConversationRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConversationRequest) ProtoMessage() {}
+
+func (x *CreateConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConversationRequest.ProtoReflect.Descriptor instead.
+func (*CreateConversationRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *CreateConversationRequest) GetParticipantAddresses() []string {
+	if x != nil {
+		return x.ParticipantAddresses
+	}
+	return nil
+}
+
+type CreateConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateConversationResponse) Reset() {
+	*x = CreateConversationResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConversationResponse) ProtoMessage() {}
+
+func (x *CreateConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConversationResponse.ProtoReflect.Descriptor instead.
+func (*CreateConversationResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *CreateConversationResponse) GetConversation() *Conversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+type GetConversationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationsRequest) Reset() {
+	*x = GetConversationsRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationsRequest) ProtoMessage() {}
+
+func (x *GetConversationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationsRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationsRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{154}
+}
+
+type GetConversationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversations []*Conversation        `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationsResponse) Reset() {
+	*x = GetConversationsResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationsResponse) ProtoMessage() {}
+
+func (x *GetConversationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationsResponse.ProtoReflect.Descriptor instead.
+func (*GetConversationsResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *GetConversationsResponse) GetConversations() []*Conversation {
+	if x != nil {
+		return x.Conversations
+	}
+	return nil
+}
+
+type GetConversationMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationMessagesRequest) Reset() {
+	*x = GetConversationMessagesRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationMessagesRequest) ProtoMessage() {}
+
+func (x *GetConversationMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{156}
+}
+
+type GetConversationMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*ConversationMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationMessagesResponse) Reset() {
+	*x = GetConversationMessagesResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationMessagesResponse) ProtoMessage() {}
+
+func (x *GetConversationMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetConversationMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *GetConversationMessagesResponse) GetMessages() []*ConversationMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *GetConversationMessagesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type SendConversationMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Body          string                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendConversationMessageRequest) Reset() {
+	*x = SendConversationMessageRequest{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendConversationMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendConversationMessageRequest) ProtoMessage() {}
+
+func (x *SendConversationMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendConversationMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendConversationMessageRequest) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *SendConversationMessageRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type SendConversationMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *ConversationMessage   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendConversationMessageResponse) Reset() {
+	*x = SendConversationMessageResponse{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendConversationMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendConversationMessageResponse) ProtoMessage() {}
+
+func (x *SendConversationMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendConversationMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendConversationMessageResponse) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *SendConversationMessageResponse) GetMessage() *ConversationMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type GetUserCommunitiesResponse_Community struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IconUrl       string                 `protobuf:"bytes,3,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	Online        int64                  `protobuf:"varint,4,opt,name=online,proto3" json:"online,omitempty"`
+	UnreadCount   int64                  `protobuf:"varint,5,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	MentionCount  int64                  `protobuf:"varint,6,opt,name=mention_count,json=mentionCount,proto3" json:"mention_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserCommunitiesResponse_Community) Reset() {
+	*x = GetUserCommunitiesResponse_Community{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserCommunitiesResponse_Community) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserCommunitiesResponse_Community) ProtoMessage() {}
+
+func (x *GetUserCommunitiesResponse_Community) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserCommunitiesResponse_Community.ProtoReflect.Descriptor instead.
+func (*GetUserCommunitiesResponse_Community) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *GetUserCommunitiesResponse_Community) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetUserCommunitiesResponse_Community) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetUserCommunitiesResponse_Community) GetIconUrl() string {
+	if x != nil {
+		return x.IconUrl
+	}
+	return ""
+}
+
+func (x *GetUserCommunitiesResponse_Community) GetOnline() int64 {
+	if x != nil {
+		return x.Online
+	}
+	return 0
+}
+
+func (x *GetUserCommunitiesResponse_Community) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *GetUserCommunitiesResponse_Community) GetMentionCount() int64 {
+	if x != nil {
+		return x.MentionCount
+	}
+	return 0
+}
+
+type ResolveInviteResponse_Community struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MemberCount   int64                  `protobuf:"varint,3,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveInviteResponse_Community) Reset() {
+	*x = ResolveInviteResponse_Community{}
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveInviteResponse_Community) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveInviteResponse_Community) ProtoMessage() {}
+
+func (x *ResolveInviteResponse_Community) ProtoReflect() protoreflect.Message {
+	mi := &file_communityserver_v1_communityserver_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveInviteResponse_Community.ProtoReflect.Descriptor instead.
+func (*ResolveInviteResponse_Community) Descriptor() ([]byte, []int) {
+	return file_communityserver_v1_communityserver_proto_rawDescGZIP(), []int{61, 0}
+}
+
+func (x *ResolveInviteResponse_Community) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResolveInviteResponse_Community) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResolveInviteResponse_Community) GetMemberCount() int64 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+var File_communityserver_v1_communityserver_proto protoreflect.FileDescriptor
+
+const file_communityserver_v1_communityserver_proto_rawDesc = "" +
+	"\n" +
+	"(communityserver/v1/communityserver.proto\x12\x12communityserver.v1\"\x1b\n" +
+	"\x19GetUserCommunitiesRequest\"\xa5\x02\n" +
+	"\x1aGetUserCommunitiesResponse\x12Z\n" +
+	"\vcommunities\x18\x01 \x03(\v28.communityserver.v1.GetUserCommunitiesResponse.CommunityR\vcommunities\x1a\xaa\x01\n" +
+	"\tCommunity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bicon_url\x18\x03 \x01(\tR\aiconUrl\x12\x16\n" +
+	"\x06online\x18\x04 \x01(\x03R\x06online\x12!\n" +
+	"\funread_count\x18\x05 \x01(\x03R\vunreadCount\x12#\n" +
+	"\rmention_count\x18\x06 \x01(\x03R\fmentionCount\"j\n" +
+	"\x11JoinServerRequest\x124\n" +
+	"\x16join_default_community\x18\x01 \x01(\bR\x14joinDefaultCommunity\x12\x1f\n" +
+	"\vinvite_code\x18\x02 \x01(\tR\n" +
+	"inviteCode\"V\n" +
+	"\x12JoinServerResponse\x12!\n" +
+	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\"Y\n" +
+	"\aChannel\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12*\n" +
+	"\x11slow_mode_seconds\x18\x03 \x01(\x05R\x0fslowModeSeconds\"\x14\n" +
+	"\x12GetChannelsRequest\"N\n" +
+	"\x13GetChannelsResponse\x127\n" +
+	"\bchannels\x18\x01 \x03(\v2\x1b.communityserver.v1.ChannelR\bchannels\"*\n" +
+	"\x14CreateChannelRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"N\n" +
+	"\x15CreateChannelResponse\x125\n" +
+	"\achannel\x18\x01 \x01(\v2\x1b.communityserver.v1.ChannelR\achannel\"*\n" +
+	"\x14UpdateChannelRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"N\n" +
+	"\x15UpdateChannelResponse\x125\n" +
+	"\achannel\x18\x01 \x01(\v2\x1b.communityserver.v1.ChannelR\achannel\"\x16\n" +
+	"\x14DeleteChannelRequest\"\x17\n" +
+	"\x15DeleteChannelResponse\"\xfd\x04\n" +
+	"\aMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12!\n" +
+	"\fuser_address\x18\x03 \x01(\tR\vuserAddress\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\x12\x18\n" +
+	"\adeleted\x18\a \x01(\bR\adeleted\x12-\n" +
+	"\x13reply_to_message_id\x18\b \x01(\tR\x10replyToMessageId\x12?\n" +
+	"\breply_to\x18\t \x01(\v2$.communityserver.v1.MessageReferenceR\areplyTo\x12\x1b\n" +
+	"\tthread_id\x18\n" +
+	" \x01(\tR\bthreadId\x12,\n" +
+	"\x12thread_reply_count\x18\v \x01(\x05R\x10threadReplyCount\x125\n" +
+	"\x17thread_last_activity_at\x18\f \x01(\tR\x14threadLastActivityAt\x12:\n" +
+	"\treactions\x18\r \x03(\v2\x1c.communityserver.v1.ReactionR\treactions\x12@\n" +
+	"\vattachments\x18\x0e \x03(\v2\x1e.communityserver.v1.AttachmentR\vattachments\x12D\n" +
+	"\rlink_previews\x18\x0f \x03(\v2\x1f.communityserver.v1.LinkPreviewR\flinkPreviews\"\xaf\x01\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x14\n" +
+	"\x05width\x18\x05 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\x05R\x06height\x12\x10\n" +
+	"\x03url\x18\a \x01(\tR\x03url\"\x91\x01\n" +
+	"\vLinkPreview\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tsite_name\x18\x04 \x01(\tR\bsiteName\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\"n\n" +
+	"\bReaction\x12\x14\n" +
+	"\x05emoji\x18\x01 \x01(\tR\x05emoji\x12&\n" +
+	"\x0fcustom_emoji_id\x18\x02 \x01(\tR\rcustomEmojiId\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\x12\x0e\n" +
+	"\x02me\x18\x04 \x01(\bR\x02me\"s\n" +
+	"\x10MessageReference\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fuser_address\x18\x02 \x01(\tR\vuserAddress\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x18\n" +
+	"\adeleted\x18\x04 \x01(\bR\adeleted\"\x14\n" +
+	"\x12GetMessagesRequest\"i\n" +
+	"\x13GetMessagesResponse\x127\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1b.communityserver.v1.MessageR\bmessages\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"~\n" +
+	"\x12SendMessageRequest\x12\x12\n" +
+	"\x04body\x18\x01 \x01(\tR\x04body\x12-\n" +
+	"\x13reply_to_message_id\x18\x02 \x01(\tR\x10replyToMessageId\x12%\n" +
+	"\x0eattachment_ids\x18\x03 \x03(\tR\rattachmentIds\"L\n" +
+	"\x13SendMessageResponse\x125\n" +
+	"\amessage\x18\x01 \x01(\v2\x1b.communityserver.v1.MessageR\amessage\"\xab\x06\n" +
+	"\x05Event\x122\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1e.communityserver.v1.Event.TypeR\x04type\x12!\n" +
+	"\fcommunity_id\x18\x02 \x01(\tR\vcommunityId\x12\x18\n" +
+	"\apayload\x18\x03 \x01(\fR\apayload\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x04 \x01(\tR\tchannelId\"\x91\x05\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14TYPE_MESSAGE_CREATED\x10\x01\x12\x16\n" +
+	"\x12TYPE_MEMBER_JOINED\x10\x02\x12\x18\n" +
+	"\x14TYPE_CHANNEL_CREATED\x10\x03\x12\x18\n" +
+	"\x14TYPE_CHANNEL_UPDATED\x10\x04\x12\x18\n" +
+	"\x14TYPE_CHANNEL_DELETED\x10\x05\x12\x17\n" +
+	"\x13TYPE_MEMBER_REMOVED\x10\x06\x12\x15\n" +
+	"\x11TYPE_MEMBER_MUTED\x10\a\x12\x1a\n" +
+	"\x16TYPE_COMMUNITY_UPDATED\x10\b\x12\x1a\n" +
+	"\x16TYPE_COMMUNITY_DELETED\x10\t\x12\x19\n" +
+	"\x15TYPE_PRESENCE_UPDATED\x10\n" +
+	"\x12\x17\n" +
+	"\x13TYPE_TYPING_STARTED\x10\v\x12\x18\n" +
+	"\x14TYPE_MESSAGE_UPDATED\x10\f\x12\x18\n" +
+	"\x14TYPE_MESSAGE_DELETED\x10\r\x12\x17\n" +
+	"\x13TYPE_REACTION_ADDED\x10\x0e\x12\x19\n" +
+	"\x15TYPE_REACTION_REMOVED\x10\x0f\x12\x1d\n" +
+	"\x19TYPE_CUSTOM_EMOJI_CREATED\x10\x10\x12\x1d\n" +
+	"\x19TYPE_CUSTOM_EMOJI_DELETED\x10\x11\x12\x17\n" +
+	"\x13TYPE_MESSAGE_PINNED\x10\x12\x12\x19\n" +
+	"\x15TYPE_MESSAGE_UNPINNED\x10\x13\x12\x15\n" +
+	"\x11TYPE_ROLE_CREATED\x10\x14\x12\x15\n" +
+	"\x11TYPE_ROLE_UPDATED\x10\x15\x12\x15\n" +
+	"\x11TYPE_ROLE_DELETED\x10\x16\x12\x1a\n" +
+	"\x16TYPE_MEMBER_ROLE_ADDED\x10\x17\x12\x1c\n" +
+	"\x18TYPE_MEMBER_ROLE_REMOVED\x10\x18\"L\n" +
+	"\x13MessageCreatedEvent\x125\n" +
+	"\amessage\x18\x01 \x01(\v2\x1b.communityserver.v1.MessageR\amessage\"L\n" +
+	"\x13MessageUpdatedEvent\x125\n" +
+	"\amessage\x18\x01 \x01(\v2\x1b.communityserver.v1.MessageR\amessage\"S\n" +
+	"\x13MessageDeletedEvent\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\"6\n" +
+	"\x11MemberJoinedEvent\x12!\n" +
+	"\fuser_address\x18\x01 \x01(\tR\vuserAddress\"L\n" +
+	"\x13ChannelCreatedEvent\x125\n" +
+	"\achannel\x18\x01 \x01(\v2\x1b.communityserver.v1.ChannelR\achannel\"L\n" +
+	"\x13ChannelUpdatedEvent\x125\n" +
+	"\achannel\x18\x01 \x01(\v2\x1b.communityserver.v1.ChannelR\achannel\"4\n" +
+	"\x13ChannelDeletedEvent\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\"L\n" +
+	"\x04Role\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vpermissions\x18\x03 \x01(\x03R\vpermissions\"@\n" +
+	"\x10RoleCreatedEvent\x12,\n" +
+	"\x04role\x18\x01 \x01(\v2\x18.communityserver.v1.RoleR\x04role\"@\n" +
+	"\x10RoleUpdatedEvent\x12,\n" +
+	"\x04role\x18\x01 \x01(\v2\x18.communityserver.v1.RoleR\x04role\"+\n" +
+	"\x10RoleDeletedEvent\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\"R\n" +
+	"\x14MemberRoleAddedEvent\x12!\n" +
+	"\fuser_address\x18\x01 \x01(\tR\vuserAddress\x12\x17\n" +
+	"\arole_id\x18\x02 \x01(\tR\x06roleId\"T\n" +
+	"\x16MemberRoleRemovedEvent\x12!\n" +
+	"\fuser_address\x18\x01 \x01(\tR\vuserAddress\x12\x17\n" +
+	"\arole_id\x18\x02 \x01(\tR\x06roleId\"\x11\n" +
+	"\x0fGetRolesRequest\"B\n" +
+	"\x10GetRolesResponse\x12.\n" +
+	"\x05roles\x18\x01 \x03(\v2\x18.communityserver.v1.RoleR\x05roles\"I\n" +
+	"\x11CreateRoleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vpermissions\x18\x02 \x01(\x03R\vpermissions\"B\n" +
+	"\x12CreateRoleResponse\x12,\n" +
+	"\x04role\x18\x01 \x01(\v2\x18.communityserver.v1.RoleR\x04role\"I\n" +
+	"\x11UpdateRoleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vpermissions\x18\x02 \x01(\x03R\vpermissions\"B\n" +
+	"\x12UpdateRoleResponse\x12,\n" +
+	"\x04role\x18\x01 \x01(\v2\x18.communityserver.v1.RoleR\x04role\"\x13\n" +
+	"\x11DeleteRoleRequest\"\x14\n" +
+	"\x12DeleteRoleResponse\"\x13\n" +
+	"\x11AssignRoleRequest\"\x14\n" +
+	"\x12AssignRoleResponse\"\x15\n" +
+	"\x13UnassignRoleRequest\"\x16\n" +
+	"\x14UnassignRoleResponse\"\xa4\x02\n" +
+	"\x13PermissionOverwrite\x12S\n" +
+	"\vtarget_type\x18\x01 \x01(\x0e22.communityserver.v1.PermissionOverwrite.TargetTypeR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\x12\x14\n" +
+	"\x05allow\x18\x03 \x01(\x03R\x05allow\x12\x12\n" +
+	"\x04deny\x18\x04 \x01(\x03R\x04deny\"q\n" +
+	"\n" +
+	"TargetType\x12\x1b\n" +
+	"\x17TARGET_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14TARGET_TYPE_EVERYONE\x10\x01\x12\x14\n" +
+	"\x10TARGET_TYPE_ROLE\x10\x02\x12\x16\n" +
+	"\x12TARGET_TYPE_MEMBER\x10\x03\"\x1d\n" +
+	"\x1bGetChannelOverwritesRequest\"g\n" +
+	"\x1cGetChannelOverwritesResponse\x12G\n" +
+	"\n" +
+	"overwrites\x18\x01 \x03(\v2'.communityserver.v1.PermissionOverwriteR\n" +
+	"overwrites\"c\n" +
+	"\x1aSetChannelOverwriteRequest\x12E\n" +
+	"\toverwrite\x18\x01 \x01(\v2'.communityserver.v1.PermissionOverwriteR\toverwrite\"\x1d\n" +
+	"\x1bSetChannelOverwriteResponse\"\xfd\x01\n" +
+	"\x06Invite\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12!\n" +
+	"\fcommunity_id\x18\x02 \x01(\tR\vcommunityId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x120\n" +
+	"\x14creator_user_address\x18\x04 \x01(\tR\x12creatorUserAddress\x12\x19\n" +
+	"\bmax_uses\x18\x05 \x01(\x05R\amaxUses\x12\x12\n" +
+	"\x04uses\x18\x06 \x01(\x05R\x04uses\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\tR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"\x13\n" +
+	"\x11GetInvitesRequest\"J\n" +
+	"\x12GetInvitesResponse\x124\n" +
+	"\ainvites\x18\x01 \x03(\v2\x1a.communityserver.v1.InviteR\ainvites\"w\n" +
+	"\x13CreateInviteRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x19\n" +
+	"\bmax_uses\x18\x02 \x01(\x05R\amaxUses\x12&\n" +
+	"\x0fmax_age_seconds\x18\x03 \x01(\x03R\rmaxAgeSeconds\"J\n" +
+	"\x14CreateInviteResponse\x122\n" +
+	"\x06invite\x18\x01 \x01(\v2\x1a.communityserver.v1.InviteR\x06invite\"\x15\n" +
+	"\x13RevokeInviteRequest\"\x16\n" +
+	"\x14RevokeInviteResponse\"\x16\n" +
+	"\x14ResolveInviteRequest\"\xa9\x02\n" +
+	"\x15ResolveInviteResponse\x122\n" +
+	"\x06invite\x18\x01 \x01(\v2\x1a.communityserver.v1.InviteR\x06invite\x12Q\n" +
+	"\tcommunity\x18\x02 \x01(\v23.communityserver.v1.ResolveInviteResponse.CommunityR\tcommunity\x125\n" +
+	"\achannel\x18\x03 \x01(\v2\x1b.communityserver.v1.ChannelR\achannel\x1aR\n" +
+	"\tCommunity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\fmember_count\x18\x03 \x01(\x03R\vmemberCount\"7\n" +
+	"\x12MemberRemovedEvent\x12!\n" +
+	"\fuser_address\x18\x01 \x01(\tR\vuserAddress\"V\n" +
+	"\x10MemberMutedEvent\x12!\n" +
+	"\fuser_address\x18\x01 \x01(\tR\vuserAddress\x12\x1f\n" +
+	"\vmuted_until\x18\x02 \x01(\tR\n" +
+	"mutedUntil\"\x13\n" +
+	"\x11KickMemberRequest\"\x14\n" +
+	"\x12KickMemberResponse\">\n" +
+	"\x11MuteMemberRequest\x12)\n" +
+	"\x10duration_seconds\x18\x01 \x01(\x03R\x0fdurationSeconds\"5\n" +
+	"\x12MuteMemberResponse\x12\x1f\n" +
+	"\vmuted_until\x18\x01 \x01(\tR\n" +
+	"mutedUntil\"\x15\n" +
+	"\x13UnmuteMemberRequest\"\x16\n" +
+	"\x14UnmuteMemberResponse\"\xbf\x01\n" +
+	"\x03Ban\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fuser_address\x18\x02 \x01(\tR\vuserAddress\x12\x12\n" +
+	"\x04host\x18\x03 \x01(\tR\x04host\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1b\n" +
+	"\tbanned_by\x18\x05 \x01(\tR\bbannedBy\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\tR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"\x10\n" +
+	"\x0eGetBansRequest\">\n" +
+	"\x0fGetBansResponse\x12+\n" +
+	"\x04bans\x18\x01 \x03(\v2\x17.communityserver.v1.BanR\x04bans\"\x8c\x01\n" +
+	"\x10CreateBanRequest\x12!\n" +
+	"\fuser_address\x18\x01 \x01(\tR\vuserAddress\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12)\n" +
+	"\x10duration_seconds\x18\x04 \x01(\x03R\x0fdurationSeconds\">\n" +
+	"\x11CreateBanResponse\x12)\n" +
+	"\x03ban\x18\x01 \x01(\v2\x17.communityserver.v1.BanR\x03ban\"\x12\n" +
+	"\x10DeleteBanRequest\"\x13\n" +
+	"\x11DeleteBanResponse\"\xd7\a\n" +
+	"\rAuditLogEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
+	"\x12actor_user_address\x18\x02 \x01(\tR\x10actorUserAddress\x12@\n" +
+	"\x06action\x18\x03 \x01(\x0e2(.communityserver.v1.AuditLogEntry.ActionR\x06action\x12\x1b\n" +
+	"\ttarget_id\x18\x04 \x01(\tR\btargetId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06before\x18\x06 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\a \x01(\tR\x05after\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"\xc3\x05\n" +
+	"\x06Action\x12\x16\n" +
+	"\x12ACTION_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ACTION_CHANNEL_CREATE\x10\x01\x12\x19\n" +
+	"\x15ACTION_CHANNEL_UPDATE\x10\x02\x12\x19\n" +
+	"\x15ACTION_CHANNEL_DELETE\x10\x03\x12#\n" +
+	"\x1fACTION_CHANNEL_OVERWRITE_UPDATE\x10\x04\x12\x16\n" +
+	"\x12ACTION_ROLE_CREATE\x10\x05\x12\x16\n" +
+	"\x12ACTION_ROLE_UPDATE\x10\x06\x12\x16\n" +
+	"\x12ACTION_ROLE_DELETE\x10\a\x12\x1a\n" +
+	"\x16ACTION_MEMBER_ROLE_ADD\x10\b\x12\x1d\n" +
+	"\x19ACTION_MEMBER_ROLE_REMOVE\x10\t\x12\x16\n" +
+	"\x12ACTION_MEMBER_KICK\x10\n" +
+	"\x12\x16\n" +
+	"\x12ACTION_MEMBER_MUTE\x10\v\x12\x18\n" +
+	"\x14ACTION_MEMBER_UNMUTE\x10\f\x12\x15\n" +
+	"\x11ACTION_BAN_CREATE\x10\r\x12\x15\n" +
+	"\x11ACTION_BAN_DELETE\x10\x0e\x12\x18\n" +
+	"\x14ACTION_INVITE_REVOKE\x10\x0f\x12\x1b\n" +
+	"\x17ACTION_COMMUNITY_UPDATE\x10\x10\x12\x19\n" +
+	"\x15ACTION_MESSAGE_DELETE\x10\x11\x12\x1e\n" +
+	"\x1aACTION_CUSTOM_EMOJI_CREATE\x10\x12\x12\x1e\n" +
+	"\x1aACTION_CUSTOM_EMOJI_DELETE\x10\x13\x12\x16\n" +
+	"\x12ACTION_MESSAGE_PIN\x10\x14\x12\x18\n" +
+	"\x14ACTION_MESSAGE_UNPIN\x10\x15\x12&\n" +
+	"\"ACTION_RATE_LIMIT_EXEMPTION_CREATE\x10\x16\x12&\n" +
+	"\"ACTION_RATE_LIMIT_EXEMPTION_DELETE\x10\x17\x12\x1c\n" +
+	"\x18ACTION_BAN_MEMBER_REMOVE\x10\x18\"\x14\n" +
+	"\x12GetAuditLogRequest\"m\n" +
+	"\x13GetAuditLogResponse\x12;\n" +
+	"\aentries\x18\x01 \x03(\v2!.communityserver.v1.AuditLogEntryR\aentries\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"i\n" +
+	"\tCommunity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bicon_url\x18\x03 \x01(\tR\aiconUrl\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x04 \x01(\bR\tisDefault\"\x15\n" +
+	"\x13GetCommunityRequest\"S\n" +
+	"\x14GetCommunityResponse\x12;\n" +
+	"\tcommunity\x18\x01 \x01(\v2\x1d.communityserver.v1.CommunityR\tcommunity\"G\n" +
+	"\x16CreateCommunityRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\bicon_url\x18\x02 \x01(\tR\aiconUrl\"V\n" +
+	"\x17CreateCommunityResponse\x12;\n" +
+	"\tcommunity\x18\x01 \x01(\v2\x1d.communityserver.v1.CommunityR\tcommunity\"G\n" +
+	"\x16UpdateCommunityRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\bicon_url\x18\x02 \x01(\tR\aiconUrl\"V\n" +
+	"\x17UpdateCommunityResponse\x12;\n" +
+	"\tcommunity\x18\x01 \x01(\v2\x1d.communityserver.v1.CommunityR\tcommunity\"\x18\n" +
+	"\x16DeleteCommunityRequest\"\x19\n" +
+	"\x17DeleteCommunityResponse\"T\n" +
+	"\x15CommunityUpdatedEvent\x12;\n" +
+	"\tcommunity\x18\x01 \x01(\v2\x1d.communityserver.v1.CommunityR\tcommunity\":\n" +
+	"\x15CommunityDeletedEvent\x12!\n" +
+	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\"\x17\n" +
+	"\x15LeaveCommunityRequest\"\x18\n" +
+	"\x16LeaveCommunityResponse\"\x14\n" +
+	"\x12LeaveServerRequest\"\x15\n" +
+	"\x13LeaveServerResponse\"\x8e\x01\n" +
+	"\bPresence\x12!\n" +
+	"\fuser_address\x18\x01 \x01(\tR\vuserAddress\x12:\n" +
+	"\x06status\x18\x02 \x01(\x0e2\".communityserver.v1.PresenceStatusR\x06status\x12#\n" +
+	"\rcustom_status\x18\x03 \x01(\tR\fcustomStatus\"P\n" +
+	"\x14PresenceUpdatedEvent\x128\n" +
+	"\bpresence\x18\x01 \x01(\v2\x1c.communityserver.v1.PresenceR\bpresence\"\x15\n" +
+	"\x13GetPresencesRequest\"R\n" +
+	"\x14GetPresencesResponse\x12:\n" +
+	"\tpresences\x18\x01 \x03(\v2\x1c.communityserver.v1.PresenceR\tpresences\"\xb6\x01\n" +
+	"\x0eGatewayCommand\x12;\n" +
+	"\x04type\x18\x01 \x01(\x0e2'.communityserver.v1.GatewayCommand.TypeR\x04type\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\fR\apayload\"M\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14TYPE_UPDATE_PRESENCE\x10\x01\x12\x15\n" +
+	"\x11TYPE_START_TYPING\x10\x02\"x\n" +
+	"\x15UpdatePresenceCommand\x12:\n" +
+	"\x06status\x18\x01 \x01(\x0e2\".communityserver.v1.PresenceStatusR\x06status\x12#\n" +
+	"\rcustom_status\x18\x02 \x01(\tR\fcustomStatus\"V\n" +
+	"\x12StartTypingCommand\x12!\n" +
+	"\fcommunity_id\x18\x01 \x01(\tR\vcommunityId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\"u\n" +
+	"\x12TypingStartedEvent\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12!\n" +
+	"\fuser_address\x18\x02 \x01(\tR\vuserAddress\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\tR\texpiresAt\"\xa3\x01\n" +
+	"\tReadState\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12/\n" +
+	"\x14last_read_message_id\x18\x02 \x01(\tR\x11lastReadMessageId\x12!\n" +
+	"\funread_count\x18\x03 \x01(\x03R\vunreadCount\x12#\n" +
+	"\rmention_count\x18\x04 \x01(\x03R\fmentionCount\"\x16\n" +
+	"\x14GetReadStatesRequest\"W\n" +
+	"\x15GetReadStatesResponse\x12>\n" +
+	"\vread_states\x18\x01 \x03(\v2\x1d.communityserver.v1.ReadStateR\n" +
+	"readStates\"2\n" +
+	"\x11AckChannelRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\"R\n" +
+	"\x12AckChannelResponse\x12<\n" +
+	"\n" +
+	"read_state\x18\x01 \x01(\v2\x1d.communityserver.v1.ReadStateR\treadState\"*\n" +
+	"\x14UpdateMessageRequest\x12\x12\n" +
+	"\x04body\x18\x01 \x01(\tR\x04body\"N\n" +
+	"\x15UpdateMessageResponse\x125\n" +
+	"\amessage\x18\x01 \x01(\v2\x1b.communityserver.v1.MessageR\amessage\"\x16\n" +
+	"\x14DeleteMessageRequest\"\x17\n" +
+	"\x15DeleteMessageResponse\"T\n" +
+	"\x0fMessageRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\"\x1c\n" +
+	"\x1aGetMessageRevisionsRequest\"`\n" +
+	"\x1bGetMessageRevisionsResponse\x12A\n" +
+	"\trevisions\x18\x01 \x03(\v2#.communityserver.v1.MessageRevisionR\trevisions\"\x8c\x01\n" +
+	"\vCustomEmoji\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\timage_url\x18\x03 \x01(\tR\bimageUrl\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\x18\n" +
+	"\x16GetCustomEmojisRequest\"R\n" +
+	"\x17GetCustomEmojisResponse\x127\n" +
+	"\x06emojis\x18\x01 \x03(\v2\x1f.communityserver.v1.CustomEmojiR\x06emojis\"R\n" +
+	"\x19CreateCustomEmojiResponse\x125\n" +
+	"\x05emoji\x18\x01 \x01(\v2\x1f.communityserver.v1.CustomEmojiR\x05emoji\"\x1a\n" +
+	"\x18DeleteCustomEmojiRequest\"\x1b\n" +
+	"\x19DeleteCustomEmojiResponse\"P\n" +
+	"\x17CustomEmojiCreatedEvent\x125\n" +
+	"\x05emoji\x18\x01 \x01(\v2\x1f.communityserver.v1.CustomEmojiR\x05emoji\"4\n" +
+	"\x17CustomEmojiDeletedEvent\x12\x19\n" +
+	"\bemoji_id\x18\x01 \x01(\tR\aemojiId\"\x14\n" +
+	"\x12AddReactionRequest\"\x15\n" +
+	"\x13AddReactionResponse\"\x17\n" +
+	"\x15RemoveReactionRequest\"\x18\n" +
+	"\x16RemoveReactionResponse\"\xb3\x01\n" +
+	"\x12ReactionAddedEvent\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12!\n" +
+	"\fuser_address\x18\x03 \x01(\tR\vuserAddress\x12\x14\n" +
+	"\x05emoji\x18\x04 \x01(\tR\x05emoji\x12&\n" +
+	"\x0fcustom_emoji_id\x18\x05 \x01(\tR\rcustomEmojiId\"\xb5\x01\n" +
+	"\x14ReactionRemovedEvent\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12!\n" +
+	"\fuser_address\x18\x03 \x01(\tR\vuserAddress\x12\x14\n" +
+	"\x05emoji\x18\x04 \x01(\tR\x05emoji\x12&\n" +
+	"\x0fcustom_emoji_id\x18\x05 \x01(\tR\rcustomEmojiId\"l\n" +
+	"\x16SearchMessagesResponse\x127\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1b.communityserver.v1.MessageR\bmessages\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"Z\n" +
+	"\x18UploadAttachmentResponse\x12>\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x1e.communityserver.v1.AttachmentR\n" +
+	"attachment\"\x80\x01\n" +
+	"\rPinnedMessage\x125\n" +
+	"\amessage\x18\x01 \x01(\v2\x1b.communityserver.v1.MessageR\amessage\x12\x1b\n" +
+	"\tpinned_by\x18\x02 \x01(\tR\bpinnedBy\x12\x1b\n" +
+	"\tpinned_at\x18\x03 \x01(\tR\bpinnedAt\"\x1a\n" +
+	"\x18GetPinnedMessagesRequest\"R\n" +
+	"\x19GetPinnedMessagesResponse\x125\n" +
+	"\x04pins\x18\x01 \x03(\v2!.communityserver.v1.PinnedMessageR\x04pins\"\x13\n" +
+	"\x11PinMessageRequest\"I\n" +
+	"\x12PinMessageResponse\x123\n" +
+	"\x03pin\x18\x01 \x01(\v2!.communityserver.v1.PinnedMessageR\x03pin\"\x15\n" +
+	"\x13UnpinMessageRequest\"\x16\n" +
+	"\x14UnpinMessageResponse\"I\n" +
+	"\x12MessagePinnedEvent\x123\n" +
+	"\x03pin\x18\x01 \x01(\v2!.communityserver.v1.PinnedMessageR\x03pin\"T\n" +
+	"\x14MessageUnpinnedEvent\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\"C\n" +
+	"\x15UpdateSlowModeRequest\x12*\n" +
+	"\x11slow_mode_seconds\x18\x01 \x01(\x05R\x0fslowModeSeconds\"O\n" +
+	"\x16UpdateSlowModeResponse\x125\n" +
+	"\achannel\x18\x01 \x01(\v2\x1b.communityserver.v1.ChannelR\achannel\"\xc0\x01\n" +
+	"\x10RateLimitedError\x12@\n" +
+	"\x05scope\x18\x01 \x01(\x0e2*.communityserver.v1.RateLimitedError.ScopeR\x05scope\x12$\n" +
+	"\x0eretry_after_ms\x18\x02 \x01(\x03R\fretryAfterMs\"D\n" +
+	"\x05Scope\x12\x15\n" +
+	"\x11SCOPE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fSCOPE_SLOW_MODE\x10\x01\x12\x0f\n" +
+	"\vSCOPE_BURST\x10\x02\"u\n" +
+	"\x12RateLimitExemption\x12!\n" +
+	"\fuser_address\x18\x01 \x01(\tR\vuserAddress\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x02 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\"\x1f\n" +
+	"\x1dGetRateLimitExemptionsRequest\"h\n" +
+	"\x1eGetRateLimitExemptionsResponse\x12F\n" +
+	"\n" +
+	"exemptions\x18\x01 \x03(\v2&.communityserver.v1.RateLimitExemptionR\n" +
+	"exemptions\"\x1e\n" +
+	"\x1cAddRateLimitExemptionRequest\"\x1f\n" +
+	"\x1dAddRateLimitExemptionResponse\"!\n" +
+	"\x1fRemoveRateLimitExemptionRequest\"\"\n" +
+	" RemoveRateLimitExemptionResponse\"\xb9\x01\n" +
+	"\fConversation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x123\n" +
+	"\x15participant_addresses\x18\x02 \x03(\tR\x14participantAddresses\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12&\n" +
+	"\x0flast_message_at\x18\x05 \x01(\tR\rlastMessageAt\"\xa4\x01\n" +
+	"\x13ConversationMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12!\n" +
+	"\fuser_address\x18\x03 \x01(\tR\vuserAddress\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"P\n" +
+	"\x19CreateConversationRequest\x123\n" +
+	"\x15participant_addresses\x18\x01 \x03(\tR\x14participantAddresses\"b\n" +
+	"\x1aCreateConversationResponse\x12D\n" +
+	"\fconversation\x18\x01 \x01(\v2 .communityserver.v1.ConversationR\fconversation\"\x19\n" +
+	"\x17GetConversationsRequest\"b\n" +
+	"\x18GetConversationsResponse\x12F\n" +
+	"\rconversations\x18\x01 \x03(\v2 .communityserver.v1.ConversationR\rconversations\" \n" +
+	"\x1eGetConversationMessagesRequest\"\x81\x01\n" +
+	"\x1fGetConversationMessagesResponse\x12C\n" +
+	"\bmessages\x18\x01 \x03(\v2'.communityserver.v1.ConversationMessageR\bmessages\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"4\n" +
+	"\x1eSendConversationMessageRequest\x12\x12\n" +
+	"\x04body\x18\x01 \x01(\tR\x04body\"d\n" +
+	"\x1fSendConversationMessageResponse\x12A\n" +
+	"\amessage\x18\x01 \x01(\v2'.communityserver.v1.ConversationMessageR\amessage*\xd9\x03\n" +
+	"\n" +
+	"Permission\x12\x1a\n" +
+	"\x16PERMISSION_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aPERMISSION_MANAGE_CHANNELS\x10\x01\x12\x1e\n" +
+	"\x1aPERMISSION_MANAGE_MESSAGES\x10\x02\x12\x1b\n" +
+	"\x17PERMISSION_KICK_MEMBERS\x10\x04\x12\x1a\n" +
+	"\x16PERMISSION_BAN_MEMBERS\x10\b\x12\x1b\n" +
+	"\x17PERMISSION_MANAGE_ROLES\x10\x10\x12\x1c\n" +
+	"\x18PERMISSION_ADMINISTRATOR\x10 \x12\x1b\n" +
+	"\x17PERMISSION_VIEW_CHANNEL\x10@\x12\x1d\n" +
+	"\x18PERMISSION_SEND_MESSAGES\x10\x80\x01\x12\x1e\n" +
+	"\x19PERMISSION_MANAGE_INVITES\x10\x80\x02\x12\x1c\n" +
+	"\x17PERMISSION_MUTE_MEMBERS\x10\x80\x04\x12\x1e\n" +
+	"\x19PERMISSION_VIEW_AUDIT_LOG\x10\x80\b\x12 \n" +
+	"\x1bPERMISSION_MANAGE_COMMUNITY\x10\x80\x10\x12\x1d\n" +
+	"\x18PERMISSION_MANAGE_EMOJIS\x10\x80 \x12 \n" +
+	"\x1bPERMISSION_MENTION_EVERYONE\x10\x80@*\xa8\x01\n" +
+	"\x0ePresenceStatus\x12\x1f\n" +
+	"\x1bPRESENCE_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16PRESENCE_STATUS_ONLINE\x10\x01\x12\x18\n" +
+	"\x14PRESENCE_STATUS_IDLE\x10\x02\x12\"\n" +
+	"\x1ePRESENCE_STATUS_DO_NOT_DISTURB\x10\x03\x12\x1b\n" +
+	"\x17PRESENCE_STATUS_OFFLINE\x10\x04B\xf2\x01\n" +
 	"\x16com.communityserver.v1B\x14CommunityserverProtoP\x01ZYgithub.com/varso/protchat-server/internal/models/gen/communityserver/v1;communityserverv1\xa2\x02\x03CXX\xaa\x02\x12Communityserver.V1\xca\x02\x12Communityserver\\V1\xe2\x02\x1eCommunityserver\\V1\\GPBMetadata\xea\x02\x13Communityserver::V1b\x06proto3"
 
 var (
@@ -261,21 +8827,246 @@ func file_communityserver_v1_communityserver_proto_rawDescGZIP() []byte {
 	return file_communityserver_v1_communityserver_proto_rawDescData
 }
 
-var file_communityserver_v1_communityserver_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_communityserver_v1_communityserver_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_communityserver_v1_communityserver_proto_msgTypes = make([]protoimpl.MessageInfo, 162)
 var file_communityserver_v1_communityserver_proto_goTypes = []any{
-	(*GetUserCommunitiesRequest)(nil),            // 0: communityserver.v1.GetUserCommunitiesRequest
-	(*GetUserCommunitiesResponse)(nil),           // 1: communityserver.v1.GetUserCommunitiesResponse
-	(*JoinServerRequest)(nil),                    // 2: communityserver.v1.JoinServerRequest
-	(*JoinServerResponse)(nil),                   // 3: communityserver.v1.JoinServerResponse
-	(*GetUserCommunitiesResponse_Community)(nil), // 4: communityserver.v1.GetUserCommunitiesResponse.Community
+	(Permission)(0),                              // 0: communityserver.v1.Permission
+	(PresenceStatus)(0),                          // 1: communityserver.v1.PresenceStatus
+	(Event_Type)(0),                              // 2: communityserver.v1.Event.Type
+	(PermissionOverwrite_TargetType)(0),          // 3: communityserver.v1.PermissionOverwrite.TargetType
+	(AuditLogEntry_Action)(0),                    // 4: communityserver.v1.AuditLogEntry.Action
+	(GatewayCommand_Type)(0),                     // 5: communityserver.v1.GatewayCommand.Type
+	(RateLimitedError_Scope)(0),                  // 6: communityserver.v1.RateLimitedError.Scope
+	(*GetUserCommunitiesRequest)(nil),            // 7: communityserver.v1.GetUserCommunitiesRequest
+	(*GetUserCommunitiesResponse)(nil),           // 8: communityserver.v1.GetUserCommunitiesResponse
+	(*JoinServerRequest)(nil),                    // 9: communityserver.v1.JoinServerRequest
+	(*JoinServerResponse)(nil),                   // 10: communityserver.v1.JoinServerResponse
+	(*Channel)(nil),                              // 11: communityserver.v1.Channel
+	(*GetChannelsRequest)(nil),                   // 12: communityserver.v1.GetChannelsRequest
+	(*GetChannelsResponse)(nil),                  // 13: communityserver.v1.GetChannelsResponse
+	(*CreateChannelRequest)(nil),                 // 14: communityserver.v1.CreateChannelRequest
+	(*CreateChannelResponse)(nil),                // 15: communityserver.v1.CreateChannelResponse
+	(*UpdateChannelRequest)(nil),                 // 16: communityserver.v1.UpdateChannelRequest
+	(*UpdateChannelResponse)(nil),                // 17: communityserver.v1.UpdateChannelResponse
+	(*DeleteChannelRequest)(nil),                 // 18: communityserver.v1.DeleteChannelRequest
+	(*DeleteChannelResponse)(nil),                // 19: communityserver.v1.DeleteChannelResponse
+	(*Message)(nil),                              // 20: communityserver.v1.Message
+	(*Attachment)(nil),                           // 21: communityserver.v1.Attachment
+	(*LinkPreview)(nil),                          // 22: communityserver.v1.LinkPreview
+	(*Reaction)(nil),                             // 23: communityserver.v1.Reaction
+	(*MessageReference)(nil),                     // 24: communityserver.v1.MessageReference
+	(*GetMessagesRequest)(nil),                   // 25: communityserver.v1.GetMessagesRequest
+	(*GetMessagesResponse)(nil),                  // 26: communityserver.v1.GetMessagesResponse
+	(*SendMessageRequest)(nil),                   // 27: communityserver.v1.SendMessageRequest
+	(*SendMessageResponse)(nil),                  // 28: communityserver.v1.SendMessageResponse
+	(*Event)(nil),                                // 29: communityserver.v1.Event
+	(*MessageCreatedEvent)(nil),                  // 30: communityserver.v1.MessageCreatedEvent
+	(*MessageUpdatedEvent)(nil),                  // 31: communityserver.v1.MessageUpdatedEvent
+	(*MessageDeletedEvent)(nil),                  // 32: communityserver.v1.MessageDeletedEvent
+	(*MemberJoinedEvent)(nil),                    // 33: communityserver.v1.MemberJoinedEvent
+	(*ChannelCreatedEvent)(nil),                  // 34: communityserver.v1.ChannelCreatedEvent
+	(*ChannelUpdatedEvent)(nil),                  // 35: communityserver.v1.ChannelUpdatedEvent
+	(*ChannelDeletedEvent)(nil),                  // 36: communityserver.v1.ChannelDeletedEvent
+	(*Role)(nil),                                 // 37: communityserver.v1.Role
+	(*RoleCreatedEvent)(nil),                     // 38: communityserver.v1.RoleCreatedEvent
+	(*RoleUpdatedEvent)(nil),                     // 39: communityserver.v1.RoleUpdatedEvent
+	(*RoleDeletedEvent)(nil),                     // 40: communityserver.v1.RoleDeletedEvent
+	(*MemberRoleAddedEvent)(nil),                 // 41: communityserver.v1.MemberRoleAddedEvent
+	(*MemberRoleRemovedEvent)(nil),               // 42: communityserver.v1.MemberRoleRemovedEvent
+	(*GetRolesRequest)(nil),                      // 43: communityserver.v1.GetRolesRequest
+	(*GetRolesResponse)(nil),                     // 44: communityserver.v1.GetRolesResponse
+	(*CreateRoleRequest)(nil),                    // 45: communityserver.v1.CreateRoleRequest
+	(*CreateRoleResponse)(nil),                   // 46: communityserver.v1.CreateRoleResponse
+	(*UpdateRoleRequest)(nil),                    // 47: communityserver.v1.UpdateRoleRequest
+	(*UpdateRoleResponse)(nil),                   // 48: communityserver.v1.UpdateRoleResponse
+	(*DeleteRoleRequest)(nil),                    // 49: communityserver.v1.DeleteRoleRequest
+	(*DeleteRoleResponse)(nil),                   // 50: communityserver.v1.DeleteRoleResponse
+	(*AssignRoleRequest)(nil),                    // 51: communityserver.v1.AssignRoleRequest
+	(*AssignRoleResponse)(nil),                   // 52: communityserver.v1.AssignRoleResponse
+	(*UnassignRoleRequest)(nil),                  // 53: communityserver.v1.UnassignRoleRequest
+	(*UnassignRoleResponse)(nil),                 // 54: communityserver.v1.UnassignRoleResponse
+	(*PermissionOverwrite)(nil),                  // 55: communityserver.v1.PermissionOverwrite
+	(*GetChannelOverwritesRequest)(nil),          // 56: communityserver.v1.GetChannelOverwritesRequest
+	(*GetChannelOverwritesResponse)(nil),         // 57: communityserver.v1.GetChannelOverwritesResponse
+	(*SetChannelOverwriteRequest)(nil),           // 58: communityserver.v1.SetChannelOverwriteRequest
+	(*SetChannelOverwriteResponse)(nil),          // 59: communityserver.v1.SetChannelOverwriteResponse
+	(*Invite)(nil),                               // 60: communityserver.v1.Invite
+	(*GetInvitesRequest)(nil),                    // 61: communityserver.v1.GetInvitesRequest
+	(*GetInvitesResponse)(nil),                   // 62: communityserver.v1.GetInvitesResponse
+	(*CreateInviteRequest)(nil),                  // 63: communityserver.v1.CreateInviteRequest
+	(*CreateInviteResponse)(nil),                 // 64: communityserver.v1.CreateInviteResponse
+	(*RevokeInviteRequest)(nil),                  // 65: communityserver.v1.RevokeInviteRequest
+	(*RevokeInviteResponse)(nil),                 // 66: communityserver.v1.RevokeInviteResponse
+	(*ResolveInviteRequest)(nil),                 // 67: communityserver.v1.ResolveInviteRequest
+	(*ResolveInviteResponse)(nil),                // 68: communityserver.v1.ResolveInviteResponse
+	(*MemberRemovedEvent)(nil),                   // 69: communityserver.v1.MemberRemovedEvent
+	(*MemberMutedEvent)(nil),                     // 70: communityserver.v1.MemberMutedEvent
+	(*KickMemberRequest)(nil),                    // 71: communityserver.v1.KickMemberRequest
+	(*KickMemberResponse)(nil),                   // 72: communityserver.v1.KickMemberResponse
+	(*MuteMemberRequest)(nil),                    // 73: communityserver.v1.MuteMemberRequest
+	(*MuteMemberResponse)(nil),                   // 74: communityserver.v1.MuteMemberResponse
+	(*UnmuteMemberRequest)(nil),                  // 75: communityserver.v1.UnmuteMemberRequest
+	(*UnmuteMemberResponse)(nil),                 // 76: communityserver.v1.UnmuteMemberResponse
+	(*Ban)(nil),                                  // 77: communityserver.v1.Ban
+	(*GetBansRequest)(nil),                       // 78: communityserver.v1.GetBansRequest
+	(*GetBansResponse)(nil),                      // 79: communityserver.v1.GetBansResponse
+	(*CreateBanRequest)(nil),                     // 80: communityserver.v1.CreateBanRequest
+	(*CreateBanResponse)(nil),                    // 81: communityserver.v1.CreateBanResponse
+	(*DeleteBanRequest)(nil),                     // 82: communityserver.v1.DeleteBanRequest
+	(*DeleteBanResponse)(nil),                    // 83: communityserver.v1.DeleteBanResponse
+	(*AuditLogEntry)(nil),                        // 84: communityserver.v1.AuditLogEntry
+	(*GetAuditLogRequest)(nil),                   // 85: communityserver.v1.GetAuditLogRequest
+	(*GetAuditLogResponse)(nil),                  // 86: communityserver.v1.GetAuditLogResponse
+	(*Community)(nil),                            // 87: communityserver.v1.Community
+	(*GetCommunityRequest)(nil),                  // 88: communityserver.v1.GetCommunityRequest
+	(*GetCommunityResponse)(nil),                 // 89: communityserver.v1.GetCommunityResponse
+	(*CreateCommunityRequest)(nil),               // 90: communityserver.v1.CreateCommunityRequest
+	(*CreateCommunityResponse)(nil),              // 91: communityserver.v1.CreateCommunityResponse
+	(*UpdateCommunityRequest)(nil),               // 92: communityserver.v1.UpdateCommunityRequest
+	(*UpdateCommunityResponse)(nil),              // 93: communityserver.v1.UpdateCommunityResponse
+	(*DeleteCommunityRequest)(nil),               // 94: communityserver.v1.DeleteCommunityRequest
+	(*DeleteCommunityResponse)(nil),              // 95: communityserver.v1.DeleteCommunityResponse
+	(*CommunityUpdatedEvent)(nil),                // 96: communityserver.v1.CommunityUpdatedEvent
+	(*CommunityDeletedEvent)(nil),                // 97: communityserver.v1.CommunityDeletedEvent
+	(*LeaveCommunityRequest)(nil),                // 98: communityserver.v1.LeaveCommunityRequest
+	(*LeaveCommunityResponse)(nil),               // 99: communityserver.v1.LeaveCommunityResponse
+	(*LeaveServerRequest)(nil),                   // 100: communityserver.v1.LeaveServerRequest
+	(*LeaveServerResponse)(nil),                  // 101: communityserver.v1.LeaveServerResponse
+	(*Presence)(nil),                             // 102: communityserver.v1.Presence
+	(*PresenceUpdatedEvent)(nil),                 // 103: communityserver.v1.PresenceUpdatedEvent
+	(*GetPresencesRequest)(nil),                  // 104: communityserver.v1.GetPresencesRequest
+	(*GetPresencesResponse)(nil),                 // 105: communityserver.v1.GetPresencesResponse
+	(*GatewayCommand)(nil),                       // 106: communityserver.v1.GatewayCommand
+	(*UpdatePresenceCommand)(nil),                // 107: communityserver.v1.UpdatePresenceCommand
+	(*StartTypingCommand)(nil),                   // 108: communityserver.v1.StartTypingCommand
+	(*TypingStartedEvent)(nil),                   // 109: communityserver.v1.TypingStartedEvent
+	(*ReadState)(nil),                            // 110: communityserver.v1.ReadState
+	(*GetReadStatesRequest)(nil),                 // 111: communityserver.v1.GetReadStatesRequest
+	(*GetReadStatesResponse)(nil),                // 112: communityserver.v1.GetReadStatesResponse
+	(*AckChannelRequest)(nil),                    // 113: communityserver.v1.AckChannelRequest
+	(*AckChannelResponse)(nil),                   // 114: communityserver.v1.AckChannelResponse
+	(*UpdateMessageRequest)(nil),                 // 115: communityserver.v1.UpdateMessageRequest
+	(*UpdateMessageResponse)(nil),                // 116: communityserver.v1.UpdateMessageResponse
+	(*DeleteMessageRequest)(nil),                 // 117: communityserver.v1.DeleteMessageRequest
+	(*DeleteMessageResponse)(nil),                // 118: communityserver.v1.DeleteMessageResponse
+	(*MessageRevision)(nil),                      // 119: communityserver.v1.MessageRevision
+	(*GetMessageRevisionsRequest)(nil),           // 120: communityserver.v1.GetMessageRevisionsRequest
+	(*GetMessageRevisionsResponse)(nil),          // 121: communityserver.v1.GetMessageRevisionsResponse
+	(*CustomEmoji)(nil),                          // 122: communityserver.v1.CustomEmoji
+	(*GetCustomEmojisRequest)(nil),               // 123: communityserver.v1.GetCustomEmojisRequest
+	(*GetCustomEmojisResponse)(nil),              // 124: communityserver.v1.GetCustomEmojisResponse
+	(*CreateCustomEmojiResponse)(nil),            // 125: communityserver.v1.CreateCustomEmojiResponse
+	(*DeleteCustomEmojiRequest)(nil),             // 126: communityserver.v1.DeleteCustomEmojiRequest
+	(*DeleteCustomEmojiResponse)(nil),            // 127: communityserver.v1.DeleteCustomEmojiResponse
+	(*CustomEmojiCreatedEvent)(nil),              // 128: communityserver.v1.CustomEmojiCreatedEvent
+	(*CustomEmojiDeletedEvent)(nil),              // 129: communityserver.v1.CustomEmojiDeletedEvent
+	(*AddReactionRequest)(nil),                   // 130: communityserver.v1.AddReactionRequest
+	(*AddReactionResponse)(nil),                  // 131: communityserver.v1.AddReactionResponse
+	(*RemoveReactionRequest)(nil),                // 132: communityserver.v1.RemoveReactionRequest
+	(*RemoveReactionResponse)(nil),               // 133: communityserver.v1.RemoveReactionResponse
+	(*ReactionAddedEvent)(nil),                   // 134: communityserver.v1.ReactionAddedEvent
+	(*ReactionRemovedEvent)(nil),                 // 135: communityserver.v1.ReactionRemovedEvent
+	(*SearchMessagesResponse)(nil),               // 136: communityserver.v1.SearchMessagesResponse
+	(*UploadAttachmentResponse)(nil),             // 137: communityserver.v1.UploadAttachmentResponse
+	(*PinnedMessage)(nil),                        // 138: communityserver.v1.PinnedMessage
+	(*GetPinnedMessagesRequest)(nil),             // 139: communityserver.v1.GetPinnedMessagesRequest
+	(*GetPinnedMessagesResponse)(nil),            // 140: communityserver.v1.GetPinnedMessagesResponse
+	(*PinMessageRequest)(nil),                    // 141: communityserver.v1.PinMessageRequest
+	(*PinMessageResponse)(nil),                   // 142: communityserver.v1.PinMessageResponse
+	(*UnpinMessageRequest)(nil),                  // 143: communityserver.v1.UnpinMessageRequest
+	(*UnpinMessageResponse)(nil),                 // 144: communityserver.v1.UnpinMessageResponse
+	(*MessagePinnedEvent)(nil),                   // 145: communityserver.v1.MessagePinnedEvent
+	(*MessageUnpinnedEvent)(nil),                 // 146: communityserver.v1.MessageUnpinnedEvent
+	(*UpdateSlowModeRequest)(nil),                // 147: communityserver.v1.UpdateSlowModeRequest
+	(*UpdateSlowModeResponse)(nil),               // 148: communityserver.v1.UpdateSlowModeResponse
+	(*RateLimitedError)(nil),                     // 149: communityserver.v1.RateLimitedError
+	(*RateLimitExemption)(nil),                   // 150: communityserver.v1.RateLimitExemption
+	(*GetRateLimitExemptionsRequest)(nil),        // 151: communityserver.v1.GetRateLimitExemptionsRequest
+	(*GetRateLimitExemptionsResponse)(nil),       // 152: communityserver.v1.GetRateLimitExemptionsResponse
+	(*AddRateLimitExemptionRequest)(nil),         // 153: communityserver.v1.AddRateLimitExemptionRequest
+	(*AddRateLimitExemptionResponse)(nil),        // 154: communityserver.v1.AddRateLimitExemptionResponse
+	(*RemoveRateLimitExemptionRequest)(nil),      // 155: communityserver.v1.RemoveRateLimitExemptionRequest
+	(*RemoveRateLimitExemptionResponse)(nil),     // 156: communityserver.v1.RemoveRateLimitExemptionResponse
+	(*Conversation)(nil),                         // 157: communityserver.v1.Conversation
+	(*ConversationMessage)(nil),                  // 158: communityserver.v1.ConversationMessage
+	(*CreateConversationRequest)(nil),            // 159: communityserver.v1.CreateConversationRequest
+	(*CreateConversationResponse)(nil),           // 160: communityserver.v1.CreateConversationResponse
+	(*GetConversationsRequest)(nil),              // 161: communityserver.v1.GetConversationsRequest
+	(*GetConversationsResponse)(nil),             // 162: communityserver.v1.GetConversationsResponse
+	(*GetConversationMessagesRequest)(nil),       // 163: communityserver.v1.GetConversationMessagesRequest
+	(*GetConversationMessagesResponse)(nil),      // 164: communityserver.v1.GetConversationMessagesResponse
+	(*SendConversationMessageRequest)(nil),       // 165: communityserver.v1.SendConversationMessageRequest
+	(*SendConversationMessageResponse)(nil),      // 166: communityserver.v1.SendConversationMessageResponse
+	(*GetUserCommunitiesResponse_Community)(nil), // 167: communityserver.v1.GetUserCommunitiesResponse.Community
+	(*ResolveInviteResponse_Community)(nil),      // 168: communityserver.v1.ResolveInviteResponse.Community
 }
 var file_communityserver_v1_communityserver_proto_depIdxs = []int32{
-	4, // 0: communityserver.v1.GetUserCommunitiesResponse.communities:type_name -> communityserver.v1.GetUserCommunitiesResponse.Community
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	167, // 0: communityserver.v1.GetUserCommunitiesResponse.communities:type_name -> communityserver.v1.GetUserCommunitiesResponse.Community
+	11,  // 1: communityserver.v1.GetChannelsResponse.channels:type_name -> communityserver.v1.Channel
+	11,  // 2: communityserver.v1.CreateChannelResponse.channel:type_name -> communityserver.v1.Channel
+	11,  // 3: communityserver.v1.UpdateChannelResponse.channel:type_name -> communityserver.v1.Channel
+	24,  // 4: communityserver.v1.Message.reply_to:type_name -> communityserver.v1.MessageReference
+	23,  // 5: communityserver.v1.Message.reactions:type_name -> communityserver.v1.Reaction
+	21,  // 6: communityserver.v1.Message.attachments:type_name -> communityserver.v1.Attachment
+	22,  // 7: communityserver.v1.Message.link_previews:type_name -> communityserver.v1.LinkPreview
+	20,  // 8: communityserver.v1.GetMessagesResponse.messages:type_name -> communityserver.v1.Message
+	20,  // 9: communityserver.v1.SendMessageResponse.message:type_name -> communityserver.v1.Message
+	2,   // 10: communityserver.v1.Event.type:type_name -> communityserver.v1.Event.Type
+	20,  // 11: communityserver.v1.MessageCreatedEvent.message:type_name -> communityserver.v1.Message
+	20,  // 12: communityserver.v1.MessageUpdatedEvent.message:type_name -> communityserver.v1.Message
+	11,  // 13: communityserver.v1.ChannelCreatedEvent.channel:type_name -> communityserver.v1.Channel
+	11,  // 14: communityserver.v1.ChannelUpdatedEvent.channel:type_name -> communityserver.v1.Channel
+	37,  // 15: communityserver.v1.RoleCreatedEvent.role:type_name -> communityserver.v1.Role
+	37,  // 16: communityserver.v1.RoleUpdatedEvent.role:type_name -> communityserver.v1.Role
+	37,  // 17: communityserver.v1.GetRolesResponse.roles:type_name -> communityserver.v1.Role
+	37,  // 18: communityserver.v1.CreateRoleResponse.role:type_name -> communityserver.v1.Role
+	37,  // 19: communityserver.v1.UpdateRoleResponse.role:type_name -> communityserver.v1.Role
+	3,   // 20: communityserver.v1.PermissionOverwrite.target_type:type_name -> communityserver.v1.PermissionOverwrite.TargetType
+	55,  // 21: communityserver.v1.GetChannelOverwritesResponse.overwrites:type_name -> communityserver.v1.PermissionOverwrite
+	55,  // 22: communityserver.v1.SetChannelOverwriteRequest.overwrite:type_name -> communityserver.v1.PermissionOverwrite
+	60,  // 23: communityserver.v1.GetInvitesResponse.invites:type_name -> communityserver.v1.Invite
+	60,  // 24: communityserver.v1.CreateInviteResponse.invite:type_name -> communityserver.v1.Invite
+	60,  // 25: communityserver.v1.ResolveInviteResponse.invite:type_name -> communityserver.v1.Invite
+	168, // 26: communityserver.v1.ResolveInviteResponse.community:type_name -> communityserver.v1.ResolveInviteResponse.Community
+	11,  // 27: communityserver.v1.ResolveInviteResponse.channel:type_name -> communityserver.v1.Channel
+	77,  // 28: communityserver.v1.GetBansResponse.bans:type_name -> communityserver.v1.Ban
+	77,  // 29: communityserver.v1.CreateBanResponse.ban:type_name -> communityserver.v1.Ban
+	4,   // 30: communityserver.v1.AuditLogEntry.action:type_name -> communityserver.v1.AuditLogEntry.Action
+	84,  // 31: communityserver.v1.GetAuditLogResponse.entries:type_name -> communityserver.v1.AuditLogEntry
+	87,  // 32: communityserver.v1.GetCommunityResponse.community:type_name -> communityserver.v1.Community
+	87,  // 33: communityserver.v1.CreateCommunityResponse.community:type_name -> communityserver.v1.Community
+	87,  // 34: communityserver.v1.UpdateCommunityResponse.community:type_name -> communityserver.v1.Community
+	87,  // 35: communityserver.v1.CommunityUpdatedEvent.community:type_name -> communityserver.v1.Community
+	1,   // 36: communityserver.v1.Presence.status:type_name -> communityserver.v1.PresenceStatus
+	102, // 37: communityserver.v1.PresenceUpdatedEvent.presence:type_name -> communityserver.v1.Presence
+	102, // 38: communityserver.v1.GetPresencesResponse.presences:type_name -> communityserver.v1.Presence
+	5,   // 39: communityserver.v1.GatewayCommand.type:type_name -> communityserver.v1.GatewayCommand.Type
+	1,   // 40: communityserver.v1.UpdatePresenceCommand.status:type_name -> communityserver.v1.PresenceStatus
+	110, // 41: communityserver.v1.GetReadStatesResponse.read_states:type_name -> communityserver.v1.ReadState
+	110, // 42: communityserver.v1.AckChannelResponse.read_state:type_name -> communityserver.v1.ReadState
+	20,  // 43: communityserver.v1.UpdateMessageResponse.message:type_name -> communityserver.v1.Message
+	119, // 44: communityserver.v1.GetMessageRevisionsResponse.revisions:type_name -> communityserver.v1.MessageRevision
+	122, // 45: communityserver.v1.GetCustomEmojisResponse.emojis:type_name -> communityserver.v1.CustomEmoji
+	122, // 46: communityserver.v1.CreateCustomEmojiResponse.emoji:type_name -> communityserver.v1.CustomEmoji
+	122, // 47: communityserver.v1.CustomEmojiCreatedEvent.emoji:type_name -> communityserver.v1.CustomEmoji
+	20,  // 48: communityserver.v1.SearchMessagesResponse.messages:type_name -> communityserver.v1.Message
+	21,  // 49: communityserver.v1.UploadAttachmentResponse.attachment:type_name -> communityserver.v1.Attachment
+	20,  // 50: communityserver.v1.PinnedMessage.message:type_name -> communityserver.v1.Message
+	138, // 51: communityserver.v1.GetPinnedMessagesResponse.pins:type_name -> communityserver.v1.PinnedMessage
+	138, // 52: communityserver.v1.PinMessageResponse.pin:type_name -> communityserver.v1.PinnedMessage
+	138, // 53: communityserver.v1.MessagePinnedEvent.pin:type_name -> communityserver.v1.PinnedMessage
+	11,  // 54: communityserver.v1.UpdateSlowModeResponse.channel:type_name -> communityserver.v1.Channel
+	6,   // 55: communityserver.v1.RateLimitedError.scope:type_name -> communityserver.v1.RateLimitedError.Scope
+	150, // 56: communityserver.v1.GetRateLimitExemptionsResponse.exemptions:type_name -> communityserver.v1.RateLimitExemption
+	157, // 57: communityserver.v1.CreateConversationResponse.conversation:type_name -> communityserver.v1.Conversation
+	157, // 58: communityserver.v1.GetConversationsResponse.conversations:type_name -> communityserver.v1.Conversation
+	158, // 59: communityserver.v1.GetConversationMessagesResponse.messages:type_name -> communityserver.v1.ConversationMessage
+	158, // 60: communityserver.v1.SendConversationMessageResponse.message:type_name -> communityserver.v1.ConversationMessage
+	61,  // [61:61] is the sub-list for method output_type
+	61,  // [61:61] is the sub-list for method input_type
+	61,  // [61:61] is the sub-list for extension type_name
+	61,  // [61:61] is the sub-list for extension extendee
+	0,   // [0:61] is the sub-list for field type_name
 }
 
 func init() { file_communityserver_v1_communityserver_proto_init() }
@@ -288,13 +9079,14 @@ func file_communityserver_v1_communityserver_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_communityserver_v1_communityserver_proto_rawDesc), len(file_communityserver_v1_communityserver_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      7,
+			NumMessages:   162,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_communityserver_v1_communityserver_proto_goTypes,
 		DependencyIndexes: file_communityserver_v1_communityserver_proto_depIdxs,
+		EnumInfos:         file_communityserver_v1_communityserver_proto_enumTypes,
 		MessageInfos:      file_communityserver_v1_communityserver_proto_msgTypes,
 	}.Build()
 	File_communityserver_v1_communityserver_proto = out.File
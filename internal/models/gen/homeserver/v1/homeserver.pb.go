@@ -24,28 +24,58 @@ const (
 type Message_Type int32
 
 const (
-	Message_TYPE_UNSPECIFIED           Message_Type = 0
-	Message_TYPE_ADD_USER_SERVER       Message_Type = 1
-	Message_TYPE_GET_USER_COMMUNITIES  Message_Type = 2
-	Message_TYPE_GET_IDENTITY_TOKEN    Message_Type = 3
-	Message_TYPE_JOIN_COMMUNITY_SERVER Message_Type = 4
+	Message_TYPE_UNSPECIFIED                  Message_Type = 0
+	Message_TYPE_ADD_USER_SERVER              Message_Type = 1
+	Message_TYPE_GET_USER_COMMUNITIES         Message_Type = 2
+	Message_TYPE_GET_IDENTITY_TOKEN           Message_Type = 3
+	Message_TYPE_JOIN_COMMUNITY_SERVER        Message_Type = 4
+	Message_TYPE_LEAVE_COMMUNITY_SERVER       Message_Type = 5
+	Message_TYPE_GET_COMMUNITY_GROUPS         Message_Type = 6
+	Message_TYPE_SET_COMMUNITY_GROUPS         Message_Type = 7
+	Message_TYPE_GET_NOTIFICATIONS            Message_Type = 8
+	Message_TYPE_ACK_NOTIFICATIONS            Message_Type = 9
+	Message_TYPE_GET_CONVERSATIONS            Message_Type = 10
+	Message_TYPE_CREATE_CONVERSATION          Message_Type = 11
+	Message_TYPE_GET_CONVERSATION_HOSTS       Message_Type = 12
+	Message_TYPE_SET_CONVERSATION_HOST_STATUS Message_Type = 13
+	Message_TYPE_REMOVE_CONVERSATION_HOST     Message_Type = 14
 )
 
 // Enum value maps for Message_Type.
 var (
 	Message_Type_name = map[int32]string{
-		0: "TYPE_UNSPECIFIED",
-		1: "TYPE_ADD_USER_SERVER",
-		2: "TYPE_GET_USER_COMMUNITIES",
-		3: "TYPE_GET_IDENTITY_TOKEN",
-		4: "TYPE_JOIN_COMMUNITY_SERVER",
+		0:  "TYPE_UNSPECIFIED",
+		1:  "TYPE_ADD_USER_SERVER",
+		2:  "TYPE_GET_USER_COMMUNITIES",
+		3:  "TYPE_GET_IDENTITY_TOKEN",
+		4:  "TYPE_JOIN_COMMUNITY_SERVER",
+		5:  "TYPE_LEAVE_COMMUNITY_SERVER",
+		6:  "TYPE_GET_COMMUNITY_GROUPS",
+		7:  "TYPE_SET_COMMUNITY_GROUPS",
+		8:  "TYPE_GET_NOTIFICATIONS",
+		9:  "TYPE_ACK_NOTIFICATIONS",
+		10: "TYPE_GET_CONVERSATIONS",
+		11: "TYPE_CREATE_CONVERSATION",
+		12: "TYPE_GET_CONVERSATION_HOSTS",
+		13: "TYPE_SET_CONVERSATION_HOST_STATUS",
+		14: "TYPE_REMOVE_CONVERSATION_HOST",
 	}
 	Message_Type_value = map[string]int32{
-		"TYPE_UNSPECIFIED":           0,
-		"TYPE_ADD_USER_SERVER":       1,
-		"TYPE_GET_USER_COMMUNITIES":  2,
-		"TYPE_GET_IDENTITY_TOKEN":    3,
-		"TYPE_JOIN_COMMUNITY_SERVER": 4,
+		"TYPE_UNSPECIFIED":                  0,
+		"TYPE_ADD_USER_SERVER":              1,
+		"TYPE_GET_USER_COMMUNITIES":         2,
+		"TYPE_GET_IDENTITY_TOKEN":           3,
+		"TYPE_JOIN_COMMUNITY_SERVER":        4,
+		"TYPE_LEAVE_COMMUNITY_SERVER":       5,
+		"TYPE_GET_COMMUNITY_GROUPS":         6,
+		"TYPE_SET_COMMUNITY_GROUPS":         7,
+		"TYPE_GET_NOTIFICATIONS":            8,
+		"TYPE_ACK_NOTIFICATIONS":            9,
+		"TYPE_GET_CONVERSATIONS":            10,
+		"TYPE_CREATE_CONVERSATION":          11,
+		"TYPE_GET_CONVERSATION_HOSTS":       12,
+		"TYPE_SET_CONVERSATION_HOST_STATUS": 13,
+		"TYPE_REMOVE_CONVERSATION_HOST":     14,
 	}
 )
 
@@ -76,6 +106,113 @@ func (Message_Type) EnumDescriptor() ([]byte, []int) {
 	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type Notification_Type int32
+
+const (
+	Notification_TYPE_UNSPECIFIED      Notification_Type = 0
+	Notification_TYPE_USER_MENTION     Notification_Type = 1
+	Notification_TYPE_ROLE_MENTION     Notification_Type = 2
+	Notification_TYPE_EVERYONE_MENTION Notification_Type = 3
+	Notification_TYPE_DIRECT_MESSAGE   Notification_Type = 4
+)
+
+// Enum value maps for Notification_Type.
+var (
+	Notification_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_USER_MENTION",
+		2: "TYPE_ROLE_MENTION",
+		3: "TYPE_EVERYONE_MENTION",
+		4: "TYPE_DIRECT_MESSAGE",
+	}
+	Notification_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":      0,
+		"TYPE_USER_MENTION":     1,
+		"TYPE_ROLE_MENTION":     2,
+		"TYPE_EVERYONE_MENTION": 3,
+		"TYPE_DIRECT_MESSAGE":   4,
+	}
+)
+
+func (x Notification_Type) Enum() *Notification_Type {
+	p := new(Notification_Type)
+	*p = x
+	return p
+}
+
+func (x Notification_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Notification_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_homeserver_v1_homeserver_proto_enumTypes[1].Descriptor()
+}
+
+func (Notification_Type) Type() protoreflect.EnumType {
+	return &file_homeserver_v1_homeserver_proto_enumTypes[1]
+}
+
+func (x Notification_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Notification_Type.Descriptor instead.
+func (Notification_Type) EnumDescriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type ConversationHost_Status int32
+
+const (
+	ConversationHost_STATUS_UNSPECIFIED ConversationHost_Status = 0
+	ConversationHost_STATUS_PENDING     ConversationHost_Status = 1
+	ConversationHost_STATUS_ACCEPTED    ConversationHost_Status = 2
+	ConversationHost_STATUS_BLOCKED     ConversationHost_Status = 3
+)
+
+// Enum value maps for ConversationHost_Status.
+var (
+	ConversationHost_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_PENDING",
+		2: "STATUS_ACCEPTED",
+		3: "STATUS_BLOCKED",
+	}
+	ConversationHost_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_PENDING":     1,
+		"STATUS_ACCEPTED":    2,
+		"STATUS_BLOCKED":     3,
+	}
+)
+
+func (x ConversationHost_Status) Enum() *ConversationHost_Status {
+	p := new(ConversationHost_Status)
+	*p = x
+	return p
+}
+
+func (x ConversationHost_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConversationHost_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_homeserver_v1_homeserver_proto_enumTypes[2].Descriptor()
+}
+
+func (ConversationHost_Status) Type() protoreflect.EnumType {
+	return &file_homeserver_v1_homeserver_proto_enumTypes[2]
+}
+
+func (x ConversationHost_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConversationHost_Status.Descriptor instead.
+func (ConversationHost_Status) EnumDescriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{24, 0}
+}
+
 type Message struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Type          Message_Type           `protobuf:"varint,1,opt,name=type,proto3,enum=homeserver.v1.Message_Type" json:"type,omitempty"`
@@ -255,6 +392,8 @@ func (*GetUserCommunitiesRequest) Descriptor() ([]byte, []int) {
 type GetUserCommunitiesResponse struct {
 	state         protoimpl.MessageState                  `protogen:"open.v1"`
 	Communities   []*GetUserCommunitiesResponse_Community `protobuf:"bytes,1,rep,name=communities,proto3" json:"communities,omitempty"`
+	UnreadCount   int64                                   `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	MentionCount  int64                                   `protobuf:"varint,3,opt,name=mention_count,json=mentionCount,proto3" json:"mention_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -296,6 +435,20 @@ func (x *GetUserCommunitiesResponse) GetCommunities() []*GetUserCommunitiesRespo
 	return nil
 }
 
+func (x *GetUserCommunitiesResponse) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *GetUserCommunitiesResponse) GetMentionCount() int64 {
+	if x != nil {
+		return x.MentionCount
+	}
+	return 0
+}
+
 type WellKnown struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PublicKey     string                 `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
@@ -342,6 +495,7 @@ func (x *WellKnown) GetPublicKey() string {
 
 type GetIdentityTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -376,6 +530,13 @@ func (*GetIdentityTokenRequest) Descriptor() ([]byte, []int) {
 	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{6}
 }
 
+func (x *GetIdentityTokenRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
 type GetIdentityTokenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -424,6 +585,7 @@ type JoinCommunityServerRequest struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	Host                 string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
 	JoinDefaultCommunity bool                   `protobuf:"varint,2,opt,name=join_default_community,json=joinDefaultCommunity,proto3" json:"join_default_community,omitempty"`
+	InviteCode           string                 `protobuf:"bytes,3,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -472,6 +634,13 @@ func (x *JoinCommunityServerRequest) GetJoinDefaultCommunity() bool {
 	return false
 }
 
+func (x *JoinCommunityServerRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
 type JoinCommunityServerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -508,27 +677,27 @@ func (*JoinCommunityServerResponse) Descriptor() ([]byte, []int) {
 	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{9}
 }
 
-type Message_Error struct {
+type LeaveCommunityServerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Message_Error) Reset() {
-	*x = Message_Error{}
+func (x *LeaveCommunityServerRequest) Reset() {
+	*x = LeaveCommunityServerRequest{}
 	mi := &file_homeserver_v1_homeserver_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Message_Error) String() string {
+func (x *LeaveCommunityServerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Message_Error) ProtoMessage() {}
+func (*LeaveCommunityServerRequest) ProtoMessage() {}
 
-func (x *Message_Error) ProtoReflect() protoreflect.Message {
+func (x *LeaveCommunityServerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_homeserver_v1_homeserver_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -540,40 +709,38 @@ func (x *Message_Error) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Message_Error.ProtoReflect.Descriptor instead.
-func (*Message_Error) Descriptor() ([]byte, []int) {
-	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use LeaveCommunityServerRequest.ProtoReflect.Descriptor instead.
+func (*LeaveCommunityServerRequest) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Message_Error) GetMessage() string {
+func (x *LeaveCommunityServerRequest) GetHost() string {
 	if x != nil {
-		return x.Message
+		return x.Host
 	}
 	return ""
 }
 
-type GetUserCommunitiesResponse_Community struct {
+type LeaveCommunityServerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserCommunitiesResponse_Community) Reset() {
-	*x = GetUserCommunitiesResponse_Community{}
+func (x *LeaveCommunityServerResponse) Reset() {
+	*x = LeaveCommunityServerResponse{}
 	mi := &file_homeserver_v1_homeserver_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserCommunitiesResponse_Community) String() string {
+func (x *LeaveCommunityServerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserCommunitiesResponse_Community) ProtoMessage() {}
+func (*LeaveCommunityServerResponse) ProtoMessage() {}
 
-func (x *GetUserCommunitiesResponse_Community) ProtoReflect() protoreflect.Message {
+func (x *LeaveCommunityServerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_homeserver_v1_homeserver_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -585,61 +752,1261 @@ func (x *GetUserCommunitiesResponse_Community) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserCommunitiesResponse_Community.ProtoReflect.Descriptor instead.
-func (*GetUserCommunitiesResponse_Community) Descriptor() ([]byte, []int) {
-	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use LeaveCommunityServerResponse.ProtoReflect.Descriptor instead.
+func (*LeaveCommunityServerResponse) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{11}
+}
+
+type Notification struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type           Notification_Type      `protobuf:"varint,2,opt,name=type,proto3,enum=homeserver.v1.Notification_Type" json:"type,omitempty"`
+	Host           string                 `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	CommunityId    string                 `protobuf:"bytes,4,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	CommunityName  string                 `protobuf:"bytes,5,opt,name=community_name,json=communityName,proto3" json:"community_name,omitempty"`
+	ChannelId      string                 `protobuf:"bytes,6,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ChannelName    string                 `protobuf:"bytes,7,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	MessageId      string                 `protobuf:"bytes,8,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	AuthorAddress  string                 `protobuf:"bytes,9,opt,name=author_address,json=authorAddress,proto3" json:"author_address,omitempty"`
+	Body           string                 `protobuf:"bytes,10,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Read           bool                   `protobuf:"varint,12,opt,name=read,proto3" json:"read,omitempty"`
+	ConversationId string                 `protobuf:"bytes,13,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserCommunitiesResponse_Community) GetId() string {
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Notification) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GetUserCommunitiesResponse_Community) GetName() string {
+func (x *Notification) GetType() Notification_Type {
 	if x != nil {
-		return x.Name
+		return x.Type
+	}
+	return Notification_TYPE_UNSPECIFIED
+}
+
+func (x *Notification) GetHost() string {
+	if x != nil {
+		return x.Host
 	}
 	return ""
 }
 
-var File_homeserver_v1_homeserver_proto protoreflect.FileDescriptor
+func (x *Notification) GetCommunityId() string {
+	if x != nil {
+		return x.CommunityId
+	}
+	return ""
+}
 
-const file_homeserver_v1_homeserver_proto_rawDesc = "" +
-	"\n" +
-	"\x1ehomeserver/v1/homeserver.proto\x12\rhomeserver.v1\"\xc0\x02\n" +
-	"\aMessage\x12/\n" +
-	"\x04type\x18\x01 \x01(\x0e2\x1b.homeserver.v1.Message.TypeR\x04type\x12\x18\n" +
-	"\apayload\x18\x02 \x01(\fR\apayload\x122\n" +
-	"\x05error\x18\x03 \x01(\v2\x1c.homeserver.v1.Message.ErrorR\x05error\x1a!\n" +
-	"\x05Error\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"\x92\x01\n" +
-	"\x04Type\x12\x14\n" +
-	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
-	"\x14TYPE_ADD_USER_SERVER\x10\x01\x12\x1d\n" +
-	"\x19TYPE_GET_USER_COMMUNITIES\x10\x02\x12\x1b\n" +
-	"\x17TYPE_GET_IDENTITY_TOKEN\x10\x03\x12\x1e\n" +
-	"\x1aTYPE_JOIN_COMMUNITY_SERVER\x10\x04\"*\n" +
-	"\x14AddUserServerRequest\x12\x12\n" +
-	"\x04host\x18\x01 \x01(\tR\x04host\"\x17\n" +
-	"\x15AddUserServerResponse\"\x1b\n" +
-	"\x19GetUserCommunitiesRequest\"\xa4\x01\n" +
-	"\x1aGetUserCommunitiesResponse\x12U\n" +
-	"\vcommunities\x18\x01 \x03(\v23.homeserver.v1.GetUserCommunitiesResponse.CommunityR\vcommunities\x1a/\n" +
-	"\tCommunity\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"*\n" +
-	"\tWellKnown\x12\x1d\n" +
-	"\n" +
-	"public_key\x18\x01 \x01(\tR\tpublicKey\"\x19\n" +
-	"\x17GetIdentityTokenRequest\"0\n" +
-	"\x18GetIdentityTokenResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"f\n" +
-	"\x1aJoinCommunityServerRequest\x12\x12\n" +
-	"\x04host\x18\x01 \x01(\tR\x04host\x124\n" +
-	"\x16join_default_community\x18\x02 \x01(\bR\x14joinDefaultCommunity\"\x1d\n" +
-	"\x1bJoinCommunityServerResponseB\xca\x01\n" +
+func (x *Notification) GetCommunityName() string {
+	if x != nil {
+		return x.CommunityName
+	}
+	return ""
+}
+
+func (x *Notification) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *Notification) GetChannelName() string {
+	if x != nil {
+		return x.ChannelName
+	}
+	return ""
+}
+
+func (x *Notification) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *Notification) GetAuthorAddress() string {
+	if x != nil {
+		return x.AuthorAddress
+	}
+	return ""
+}
+
+func (x *Notification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Notification) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Notification) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *Notification) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+type DeliverNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliverNotificationsRequest) Reset() {
+	*x = DeliverNotificationsRequest{}
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliverNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliverNotificationsRequest) ProtoMessage() {}
+
+func (x *DeliverNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliverNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*DeliverNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeliverNotificationsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type DeliverNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivered     int64                  `protobuf:"varint,1,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliverNotificationsResponse) Reset() {
+	*x = DeliverNotificationsResponse{}
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliverNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliverNotificationsResponse) ProtoMessage() {}
+
+func (x *DeliverNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliverNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*DeliverNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeliverNotificationsResponse) GetDelivered() int64 {
+	if x != nil {
+		return x.Delivered
+	}
+	return 0
+}
+
+type GetNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Before        string                 `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationsRequest) Reset() {
+	*x = GetNotificationsRequest{}
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationsRequest) ProtoMessage() {}
+
+func (x *GetNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetNotificationsRequest) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *GetNotificationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	UnreadCount   int64                  `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationsResponse) Reset() {
+	*x = GetNotificationsResponse{}
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationsResponse) ProtoMessage() {}
+
+func (x *GetNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *GetNotificationsResponse) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type AckNotificationsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NotificationIds []string               `protobuf:"bytes,1,rep,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+	All             bool                   `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AckNotificationsRequest) Reset() {
+	*x = AckNotificationsRequest{}
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckNotificationsRequest) ProtoMessage() {}
+
+func (x *AckNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*AckNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AckNotificationsRequest) GetNotificationIds() []string {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+func (x *AckNotificationsRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type AckNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnreadCount   int64                  `protobuf:"varint,1,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AckNotificationsResponse) Reset() {
+	*x = AckNotificationsResponse{}
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckNotificationsResponse) ProtoMessage() {}
+
+func (x *AckNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*AckNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AckNotificationsResponse) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type Conversation struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Host                 string                 `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	ParticipantAddresses []string               `protobuf:"bytes,3,rep,name=participant_addresses,json=participantAddresses,proto3" json:"participant_addresses,omitempty"`
+	CreatedBy            string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt            string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastMessageAt        string                 `protobuf:"bytes,6,opt,name=last_message_at,json=lastMessageAt,proto3" json:"last_message_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Conversation) Reset() {
+	*x = Conversation{}
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Conversation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Conversation) ProtoMessage() {}
+
+func (x *Conversation) ProtoReflect() protoreflect.Message {
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Conversation.ProtoReflect.Descriptor instead.
+func (*Conversation) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Conversation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Conversation) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Conversation) GetParticipantAddresses() []string {
+	if x != nil {
+		return x.ParticipantAddresses
+	}
+	return nil
+}
+
+func (x *Conversation) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Conversation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Conversation) GetLastMessageAt() string {
+	if x != nil {
+		return x.LastMessageAt
+	}
+	return ""
+}
+
+type GetConversationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationsRequest) Reset() {
+	*x = GetConversationsRequest{}
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationsRequest) ProtoMessage() {}
+
+func (x *GetConversationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationsRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationsRequest) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{20}
+}
+
+type GetConversationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversations []*Conversation        `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationsResponse) Reset() {
+	*x = GetConversationsResponse{}
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationsResponse) ProtoMessage() {}
+
+func (x *GetConversationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationsResponse.ProtoReflect.Descriptor instead.
+func (*GetConversationsResponse) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetConversationsResponse) GetConversations() []*Conversation {
+	if x != nil {
+		return x.Conversations
+	}
+	return nil
+}
+
+type CreateConversationRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantAddresses []string               `protobuf:"bytes,1,rep,name=participant_addresses,json=participantAddresses,proto3" json:"participant_addresses,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateConversationRequest) Reset() {
+	*x = CreateConversationRequest{}
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConversationRequest) ProtoMessage() {}
+
+func (x *CreateConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConversationRequest.ProtoReflect.Descriptor instead.
+func (*CreateConversationRequest) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateConversationRequest) GetParticipantAddresses() []string {
+	if x != nil {
+		return x.ParticipantAddresses
+	}
+	return nil
+}
+
+type CreateConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateConversationResponse) Reset() {
+	*x = CreateConversationResponse{}
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConversationResponse) ProtoMessage() {}
+
+func (x *CreateConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConversationResponse.ProtoReflect.Descriptor instead.
+func (*CreateConversationResponse) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateConversationResponse) GetConversation() *Conversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+type ConversationHost struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Host          string                  `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Status        ConversationHost_Status `protobuf:"varint,2,opt,name=status,proto3,enum=homeserver.v1.ConversationHost_Status" json:"status,omitempty"`
+	CreatedAt     string                  `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConversationHost) Reset() {
+	*x = ConversationHost{}
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationHost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationHost) ProtoMessage() {}
+
+func (x *ConversationHost) ProtoReflect() protoreflect.Message {
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationHost.ProtoReflect.Descriptor instead.
+func (*ConversationHost) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ConversationHost) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ConversationHost) GetStatus() ConversationHost_Status {
+	if x != nil {
+		return x.Status
+	}
+	return ConversationHost_STATUS_UNSPECIFIED
+}
+
+func (x *ConversationHost) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetConversationHostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationHostsRequest) Reset() {
+	*x = GetConversationHostsRequest{}
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationHostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationHostsRequest) ProtoMessage() {}
+
+func (x *GetConversationHostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationHostsRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationHostsRequest) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{25}
+}
+
+type GetConversationHostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hosts         []*ConversationHost    `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationHostsResponse) Reset() {
+	*x = GetConversationHostsResponse{}
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationHostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationHostsResponse) ProtoMessage() {}
+
+func (x *GetConversationHostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationHostsResponse.ProtoReflect.Descriptor instead.
+func (*GetConversationHostsResponse) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetConversationHostsResponse) GetHosts() []*ConversationHost {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+type SetConversationHostStatusRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Host          string                  `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Status        ConversationHost_Status `protobuf:"varint,2,opt,name=status,proto3,enum=homeserver.v1.ConversationHost_Status" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetConversationHostStatusRequest) Reset() {
+	*x = SetConversationHostStatusRequest{}
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetConversationHostStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConversationHostStatusRequest) ProtoMessage() {}
+
+func (x *SetConversationHostStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConversationHostStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetConversationHostStatusRequest) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetConversationHostStatusRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *SetConversationHostStatusRequest) GetStatus() ConversationHost_Status {
+	if x != nil {
+		return x.Status
+	}
+	return ConversationHost_STATUS_UNSPECIFIED
+}
+
+type SetConversationHostStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetConversationHostStatusResponse) Reset() {
+	*x = SetConversationHostStatusResponse{}
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetConversationHostStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConversationHostStatusResponse) ProtoMessage() {}
+
+func (x *SetConversationHostStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConversationHostStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetConversationHostStatusResponse) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{28}
+}
+
+type RemoveConversationHostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveConversationHostRequest) Reset() {
+	*x = RemoveConversationHostRequest{}
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveConversationHostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveConversationHostRequest) ProtoMessage() {}
+
+func (x *RemoveConversationHostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveConversationHostRequest.ProtoReflect.Descriptor instead.
+func (*RemoveConversationHostRequest) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RemoveConversationHostRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type RemoveConversationHostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveConversationHostResponse) Reset() {
+	*x = RemoveConversationHostResponse{}
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveConversationHostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveConversationHostResponse) ProtoMessage() {}
+
+func (x *RemoveConversationHostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveConversationHostResponse.ProtoReflect.Descriptor instead.
+func (*RemoveConversationHostResponse) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{30}
+}
+
+type Message_Error struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Message_Error) Reset() {
+	*x = Message_Error{}
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Message_Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message_Error) ProtoMessage() {}
+
+func (x *Message_Error) ProtoReflect() protoreflect.Message {
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message_Error.ProtoReflect.Descriptor instead.
+func (*Message_Error) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Message_Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetUserCommunitiesResponse_Community struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Online        int64                  `protobuf:"varint,3,opt,name=online,proto3" json:"online,omitempty"`
+	Host          string                 `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
+	UnreadCount   int64                  `protobuf:"varint,5,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	MentionCount  int64                  `protobuf:"varint,6,opt,name=mention_count,json=mentionCount,proto3" json:"mention_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserCommunitiesResponse_Community) Reset() {
+	*x = GetUserCommunitiesResponse_Community{}
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserCommunitiesResponse_Community) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserCommunitiesResponse_Community) ProtoMessage() {}
+
+func (x *GetUserCommunitiesResponse_Community) ProtoReflect() protoreflect.Message {
+	mi := &file_homeserver_v1_homeserver_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserCommunitiesResponse_Community.ProtoReflect.Descriptor instead.
+func (*GetUserCommunitiesResponse_Community) Descriptor() ([]byte, []int) {
+	return file_homeserver_v1_homeserver_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *GetUserCommunitiesResponse_Community) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetUserCommunitiesResponse_Community) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetUserCommunitiesResponse_Community) GetOnline() int64 {
+	if x != nil {
+		return x.Online
+	}
+	return 0
+}
+
+func (x *GetUserCommunitiesResponse_Community) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *GetUserCommunitiesResponse_Community) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *GetUserCommunitiesResponse_Community) GetMentionCount() int64 {
+	if x != nil {
+		return x.MentionCount
+	}
+	return 0
+}
+
+var File_homeserver_v1_homeserver_proto protoreflect.FileDescriptor
+
+const file_homeserver_v1_homeserver_proto_rawDesc = "" +
+	"\n" +
+	"\x1ehomeserver/v1/homeserver.proto\x12\rhomeserver.v1\"\xfc\x04\n" +
+	"\aMessage\x12/\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1b.homeserver.v1.Message.TypeR\x04type\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\fR\apayload\x122\n" +
+	"\x05error\x18\x03 \x01(\v2\x1c.homeserver.v1.Message.ErrorR\x05error\x1a!\n" +
+	"\x05Error\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xce\x03\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14TYPE_ADD_USER_SERVER\x10\x01\x12\x1d\n" +
+	"\x19TYPE_GET_USER_COMMUNITIES\x10\x02\x12\x1b\n" +
+	"\x17TYPE_GET_IDENTITY_TOKEN\x10\x03\x12\x1e\n" +
+	"\x1aTYPE_JOIN_COMMUNITY_SERVER\x10\x04\x12\x1f\n" +
+	"\x1bTYPE_LEAVE_COMMUNITY_SERVER\x10\x05\x12\x1d\n" +
+	"\x19TYPE_GET_COMMUNITY_GROUPS\x10\x06\x12\x1d\n" +
+	"\x19TYPE_SET_COMMUNITY_GROUPS\x10\a\x12\x1a\n" +
+	"\x16TYPE_GET_NOTIFICATIONS\x10\b\x12\x1a\n" +
+	"\x16TYPE_ACK_NOTIFICATIONS\x10\t\x12\x1a\n" +
+	"\x16TYPE_GET_CONVERSATIONS\x10\n" +
+	"\x12\x1c\n" +
+	"\x18TYPE_CREATE_CONVERSATION\x10\v\x12\x1f\n" +
+	"\x1bTYPE_GET_CONVERSATION_HOSTS\x10\f\x12%\n" +
+	"!TYPE_SET_CONVERSATION_HOST_STATUS\x10\r\x12!\n" +
+	"\x1dTYPE_REMOVE_CONVERSATION_HOST\x10\x0e\"*\n" +
+	"\x14AddUserServerRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\"\x17\n" +
+	"\x15AddUserServerResponse\"\x1b\n" +
+	"\x19GetUserCommunitiesRequest\"\xe1\x02\n" +
+	"\x1aGetUserCommunitiesResponse\x12U\n" +
+	"\vcommunities\x18\x01 \x03(\v23.homeserver.v1.GetUserCommunitiesResponse.CommunityR\vcommunities\x12!\n" +
+	"\funread_count\x18\x02 \x01(\x03R\vunreadCount\x12#\n" +
+	"\rmention_count\x18\x03 \x01(\x03R\fmentionCount\x1a\xa3\x01\n" +
+	"\tCommunity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06online\x18\x03 \x01(\x03R\x06online\x12\x12\n" +
+	"\x04host\x18\x04 \x01(\tR\x04host\x12!\n" +
+	"\funread_count\x18\x05 \x01(\x03R\vunreadCount\x12#\n" +
+	"\rmention_count\x18\x06 \x01(\x03R\fmentionCount\"*\n" +
+	"\tWellKnown\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x01 \x01(\tR\tpublicKey\"-\n" +
+	"\x17GetIdentityTokenRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\"0\n" +
+	"\x18GetIdentityTokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x87\x01\n" +
+	"\x1aJoinCommunityServerRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x124\n" +
+	"\x16join_default_community\x18\x02 \x01(\bR\x14joinDefaultCommunity\x12\x1f\n" +
+	"\vinvite_code\x18\x03 \x01(\tR\n" +
+	"inviteCode\"\x1d\n" +
+	"\x1bJoinCommunityServerResponse\"1\n" +
+	"\x1bLeaveCommunityServerRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\"\x1e\n" +
+	"\x1cLeaveCommunityServerResponse\"\xaa\x04\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x124\n" +
+	"\x04type\x18\x02 \x01(\x0e2 .homeserver.v1.Notification.TypeR\x04type\x12\x12\n" +
+	"\x04host\x18\x03 \x01(\tR\x04host\x12!\n" +
+	"\fcommunity_id\x18\x04 \x01(\tR\vcommunityId\x12%\n" +
+	"\x0ecommunity_name\x18\x05 \x01(\tR\rcommunityName\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x06 \x01(\tR\tchannelId\x12!\n" +
+	"\fchannel_name\x18\a \x01(\tR\vchannelName\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\b \x01(\tR\tmessageId\x12%\n" +
+	"\x0eauthor_address\x18\t \x01(\tR\rauthorAddress\x12\x12\n" +
+	"\x04body\x18\n" +
+	" \x01(\tR\x04body\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x12\n" +
+	"\x04read\x18\f \x01(\bR\x04read\x12'\n" +
+	"\x0fconversation_id\x18\r \x01(\tR\x0econversationId\"~\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11TYPE_USER_MENTION\x10\x01\x12\x15\n" +
+	"\x11TYPE_ROLE_MENTION\x10\x02\x12\x19\n" +
+	"\x15TYPE_EVERYONE_MENTION\x10\x03\x12\x17\n" +
+	"\x13TYPE_DIRECT_MESSAGE\x10\x04\"3\n" +
+	"\x1bDeliverNotificationsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"<\n" +
+	"\x1cDeliverNotificationsResponse\x12\x1c\n" +
+	"\tdelivered\x18\x01 \x01(\x03R\tdelivered\"G\n" +
+	"\x17GetNotificationsRequest\x12\x16\n" +
+	"\x06before\x18\x01 \x01(\tR\x06before\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x80\x01\n" +
+	"\x18GetNotificationsResponse\x12A\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x1b.homeserver.v1.NotificationR\rnotifications\x12!\n" +
+	"\funread_count\x18\x02 \x01(\x03R\vunreadCount\"V\n" +
+	"\x17AckNotificationsRequest\x12)\n" +
+	"\x10notification_ids\x18\x01 \x03(\tR\x0fnotificationIds\x12\x10\n" +
+	"\x03all\x18\x02 \x01(\bR\x03all\"=\n" +
+	"\x18AckNotificationsResponse\x12!\n" +
+	"\funread_count\x18\x01 \x01(\x03R\vunreadCount\"\xcd\x01\n" +
+	"\fConversation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\x123\n" +
+	"\x15participant_addresses\x18\x03 \x03(\tR\x14participantAddresses\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12&\n" +
+	"\x0flast_message_at\x18\x06 \x01(\tR\rlastMessageAt\"\x19\n" +
+	"\x17GetConversationsRequest\"]\n" +
+	"\x18GetConversationsResponse\x12A\n" +
+	"\rconversations\x18\x01 \x03(\v2\x1b.homeserver.v1.ConversationR\rconversations\"P\n" +
+	"\x19CreateConversationRequest\x123\n" +
+	"\x15participant_addresses\x18\x01 \x03(\tR\x14participantAddresses\"]\n" +
+	"\x1aCreateConversationResponse\x12?\n" +
+	"\fconversation\x18\x01 \x01(\v2\x1b.homeserver.v1.ConversationR\fconversation\"\xe4\x01\n" +
+	"\x10ConversationHost\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12>\n" +
+	"\x06status\x18\x02 \x01(\x0e2&.homeserver.v1.ConversationHost.StatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\"]\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSTATUS_PENDING\x10\x01\x12\x13\n" +
+	"\x0fSTATUS_ACCEPTED\x10\x02\x12\x12\n" +
+	"\x0eSTATUS_BLOCKED\x10\x03\"\x1d\n" +
+	"\x1bGetConversationHostsRequest\"U\n" +
+	"\x1cGetConversationHostsResponse\x125\n" +
+	"\x05hosts\x18\x01 \x03(\v2\x1f.homeserver.v1.ConversationHostR\x05hosts\"v\n" +
+	" SetConversationHostStatusRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12>\n" +
+	"\x06status\x18\x02 \x01(\x0e2&.homeserver.v1.ConversationHost.StatusR\x06status\"#\n" +
+	"!SetConversationHostStatusResponse\"3\n" +
+	"\x1dRemoveConversationHostRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\" \n" +
+	"\x1eRemoveConversationHostResponseB\xca\x01\n" +
 	"\x11com.homeserver.v1B\x0fHomeserverProtoP\x01ZOgithub.com/varso/protchat-server/internal/models/gen/homeserver/v1;homeserverv1\xa2\x02\x03HXX\xaa\x02\rHomeserver.V1\xca\x02\rHomeserver\\V1\xe2\x02\x19Homeserver\\V1\\GPBMetadata\xea\x02\x0eHomeserver::V1b\x06proto3"
 
 var (
@@ -654,32 +2021,62 @@ func file_homeserver_v1_homeserver_proto_rawDescGZIP() []byte {
 	return file_homeserver_v1_homeserver_proto_rawDescData
 }
 
-var file_homeserver_v1_homeserver_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_homeserver_v1_homeserver_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_homeserver_v1_homeserver_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_homeserver_v1_homeserver_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_homeserver_v1_homeserver_proto_goTypes = []any{
 	(Message_Type)(0),                            // 0: homeserver.v1.Message.Type
-	(*Message)(nil),                              // 1: homeserver.v1.Message
-	(*AddUserServerRequest)(nil),                 // 2: homeserver.v1.AddUserServerRequest
-	(*AddUserServerResponse)(nil),                // 3: homeserver.v1.AddUserServerResponse
-	(*GetUserCommunitiesRequest)(nil),            // 4: homeserver.v1.GetUserCommunitiesRequest
-	(*GetUserCommunitiesResponse)(nil),           // 5: homeserver.v1.GetUserCommunitiesResponse
-	(*WellKnown)(nil),                            // 6: homeserver.v1.WellKnown
-	(*GetIdentityTokenRequest)(nil),              // 7: homeserver.v1.GetIdentityTokenRequest
-	(*GetIdentityTokenResponse)(nil),             // 8: homeserver.v1.GetIdentityTokenResponse
-	(*JoinCommunityServerRequest)(nil),           // 9: homeserver.v1.JoinCommunityServerRequest
-	(*JoinCommunityServerResponse)(nil),          // 10: homeserver.v1.JoinCommunityServerResponse
-	(*Message_Error)(nil),                        // 11: homeserver.v1.Message.Error
-	(*GetUserCommunitiesResponse_Community)(nil), // 12: homeserver.v1.GetUserCommunitiesResponse.Community
+	(Notification_Type)(0),                       // 1: homeserver.v1.Notification.Type
+	(ConversationHost_Status)(0),                 // 2: homeserver.v1.ConversationHost.Status
+	(*Message)(nil),                              // 3: homeserver.v1.Message
+	(*AddUserServerRequest)(nil),                 // 4: homeserver.v1.AddUserServerRequest
+	(*AddUserServerResponse)(nil),                // 5: homeserver.v1.AddUserServerResponse
+	(*GetUserCommunitiesRequest)(nil),            // 6: homeserver.v1.GetUserCommunitiesRequest
+	(*GetUserCommunitiesResponse)(nil),           // 7: homeserver.v1.GetUserCommunitiesResponse
+	(*WellKnown)(nil),                            // 8: homeserver.v1.WellKnown
+	(*GetIdentityTokenRequest)(nil),              // 9: homeserver.v1.GetIdentityTokenRequest
+	(*GetIdentityTokenResponse)(nil),             // 10: homeserver.v1.GetIdentityTokenResponse
+	(*JoinCommunityServerRequest)(nil),           // 11: homeserver.v1.JoinCommunityServerRequest
+	(*JoinCommunityServerResponse)(nil),          // 12: homeserver.v1.JoinCommunityServerResponse
+	(*LeaveCommunityServerRequest)(nil),          // 13: homeserver.v1.LeaveCommunityServerRequest
+	(*LeaveCommunityServerResponse)(nil),         // 14: homeserver.v1.LeaveCommunityServerResponse
+	(*Notification)(nil),                         // 15: homeserver.v1.Notification
+	(*DeliverNotificationsRequest)(nil),          // 16: homeserver.v1.DeliverNotificationsRequest
+	(*DeliverNotificationsResponse)(nil),         // 17: homeserver.v1.DeliverNotificationsResponse
+	(*GetNotificationsRequest)(nil),              // 18: homeserver.v1.GetNotificationsRequest
+	(*GetNotificationsResponse)(nil),             // 19: homeserver.v1.GetNotificationsResponse
+	(*AckNotificationsRequest)(nil),              // 20: homeserver.v1.AckNotificationsRequest
+	(*AckNotificationsResponse)(nil),             // 21: homeserver.v1.AckNotificationsResponse
+	(*Conversation)(nil),                         // 22: homeserver.v1.Conversation
+	(*GetConversationsRequest)(nil),              // 23: homeserver.v1.GetConversationsRequest
+	(*GetConversationsResponse)(nil),             // 24: homeserver.v1.GetConversationsResponse
+	(*CreateConversationRequest)(nil),            // 25: homeserver.v1.CreateConversationRequest
+	(*CreateConversationResponse)(nil),           // 26: homeserver.v1.CreateConversationResponse
+	(*ConversationHost)(nil),                     // 27: homeserver.v1.ConversationHost
+	(*GetConversationHostsRequest)(nil),          // 28: homeserver.v1.GetConversationHostsRequest
+	(*GetConversationHostsResponse)(nil),         // 29: homeserver.v1.GetConversationHostsResponse
+	(*SetConversationHostStatusRequest)(nil),     // 30: homeserver.v1.SetConversationHostStatusRequest
+	(*SetConversationHostStatusResponse)(nil),    // 31: homeserver.v1.SetConversationHostStatusResponse
+	(*RemoveConversationHostRequest)(nil),        // 32: homeserver.v1.RemoveConversationHostRequest
+	(*RemoveConversationHostResponse)(nil),       // 33: homeserver.v1.RemoveConversationHostResponse
+	(*Message_Error)(nil),                        // 34: homeserver.v1.Message.Error
+	(*GetUserCommunitiesResponse_Community)(nil), // 35: homeserver.v1.GetUserCommunitiesResponse.Community
 }
 var file_homeserver_v1_homeserver_proto_depIdxs = []int32{
 	0,  // 0: homeserver.v1.Message.type:type_name -> homeserver.v1.Message.Type
-	11, // 1: homeserver.v1.Message.error:type_name -> homeserver.v1.Message.Error
-	12, // 2: homeserver.v1.GetUserCommunitiesResponse.communities:type_name -> homeserver.v1.GetUserCommunitiesResponse.Community
-	3,  // [3:3] is the sub-list for method output_type
-	3,  // [3:3] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	34, // 1: homeserver.v1.Message.error:type_name -> homeserver.v1.Message.Error
+	35, // 2: homeserver.v1.GetUserCommunitiesResponse.communities:type_name -> homeserver.v1.GetUserCommunitiesResponse.Community
+	1,  // 3: homeserver.v1.Notification.type:type_name -> homeserver.v1.Notification.Type
+	15, // 4: homeserver.v1.GetNotificationsResponse.notifications:type_name -> homeserver.v1.Notification
+	22, // 5: homeserver.v1.GetConversationsResponse.conversations:type_name -> homeserver.v1.Conversation
+	22, // 6: homeserver.v1.CreateConversationResponse.conversation:type_name -> homeserver.v1.Conversation
+	2,  // 7: homeserver.v1.ConversationHost.status:type_name -> homeserver.v1.ConversationHost.Status
+	27, // 8: homeserver.v1.GetConversationHostsResponse.hosts:type_name -> homeserver.v1.ConversationHost
+	2,  // 9: homeserver.v1.SetConversationHostStatusRequest.status:type_name -> homeserver.v1.ConversationHost.Status
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_homeserver_v1_homeserver_proto_init() }
@@ -692,8 +2089,8 @@ func file_homeserver_v1_homeserver_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_homeserver_v1_homeserver_proto_rawDesc), len(file_homeserver_v1_homeserver_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   12,
+			NumEnums:      3,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -125,6 +125,174 @@ func (x *CommunityGroup) GetCommunities() []*Community {
 	return nil
 }
 
+type GetCommunityGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommunityGroupsRequest) Reset() {
+	*x = GetCommunityGroupsRequest{}
+	mi := &file_prochat_v1_base_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommunityGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommunityGroupsRequest) ProtoMessage() {}
+
+func (x *GetCommunityGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prochat_v1_base_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommunityGroupsRequest.ProtoReflect.Descriptor instead.
+func (*GetCommunityGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_prochat_v1_base_proto_rawDescGZIP(), []int{2}
+}
+
+type GetCommunityGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*CommunityGroup      `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommunityGroupsResponse) Reset() {
+	*x = GetCommunityGroupsResponse{}
+	mi := &file_prochat_v1_base_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommunityGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommunityGroupsResponse) ProtoMessage() {}
+
+func (x *GetCommunityGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prochat_v1_base_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommunityGroupsResponse.ProtoReflect.Descriptor instead.
+func (*GetCommunityGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_prochat_v1_base_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCommunityGroupsResponse) GetGroups() []*CommunityGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type SetCommunityGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*CommunityGroup      `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCommunityGroupsRequest) Reset() {
+	*x = SetCommunityGroupsRequest{}
+	mi := &file_prochat_v1_base_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCommunityGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommunityGroupsRequest) ProtoMessage() {}
+
+func (x *SetCommunityGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prochat_v1_base_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommunityGroupsRequest.ProtoReflect.Descriptor instead.
+func (*SetCommunityGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_prochat_v1_base_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetCommunityGroupsRequest) GetGroups() []*CommunityGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type SetCommunityGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*CommunityGroup      `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCommunityGroupsResponse) Reset() {
+	*x = SetCommunityGroupsResponse{}
+	mi := &file_prochat_v1_base_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCommunityGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommunityGroupsResponse) ProtoMessage() {}
+
+func (x *SetCommunityGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prochat_v1_base_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommunityGroupsResponse.ProtoReflect.Descriptor instead.
+func (*SetCommunityGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_prochat_v1_base_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetCommunityGroupsResponse) GetGroups() []*CommunityGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 type Community struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -133,13 +301,14 @@ type Community struct {
 	Online        int64                  `protobuf:"varint,4,opt,name=online,proto3" json:"online,omitempty"`
 	Members       []*Member              `protobuf:"bytes,5,rep,name=members,proto3" json:"members,omitempty"`
 	Channels      []*Channel             `protobuf:"bytes,6,rep,name=channels,proto3" json:"channels,omitempty"`
+	Host          string                 `protobuf:"bytes,7,opt,name=host,proto3" json:"host,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Community) Reset() {
 	*x = Community{}
-	mi := &file_prochat_v1_base_proto_msgTypes[2]
+	mi := &file_prochat_v1_base_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -151,7 +320,7 @@ func (x *Community) String() string {
 func (*Community) ProtoMessage() {}
 
 func (x *Community) ProtoReflect() protoreflect.Message {
-	mi := &file_prochat_v1_base_proto_msgTypes[2]
+	mi := &file_prochat_v1_base_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -164,7 +333,7 @@ func (x *Community) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Community.ProtoReflect.Descriptor instead.
 func (*Community) Descriptor() ([]byte, []int) {
-	return file_prochat_v1_base_proto_rawDescGZIP(), []int{2}
+	return file_prochat_v1_base_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Community) GetId() string {
@@ -209,6 +378,13 @@ func (x *Community) GetChannels() []*Channel {
 	return nil
 }
 
+func (x *Community) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
 type Channel struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -219,7 +395,7 @@ type Channel struct {
 
 func (x *Channel) Reset() {
 	*x = Channel{}
-	mi := &file_prochat_v1_base_proto_msgTypes[3]
+	mi := &file_prochat_v1_base_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -231,7 +407,7 @@ func (x *Channel) String() string {
 func (*Channel) ProtoMessage() {}
 
 func (x *Channel) ProtoReflect() protoreflect.Message {
-	mi := &file_prochat_v1_base_proto_msgTypes[3]
+	mi := &file_prochat_v1_base_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -244,7 +420,7 @@ func (x *Channel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Channel.ProtoReflect.Descriptor instead.
 func (*Channel) Descriptor() ([]byte, []int) {
-	return file_prochat_v1_base_proto_rawDescGZIP(), []int{3}
+	return file_prochat_v1_base_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Channel) GetId() string {
@@ -271,7 +447,7 @@ type Member struct {
 
 func (x *Member) Reset() {
 	*x = Member{}
-	mi := &file_prochat_v1_base_proto_msgTypes[4]
+	mi := &file_prochat_v1_base_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -283,7 +459,7 @@ func (x *Member) String() string {
 func (*Member) ProtoMessage() {}
 
 func (x *Member) ProtoReflect() protoreflect.Message {
-	mi := &file_prochat_v1_base_proto_msgTypes[4]
+	mi := &file_prochat_v1_base_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -296,7 +472,7 @@ func (x *Member) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Member.ProtoReflect.Descriptor instead.
 func (*Member) Descriptor() ([]byte, []int) {
-	return file_prochat_v1_base_proto_rawDescGZIP(), []int{4}
+	return file_prochat_v1_base_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Member) GetId() string {
@@ -324,14 +500,22 @@ const file_prochat_v1_base_proto_rawDesc = "" +
 	"\x0eCommunityGroup\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x127\n" +
-	"\vcommunities\x18\x03 \x03(\v2\x15.prochat.v1.CommunityR\vcommunities\"\xc1\x01\n" +
+	"\vcommunities\x18\x03 \x03(\v2\x15.prochat.v1.CommunityR\vcommunities\"\x1b\n" +
+	"\x19GetCommunityGroupsRequest\"P\n" +
+	"\x1aGetCommunityGroupsResponse\x122\n" +
+	"\x06groups\x18\x01 \x03(\v2\x1a.prochat.v1.CommunityGroupR\x06groups\"O\n" +
+	"\x19SetCommunityGroupsRequest\x122\n" +
+	"\x06groups\x18\x01 \x03(\v2\x1a.prochat.v1.CommunityGroupR\x06groups\"P\n" +
+	"\x1aSetCommunityGroupsResponse\x122\n" +
+	"\x06groups\x18\x01 \x03(\v2\x1a.prochat.v1.CommunityGroupR\x06groups\"\xd5\x01\n" +
 	"\tCommunity\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
 	"\bicon_url\x18\x03 \x01(\tR\aiconUrl\x12\x16\n" +
 	"\x06online\x18\x04 \x01(\x03R\x06online\x12,\n" +
 	"\amembers\x18\x05 \x03(\v2\x12.prochat.v1.MemberR\amembers\x12/\n" +
-	"\bchannels\x18\x06 \x03(\v2\x13.prochat.v1.ChannelR\bchannels\"-\n" +
+	"\bchannels\x18\x06 \x03(\v2\x13.prochat.v1.ChannelR\bchannels\x12\x12\n" +
+	"\x04host\x18\a \x01(\tR\x04host\"-\n" +
 	"\aChannel\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\",\n" +
@@ -354,24 +538,31 @@ func file_prochat_v1_base_proto_rawDescGZIP() []byte {
 	return file_prochat_v1_base_proto_rawDescData
 }
 
-var file_prochat_v1_base_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_prochat_v1_base_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_prochat_v1_base_proto_goTypes = []any{
 	(*GetUserCommunitiesResponse)(nil), // 0: prochat.v1.GetUserCommunitiesResponse
 	(*CommunityGroup)(nil),             // 1: prochat.v1.CommunityGroup
-	(*Community)(nil),                  // 2: prochat.v1.Community
-	(*Channel)(nil),                    // 3: prochat.v1.Channel
-	(*Member)(nil),                     // 4: prochat.v1.Member
+	(*GetCommunityGroupsRequest)(nil),  // 2: prochat.v1.GetCommunityGroupsRequest
+	(*GetCommunityGroupsResponse)(nil), // 3: prochat.v1.GetCommunityGroupsResponse
+	(*SetCommunityGroupsRequest)(nil),  // 4: prochat.v1.SetCommunityGroupsRequest
+	(*SetCommunityGroupsResponse)(nil), // 5: prochat.v1.SetCommunityGroupsResponse
+	(*Community)(nil),                  // 6: prochat.v1.Community
+	(*Channel)(nil),                    // 7: prochat.v1.Channel
+	(*Member)(nil),                     // 8: prochat.v1.Member
 }
 var file_prochat_v1_base_proto_depIdxs = []int32{
 	1, // 0: prochat.v1.GetUserCommunitiesResponse.communities:type_name -> prochat.v1.CommunityGroup
-	2, // 1: prochat.v1.CommunityGroup.communities:type_name -> prochat.v1.Community
-	4, // 2: prochat.v1.Community.members:type_name -> prochat.v1.Member
-	3, // 3: prochat.v1.Community.channels:type_name -> prochat.v1.Channel
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	6, // 1: prochat.v1.CommunityGroup.communities:type_name -> prochat.v1.Community
+	1, // 2: prochat.v1.GetCommunityGroupsResponse.groups:type_name -> prochat.v1.CommunityGroup
+	1, // 3: prochat.v1.SetCommunityGroupsRequest.groups:type_name -> prochat.v1.CommunityGroup
+	1, // 4: prochat.v1.SetCommunityGroupsResponse.groups:type_name -> prochat.v1.CommunityGroup
+	8, // 5: prochat.v1.Community.members:type_name -> prochat.v1.Member
+	7, // 6: prochat.v1.Community.channels:type_name -> prochat.v1.Channel
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_prochat_v1_base_proto_init() }
@@ -385,7 +576,7 @@ func file_prochat_v1_base_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_prochat_v1_base_proto_rawDesc), len(file_prochat_v1_base_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,9 @@
+CREATE TABLE channels (
+    id UUID PRIMARY KEY,
+    community_id UUID NOT NULL REFERENCES communities (id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE INDEX channels_community_id_idx
+    ON channels (community_id);
@@ -0,0 +1,12 @@
+CREATE TABLE messages (
+    id UUID PRIMARY KEY,
+    channel_id UUID NOT NULL REFERENCES channels (id) ON DELETE CASCADE,
+    user_address TEXT NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ
+);
+
+-- Message IDs are UUIDv7, so ordering by ID orders by creation time
+CREATE INDEX messages_channel_id_id_idx
+    ON messages (channel_id, id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS member_roles;
+DROP TABLE IF EXISTS roles;
+ALTER TABLE communities DROP COLUMN IF EXISTS owner_member_id;
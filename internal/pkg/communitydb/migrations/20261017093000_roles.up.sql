@@ -0,0 +1,19 @@
+ALTER TABLE communities ADD COLUMN owner_member_id UUID;
+
+CREATE TABLE roles (
+    id UUID PRIMARY KEY,
+    community_id UUID NOT NULL REFERENCES communities (id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    permissions BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE INDEX roles_community_id_idx
+    ON roles (community_id);
+
+CREATE TABLE member_roles (
+    member_id UUID NOT NULL,
+    role_id UUID NOT NULL REFERENCES roles (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    PRIMARY KEY (member_id, role_id)
+);
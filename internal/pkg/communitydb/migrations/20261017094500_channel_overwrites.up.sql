@@ -0,0 +1,11 @@
+-- target_type is one of 'everyone', 'role' or 'member'. target is the role id for role overwrites, the user
+-- address for member overwrites, and empty for the everyone overwrite.
+CREATE TABLE channel_overwrites (
+    channel_id UUID NOT NULL REFERENCES channels (id) ON DELETE CASCADE,
+    target_type TEXT NOT NULL CHECK (target_type IN ('everyone', 'role', 'member')),
+    target TEXT NOT NULL,
+    allow BIGINT NOT NULL DEFAULT 0,
+    deny BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    PRIMARY KEY (channel_id, target_type, target)
+);
@@ -0,0 +1,14 @@
+CREATE TABLE invites (
+    code TEXT PRIMARY KEY,
+    community_id UUID NOT NULL REFERENCES communities (id) ON DELETE CASCADE,
+    channel_id UUID REFERENCES channels (id) ON DELETE SET NULL,
+    creator_user_address TEXT NOT NULL,
+    max_uses INTEGER NOT NULL DEFAULT 0,
+    uses INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE INDEX invites_community_id_idx
+    ON invites (community_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS bans;
+ALTER TABLE community_members DROP COLUMN IF EXISTS muted_until;
@@ -0,0 +1,16 @@
+ALTER TABLE community_members ADD COLUMN muted_until TIMESTAMPTZ;
+
+-- Bans either target a single user address, or every user of a homeserver host when is_host is set.
+CREATE TABLE bans (
+    id UUID PRIMARY KEY,
+    community_id UUID NOT NULL REFERENCES communities (id) ON DELETE CASCADE,
+    target TEXT NOT NULL,
+    is_host BOOLEAN NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    banned_by TEXT NOT NULL,
+    expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE UNIQUE INDEX bans_community_id_target_is_host_idx
+    ON bans (community_id, target, is_host);
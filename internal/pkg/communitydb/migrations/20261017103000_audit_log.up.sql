@@ -0,0 +1,15 @@
+-- Audit log entries are append-only, they are never updated or deleted other than alongside their community.
+CREATE TABLE audit_log_entries (
+    id UUID PRIMARY KEY,
+    community_id UUID NOT NULL REFERENCES communities (id) ON DELETE CASCADE,
+    actor_user_address TEXT NOT NULL,
+    action TEXT NOT NULL,
+    target_id TEXT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    before JSONB,
+    after JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX audit_log_entries_community_id_id_idx
+    ON audit_log_entries (community_id, id);
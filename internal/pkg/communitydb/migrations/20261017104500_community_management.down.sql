@@ -0,0 +1,2 @@
+ALTER TABLE community_members DROP CONSTRAINT IF EXISTS community_members_community_id_fkey;
+ALTER TABLE communities DROP COLUMN IF EXISTS icon_url;
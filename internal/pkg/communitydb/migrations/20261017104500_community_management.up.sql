@@ -0,0 +1,6 @@
+ALTER TABLE communities ADD COLUMN icon_url TEXT NOT NULL DEFAULT '';
+
+-- Members of deleted communities are removed alongside them
+ALTER TABLE community_members
+    ADD CONSTRAINT community_members_community_id_fkey
+    FOREIGN KEY (community_id) REFERENCES communities (id) ON DELETE CASCADE;
@@ -0,0 +1,10 @@
+-- A read state is created when a member reads a channel or is mentioned in it. Members without a read state
+-- in a channel have read the messages sent before they joined the community.
+CREATE TABLE channel_read_states (
+    member_id UUID NOT NULL REFERENCES members (id) ON DELETE CASCADE,
+    channel_id UUID NOT NULL REFERENCES channels (id) ON DELETE CASCADE,
+    last_read_message_id UUID,
+    mention_count INT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (member_id, channel_id)
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS message_revisions;
+ALTER TABLE messages DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE messages DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,15 @@
+-- Deleted messages are kept as tombstones without a body, so message ID cursors stay valid
+ALTER TABLE messages ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE messages ADD COLUMN deleted_by TEXT NOT NULL DEFAULT '';
+
+-- Revisions are the prior bodies of edited or deleted messages. created_at is when the body was written,
+-- rather than when it was replaced.
+CREATE TABLE message_revisions (
+    id UUID PRIMARY KEY,
+    message_id UUID NOT NULL REFERENCES messages (id) ON DELETE CASCADE,
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX message_revisions_message_id_id_idx
+    ON message_revisions (message_id, id);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS messages_thread_id_id_idx;
+ALTER TABLE messages DROP COLUMN IF EXISTS thread_last_activity_at;
+ALTER TABLE messages DROP COLUMN IF EXISTS thread_reply_count;
+ALTER TABLE messages DROP COLUMN IF EXISTS thread_id;
+ALTER TABLE messages DROP COLUMN IF EXISTS reply_to_message_id;
@@ -0,0 +1,10 @@
+-- Replies reference the message they quote. Messages in a thread reference the message the thread branches
+-- off, which keeps the reply count and last activity of its thread.
+ALTER TABLE messages ADD COLUMN reply_to_message_id UUID REFERENCES messages (id) ON DELETE SET NULL;
+ALTER TABLE messages ADD COLUMN thread_id UUID REFERENCES messages (id) ON DELETE CASCADE;
+ALTER TABLE messages ADD COLUMN thread_reply_count INT NOT NULL DEFAULT 0;
+ALTER TABLE messages ADD COLUMN thread_last_activity_at TIMESTAMPTZ;
+
+CREATE INDEX messages_thread_id_id_idx
+    ON messages (thread_id, id)
+    WHERE thread_id IS NOT NULL;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS message_reactions;
+DROP TABLE IF EXISTS custom_emojis;
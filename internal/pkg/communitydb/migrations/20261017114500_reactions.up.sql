@@ -0,0 +1,22 @@
+CREATE TABLE custom_emojis (
+    id UUID PRIMARY KEY,
+    community_id UUID NOT NULL REFERENCES communities (id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX custom_emojis_community_id_name_idx
+    ON custom_emojis (community_id, name);
+
+-- The emoji of a reaction is either a unicode emoji, or the ID of a custom emoji of the community, in which
+-- case custom_emoji_id is set so the reaction is removed alongside the custom emoji.
+CREATE TABLE message_reactions (
+    message_id UUID NOT NULL REFERENCES messages (id) ON DELETE CASCADE,
+    emoji TEXT NOT NULL,
+    custom_emoji_id UUID REFERENCES custom_emojis (id) ON DELETE CASCADE,
+    user_address TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (message_id, emoji, user_address)
+);
@@ -0,0 +1,8 @@
+-- has_attachments is set when attachments are added to a message, so search can filter on it without joining
+ALTER TABLE messages ADD COLUMN has_attachments BOOLEAN NOT NULL DEFAULT false;
+
+-- Messages are searched with the simple configuration, which doesn't stem words, since communities write in
+-- any language. The index only covers messages that can be found.
+CREATE INDEX messages_body_search_idx
+    ON messages USING GIN (to_tsvector('simple', body))
+    WHERE deleted_at IS NULL;
@@ -0,0 +1,23 @@
+-- Attachments are uploaded before the message they belong to is sent, so message_id is only set once the
+-- message is sent. position orders the attachments of a message. Dimensions are only set for images.
+CREATE TABLE message_attachments (
+    id UUID PRIMARY KEY,
+    community_id UUID NOT NULL REFERENCES communities (id) ON DELETE CASCADE,
+    channel_id UUID NOT NULL REFERENCES channels (id) ON DELETE CASCADE,
+    message_id UUID REFERENCES messages (id) ON DELETE CASCADE,
+    uploaded_by TEXT NOT NULL,
+    filename TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size BIGINT NOT NULL,
+    width INT,
+    height INT,
+    position INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX message_attachments_message_id_idx
+    ON message_attachments (message_id, position);
+
+CREATE INDEX message_attachments_pending_idx
+    ON message_attachments (channel_id, uploaded_by)
+    WHERE message_id IS NULL;
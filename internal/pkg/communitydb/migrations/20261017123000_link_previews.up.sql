@@ -0,0 +1,12 @@
+-- Previews of the links in a message, fetched after it is sent or edited. position orders the previews by the
+-- order of the links in the message body. image_url is the original URL, which is served through the image proxy.
+CREATE TABLE message_link_previews (
+    message_id UUID NOT NULL REFERENCES messages (id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    url TEXT NOT NULL,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL,
+    site_name TEXT NOT NULL,
+    image_url TEXT NOT NULL,
+    PRIMARY KEY (message_id, position)
+);
@@ -0,0 +1,10 @@
+-- Messages pinned to their channel by moderators. Pins are removed when their message is deleted.
+CREATE TABLE pinned_messages (
+    message_id UUID PRIMARY KEY REFERENCES messages (id) ON DELETE CASCADE,
+    channel_id UUID NOT NULL REFERENCES channels (id) ON DELETE CASCADE,
+    pinned_by TEXT NOT NULL,
+    pinned_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX pinned_messages_channel_id_idx
+    ON pinned_messages (channel_id, pinned_at DESC);
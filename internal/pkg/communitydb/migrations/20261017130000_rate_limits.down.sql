@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS rate_limit_exemptions;
+
+ALTER TABLE channels DROP COLUMN IF EXISTS slow_mode_seconds;
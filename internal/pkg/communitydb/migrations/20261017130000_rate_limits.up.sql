@@ -0,0 +1,12 @@
+-- slow_mode_seconds is the minimum interval between messages of a member in a channel, or 0 if the channel
+-- doesn't have slow mode.
+ALTER TABLE channels ADD COLUMN slow_mode_seconds INT NOT NULL DEFAULT 0;
+
+-- Users the owner of a community exempted from slow mode and send rate limits in it.
+CREATE TABLE rate_limit_exemptions (
+    community_id UUID NOT NULL REFERENCES communities (id) ON DELETE CASCADE,
+    user_address TEXT NOT NULL,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (community_id, user_address)
+);
@@ -0,0 +1,30 @@
+-- Direct message conversations between users of any homeserver, hosted by the server of the user that created
+-- them. direct_key identifies 1:1 conversations by their participants, so each pair of users has a single one.
+CREATE TABLE conversations (
+    id UUID PRIMARY KEY,
+    direct_key TEXT UNIQUE,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_message_at TIMESTAMPTZ
+);
+
+CREATE TABLE conversation_participants (
+    conversation_id UUID NOT NULL REFERENCES conversations (id) ON DELETE CASCADE,
+    user_address TEXT NOT NULL,
+    joined_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (conversation_id, user_address)
+);
+
+CREATE INDEX conversation_participants_user_address_idx
+    ON conversation_participants (user_address);
+
+CREATE TABLE conversation_messages (
+    id UUID PRIMARY KEY,
+    conversation_id UUID NOT NULL REFERENCES conversations (id) ON DELETE CASCADE,
+    user_address TEXT NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX conversation_messages_conversation_id_idx
+    ON conversation_messages (conversation_id, id DESC);
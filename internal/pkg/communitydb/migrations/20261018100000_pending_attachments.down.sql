@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS message_attachments_pending_created_at_idx;
+DROP INDEX IF EXISTS message_attachments_pending_idx;
+
+CREATE INDEX message_attachments_pending_idx
+    ON message_attachments (channel_id, uploaded_by)
+    WHERE message_id IS NULL;
@@ -0,0 +1,10 @@
+-- Pending attachments are capped per member in each community, and expire when they aren't sent in time
+DROP INDEX IF EXISTS message_attachments_pending_idx;
+
+CREATE INDEX message_attachments_pending_idx
+    ON message_attachments (community_id, uploaded_by)
+    WHERE message_id IS NULL;
+
+CREATE INDEX message_attachments_pending_created_at_idx
+    ON message_attachments (created_at)
+    WHERE message_id IS NULL;
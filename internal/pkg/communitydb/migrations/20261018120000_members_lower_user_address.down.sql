@@ -0,0 +1 @@
+DROP INDEX IF EXISTS members_lower_user_address_idx;
@@ -0,0 +1,2 @@
+-- Bans match members by user address case-insensitively
+CREATE INDEX members_lower_user_address_idx ON members (lower(user_address));
@@ -9,11 +9,61 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AuditLogEntry struct {
+	ID               uuid.UUID
+	CommunityID      uuid.UUID
+	ActorUserAddress string
+	Action           string
+	TargetID         string
+	Reason           string
+	Before           []byte
+	After            []byte
+	CreatedAt        pgtype.Timestamptz
+}
+
+type Ban struct {
+	ID          uuid.UUID
+	CommunityID uuid.UUID
+	Target      string
+	IsHost      bool
+	Reason      string
+	BannedBy    string
+	ExpiresAt   pgtype.Timestamptz
+	CreatedAt   pgtype.Timestamptz
+}
+
+type Channel struct {
+	ID              uuid.UUID
+	CommunityID     uuid.UUID
+	Name            string
+	CreatedAt       pgtype.Timestamptz
+	SlowModeSeconds int32
+}
+
+type ChannelOverwrite struct {
+	ChannelID  uuid.UUID
+	TargetType string
+	Target     string
+	Allow      int64
+	Deny       int64
+	CreatedAt  pgtype.Timestamptz
+}
+
+type ChannelReadState struct {
+	MemberID          uuid.UUID
+	ChannelID         uuid.UUID
+	LastReadMessageID pgtype.UUID
+	MentionCount      int32
+	UpdatedAt         pgtype.Timestamptz
+}
+
 type Community struct {
-	ID        uuid.UUID
-	Name      string
-	IsDefault bool
-	CreatedAt pgtype.Timestamptz
+	ID            uuid.UUID
+	Name          string
+	IsDefault     bool
+	CreatedAt     pgtype.Timestamptz
+	OwnerMemberID pgtype.UUID
+	IconUrl       string
 }
 
 type CommunityMember struct {
@@ -21,6 +71,50 @@ type CommunityMember struct {
 	MemberID    uuid.UUID
 	CommunityID uuid.UUID
 	CreatedAt   pgtype.Timestamptz
+	MutedUntil  pgtype.Timestamptz
+}
+
+type Conversation struct {
+	ID            uuid.UUID
+	DirectKey     pgtype.Text
+	CreatedBy     string
+	CreatedAt     pgtype.Timestamptz
+	LastMessageAt pgtype.Timestamptz
+}
+
+type ConversationMessage struct {
+	ID             uuid.UUID
+	ConversationID uuid.UUID
+	UserAddress    string
+	Body           string
+	CreatedAt      pgtype.Timestamptz
+}
+
+type ConversationParticipant struct {
+	ConversationID uuid.UUID
+	UserAddress    string
+	JoinedAt       pgtype.Timestamptz
+}
+
+type CustomEmoji struct {
+	ID          uuid.UUID
+	CommunityID uuid.UUID
+	Name        string
+	ContentType string
+	CreatedBy   string
+	CreatedAt   pgtype.Timestamptz
+}
+
+type Invite struct {
+	Code               string
+	CommunityID        uuid.UUID
+	ChannelID          pgtype.UUID
+	CreatorUserAddress string
+	MaxUses            int32
+	Uses               int32
+	ExpiresAt          pgtype.Timestamptz
+	RevokedAt          pgtype.Timestamptz
+	CreatedAt          pgtype.Timestamptz
 }
 
 type Member struct {
@@ -28,3 +122,87 @@ type Member struct {
 	UserAddress string
 	CreatedAt   pgtype.Timestamptz
 }
+
+type MemberRole struct {
+	MemberID  uuid.UUID
+	RoleID    uuid.UUID
+	CreatedAt pgtype.Timestamptz
+}
+
+type Message struct {
+	ID                   uuid.UUID
+	ChannelID            uuid.UUID
+	UserAddress          string
+	Body                 string
+	CreatedAt            pgtype.Timestamptz
+	UpdatedAt            pgtype.Timestamptz
+	DeletedAt            pgtype.Timestamptz
+	DeletedBy            string
+	ReplyToMessageID     pgtype.UUID
+	ThreadID             pgtype.UUID
+	ThreadReplyCount     int32
+	ThreadLastActivityAt pgtype.Timestamptz
+	HasAttachments       bool
+}
+
+type MessageAttachment struct {
+	ID          uuid.UUID
+	CommunityID uuid.UUID
+	ChannelID   uuid.UUID
+	MessageID   pgtype.UUID
+	UploadedBy  string
+	Filename    string
+	ContentType string
+	Size        int64
+	Width       pgtype.Int4
+	Height      pgtype.Int4
+	Position    int32
+	CreatedAt   pgtype.Timestamptz
+}
+
+type MessageLinkPreview struct {
+	MessageID   uuid.UUID
+	Position    int32
+	Url         string
+	Title       string
+	Description string
+	SiteName    string
+	ImageUrl    string
+}
+
+type MessageReaction struct {
+	MessageID     uuid.UUID
+	Emoji         string
+	CustomEmojiID pgtype.UUID
+	UserAddress   string
+	CreatedAt     pgtype.Timestamptz
+}
+
+type MessageRevision struct {
+	ID        uuid.UUID
+	MessageID uuid.UUID
+	Body      string
+	CreatedAt pgtype.Timestamptz
+}
+
+type PinnedMessage struct {
+	MessageID uuid.UUID
+	ChannelID uuid.UUID
+	PinnedBy  string
+	PinnedAt  pgtype.Timestamptz
+}
+
+type RateLimitExemption struct {
+	CommunityID uuid.UUID
+	UserAddress string
+	CreatedBy   string
+	CreatedAt   pgtype.Timestamptz
+}
+
+type Role struct {
+	ID          uuid.UUID
+	CommunityID uuid.UUID
+	Name        string
+	Permissions int64
+	CreatedAt   pgtype.Timestamptz
+}
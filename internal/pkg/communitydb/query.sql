@@ -1,6 +1,9 @@
 -- name: GetMemberByUserAddress :one
 SELECT * FROM members WHERE user_address = @user_address;
 
+-- name: GetMembersByUserAddressInsensitive :many
+SELECT * FROM members WHERE lower(user_address) = lower(@user_address);
+
 -- name: UpsertMember :one
 INSERT INTO members (id, user_address)
 VALUES ($1, $2)
@@ -8,8 +11,8 @@ VALUES ($1, $2)
     RETURNING *;
 
 -- name: InsertCommunity :one
-INSERT INTO communities (id, name)
-VALUES ($1, $2)
+INSERT INTO communities (id, name, icon_url)
+VALUES ($1, $2, $3)
     RETURNING *;
 
 -- name: UpsertDefaultCommunity :one
@@ -30,4 +33,556 @@ SELECT c.* FROM community_members INNER JOIN communities c ON c.id = community_m
 INSERT INTO community_members (id, member_id, community_id)
 VALUES ($1, $2, $3)
     ON CONFLICT (member_id, community_id) DO NOTHING
-    RETURNING *;
\ No newline at end of file
+    RETURNING *;
+
+-- name: GetCommunityMember :one
+SELECT * FROM community_members WHERE member_id = $1 AND community_id = $2;
+
+-- name: InsertChannel :one
+INSERT INTO channels (id, community_id, name)
+VALUES ($1, $2, $3)
+    RETURNING *;
+
+-- name: GetChannel :one
+SELECT * FROM channels WHERE id = $1 AND community_id = $2;
+
+-- name: GetChannelForUpdate :one
+SELECT * FROM channels WHERE id = $1 AND community_id = $2
+    FOR UPDATE;
+
+-- name: GetCommunityChannels :many
+SELECT * FROM channels WHERE community_id = $1 ORDER BY created_at, id;
+
+-- name: UpdateChannelName :one
+UPDATE channels SET name = $3
+WHERE id = $1 AND community_id = $2
+    RETURNING *;
+
+-- name: UpdateChannelSlowMode :one
+UPDATE channels SET slow_mode_seconds = $3
+WHERE id = $1 AND community_id = $2
+    RETURNING *;
+
+-- name: DeleteChannel :one
+DELETE FROM channels WHERE id = $1 AND community_id = $2
+    RETURNING *;
+
+-- name: InsertMessage :one
+INSERT INTO messages (id, channel_id, user_address, body, reply_to_message_id, thread_id, has_attachments)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+    RETURNING *;
+
+-- name: GetLatestChannelMessages :many
+SELECT * FROM messages
+WHERE channel_id = @channel_id AND thread_id IS NULL
+ORDER BY id DESC
+LIMIT @max_results;
+
+-- name: GetChannelMessagesBefore :many
+SELECT * FROM messages
+WHERE channel_id = @channel_id AND id < @before AND thread_id IS NULL
+ORDER BY id DESC
+LIMIT @max_results;
+
+-- name: GetChannelMessagesAfter :many
+SELECT * FROM messages
+WHERE channel_id = @channel_id AND id > @after AND thread_id IS NULL
+ORDER BY id
+LIMIT @max_results;
+
+-- name: GetCommunity :one
+SELECT * FROM communities WHERE id = $1;
+
+-- name: GetCommunityForUpdate :one
+SELECT * FROM communities WHERE id = $1
+    FOR UPDATE;
+
+-- name: SetCommunityOwner :exec
+UPDATE communities SET owner_member_id = $2 WHERE id = $1;
+
+-- name: InsertRole :one
+INSERT INTO roles (id, community_id, name, permissions)
+VALUES ($1, $2, $3, $4)
+    RETURNING *;
+
+-- name: GetRole :one
+SELECT * FROM roles WHERE id = $1 AND community_id = $2;
+
+-- name: GetCommunityRoles :many
+SELECT * FROM roles WHERE community_id = $1 ORDER BY created_at, id;
+
+-- name: UpdateRole :one
+UPDATE roles SET name = $3, permissions = $4
+WHERE id = $1 AND community_id = $2
+    RETURNING *;
+
+-- name: DeleteRole :execrows
+DELETE FROM roles WHERE id = $1 AND community_id = $2;
+
+-- name: AssignMemberRole :exec
+INSERT INTO member_roles (member_id, role_id)
+VALUES ($1, $2)
+    ON CONFLICT (member_id, role_id) DO NOTHING;
+
+-- name: UnassignMemberRole :execrows
+DELETE FROM member_roles WHERE member_id = $1 AND role_id = $2;
+
+-- name: GetMemberPermissions :one
+SELECT COALESCE(bit_or(r.permissions), 0)::BIGINT AS permissions
+FROM member_roles INNER JOIN roles r ON r.id = member_roles.role_id
+WHERE member_roles.member_id = $1 AND r.community_id = $2;
+
+-- name: GetMemberRoleIds :many
+SELECT member_roles.role_id FROM member_roles INNER JOIN roles r ON r.id = member_roles.role_id
+WHERE member_roles.member_id = $1 AND r.community_id = $2;
+
+-- name: GetChannelOverwrites :many
+SELECT * FROM channel_overwrites WHERE channel_id = $1 ORDER BY created_at, target_type, target;
+
+-- name: GetCommunityChannelOverwrites :many
+SELECT o.* FROM channel_overwrites o INNER JOIN channels c ON c.id = o.channel_id
+WHERE c.community_id = $1;
+
+-- name: UpsertChannelOverwrite :one
+INSERT INTO channel_overwrites (channel_id, target_type, target, allow, deny)
+VALUES ($1, $2, $3, $4, $5)
+    ON CONFLICT (channel_id, target_type, target)
+    DO UPDATE SET allow = EXCLUDED.allow, deny = EXCLUDED.deny
+    RETURNING *;
+
+-- name: DeleteChannelOverwrite :execrows
+DELETE FROM channel_overwrites WHERE channel_id = $1 AND target_type = $2 AND target = $3;
+
+-- name: DeleteTargetOverwrites :exec
+DELETE FROM channel_overwrites WHERE target_type = $1 AND target = $2;
+
+-- name: CountCommunityMembers :one
+SELECT count(*) FROM community_members WHERE community_id = $1;
+
+-- name: InsertInvite :one
+INSERT INTO invites (code, community_id, channel_id, creator_user_address, max_uses, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+    RETURNING *;
+
+-- name: GetInvite :one
+SELECT * FROM invites WHERE code = $1;
+
+-- name: GetCommunityInvites :many
+SELECT * FROM invites WHERE community_id = $1 AND revoked_at IS NULL ORDER BY created_at, code;
+
+-- name: GetCreatorInvites :many
+SELECT * FROM invites WHERE community_id = $1 AND creator_user_address = $2 AND revoked_at IS NULL ORDER BY created_at, code;
+
+-- name: RevokeInvite :execrows
+UPDATE invites SET revoked_at = now()
+WHERE code = $1 AND community_id = $2 AND revoked_at IS NULL;
+
+-- name: UseInvite :one
+UPDATE invites SET uses = uses + 1
+WHERE code = $1
+  AND revoked_at IS NULL
+  AND (expires_at IS NULL OR expires_at > now())
+  AND (max_uses = 0 OR uses < max_uses)
+    RETURNING *;
+
+-- name: GetMember :one
+SELECT * FROM members WHERE id = $1;
+
+-- name: DeleteCommunityMember :execrows
+DELETE FROM community_members WHERE member_id = $1 AND community_id = $2;
+
+-- name: GetHostCommunityMembers :many
+SELECT m.* FROM members m
+    INNER JOIN community_members ON community_members.member_id = m.id
+WHERE community_members.community_id = @community_id
+  AND lower(substring(m.user_address FROM position('@' IN m.user_address) + 1)) = @host
+ORDER BY m.id;
+
+-- name: DeleteCommunityMemberRoles :exec
+DELETE FROM member_roles USING roles r
+WHERE r.id = member_roles.role_id AND member_roles.member_id = $1 AND r.community_id = $2;
+
+-- name: SetCommunityMemberMutedUntil :execrows
+UPDATE community_members SET muted_until = $3 WHERE member_id = $1 AND community_id = $2;
+
+-- name: UpsertBan :one
+INSERT INTO bans (id, community_id, target, is_host, reason, banned_by, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+    ON CONFLICT (community_id, target, is_host)
+    DO UPDATE SET reason = EXCLUDED.reason, banned_by = EXCLUDED.banned_by, expires_at = EXCLUDED.expires_at, created_at = now()
+    RETURNING *;
+
+-- name: GetCommunityBans :many
+SELECT * FROM bans
+WHERE community_id = $1 AND (expires_at IS NULL OR expires_at > now())
+ORDER BY created_at, id;
+
+-- name: GetActiveBan :one
+SELECT * FROM bans
+WHERE community_id = @community_id
+  AND (expires_at IS NULL OR expires_at > now())
+  AND ((is_host = false AND lower(target) = lower(@user_address)) OR (is_host = true AND target = @host))
+LIMIT 1;
+
+-- name: DeleteBan :one
+DELETE FROM bans WHERE id = $1 AND community_id = $2
+    RETURNING *;
+
+-- name: GetChannelOverwrite :one
+SELECT * FROM channel_overwrites WHERE channel_id = $1 AND target_type = $2 AND target = $3;
+
+-- name: InsertAuditLogEntry :exec
+INSERT INTO audit_log_entries (id, community_id, actor_user_address, action, target_id, reason, before, after)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: GetAuditLogEntries :many
+SELECT * FROM audit_log_entries
+WHERE community_id = @community_id
+  AND (sqlc.narg('before')::UUID IS NULL OR id < sqlc.narg('before'))
+  AND (sqlc.narg('action')::TEXT IS NULL OR action = sqlc.narg('action'))
+  AND (sqlc.narg('actor_user_address')::TEXT IS NULL OR actor_user_address = sqlc.narg('actor_user_address'))
+  AND (sqlc.narg('target_id')::TEXT IS NULL OR target_id = sqlc.narg('target_id'))
+ORDER BY id DESC
+LIMIT @max_results;
+
+-- name: UpdateCommunity :one
+UPDATE communities SET name = $2, icon_url = $3 WHERE id = $1
+    RETURNING *;
+
+-- name: DeleteCommunity :execrows
+DELETE FROM communities WHERE id = $1 AND is_default = false;
+
+-- name: AckChannel :one
+INSERT INTO channel_read_states (member_id, channel_id, last_read_message_id)
+VALUES ($1, $2, $3)
+    ON CONFLICT (member_id, channel_id) DO UPDATE
+    SET last_read_message_id = GREATEST(channel_read_states.last_read_message_id, excluded.last_read_message_id),
+        mention_count = CASE
+            WHEN channel_read_states.last_read_message_id IS NULL OR excluded.last_read_message_id >= channel_read_states.last_read_message_id THEN 0
+            ELSE channel_read_states.mention_count
+        END,
+        updated_at = now()
+    RETURNING *;
+
+-- name: IncrementMentionCounts :exec
+INSERT INTO channel_read_states (member_id, channel_id, mention_count)
+SELECT unnest(@member_ids::uuid[]), @channel_id::uuid, 1
+    ON CONFLICT (member_id, channel_id) DO UPDATE
+    SET mention_count = channel_read_states.mention_count + 1,
+        updated_at = now();
+
+-- name: GetMentionedMembers :many
+SELECT members.id AS member_id, members.user_address,
+       COALESCE(array_agg(member_roles.role_id) FILTER (WHERE member_roles.role_id IS NOT NULL), '{}')::uuid[] AS role_ids
+FROM community_members
+    INNER JOIN members ON members.id = community_members.member_id
+    LEFT JOIN member_roles ON member_roles.member_id = members.id
+        AND member_roles.role_id IN (SELECT roles.id FROM roles WHERE roles.community_id = @community_id)
+WHERE community_members.community_id = @community_id
+    AND (@everyone::bool
+        OR members.user_address = ANY(@user_addresses::text[])
+        OR members.id IN (SELECT mentioned_roles.member_id FROM member_roles mentioned_roles WHERE mentioned_roles.role_id = ANY(@role_ids::uuid[])))
+GROUP BY members.id, members.user_address;
+
+-- name: GetChannelReadStates :many
+SELECT channels.id AS channel_id,
+       channel_read_states.last_read_message_id,
+       COALESCE(channel_read_states.mention_count, 0)::INT AS mention_count,
+       (SELECT count(*) FROM (
+            SELECT 1 FROM messages
+            WHERE messages.channel_id = channels.id
+              AND messages.user_address <> members.user_address
+              AND messages.deleted_at IS NULL
+              AND messages.thread_id IS NULL
+              AND (messages.id > channel_read_states.last_read_message_id
+                OR (channel_read_states.last_read_message_id IS NULL AND messages.created_at > community_members.created_at))
+            LIMIT $3
+        ) unread_messages) AS unread_count
+FROM channels
+    INNER JOIN community_members ON community_members.community_id = channels.community_id AND community_members.member_id = $1
+    INNER JOIN members ON members.id = community_members.member_id
+    LEFT JOIN channel_read_states ON channel_read_states.channel_id = channels.id AND channel_read_states.member_id = community_members.member_id
+WHERE channels.community_id = $2
+ORDER BY channels.created_at, channels.id;
+
+-- name: GetMemberChannelReadStates :many
+SELECT channels.community_id,
+       channels.id AS channel_id,
+       COALESCE(channel_read_states.mention_count, 0)::INT AS mention_count,
+       (SELECT count(*) FROM (
+            SELECT 1 FROM messages
+            WHERE messages.channel_id = channels.id
+              AND messages.user_address <> members.user_address
+              AND messages.deleted_at IS NULL
+              AND messages.thread_id IS NULL
+              AND (messages.id > channel_read_states.last_read_message_id
+                OR (channel_read_states.last_read_message_id IS NULL AND messages.created_at > community_members.created_at))
+            LIMIT $2
+        ) unread_messages) AS unread_count
+FROM channels
+    INNER JOIN community_members ON community_members.community_id = channels.community_id AND community_members.member_id = $1
+    INNER JOIN members ON members.id = community_members.member_id
+    LEFT JOIN channel_read_states ON channel_read_states.channel_id = channels.id AND channel_read_states.member_id = community_members.member_id;
+
+-- name: GetMemberCommunityRoles :many
+SELECT r.community_id, r.id, r.permissions FROM member_roles INNER JOIN roles r ON r.id = member_roles.role_id
+WHERE member_roles.member_id = $1;
+
+-- name: GetMemberChannelOverwrites :many
+SELECT o.channel_id, o.target_type, o.target, o.allow, o.deny, o.created_at FROM channel_overwrites o
+    INNER JOIN channels c ON c.id = o.channel_id
+    INNER JOIN community_members ON community_members.community_id = c.community_id
+WHERE community_members.member_id = $1;
+
+-- name: DeleteCommunityMemberReadStates :exec
+DELETE FROM channel_read_states
+WHERE member_id = $1 AND channel_id IN (SELECT id FROM channels WHERE community_id = $2);
+
+-- name: GetMessage :one
+SELECT * FROM messages WHERE id = $1 AND channel_id = $2;
+
+-- name: GetMessageForUpdate :one
+SELECT * FROM messages WHERE id = $1 AND channel_id = $2
+    FOR UPDATE;
+
+-- name: UpdateMessageBody :one
+UPDATE messages SET body = $2, updated_at = now()
+WHERE id = $1 AND deleted_at IS NULL
+    RETURNING *;
+
+-- name: DeleteMessage :one
+UPDATE messages SET body = '', deleted_at = now(), deleted_by = $2
+WHERE id = $1 AND deleted_at IS NULL
+    RETURNING *;
+
+-- name: InsertMessageRevision :exec
+INSERT INTO message_revisions (id, message_id, body, created_at)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetMessageRevisions :many
+SELECT * FROM message_revisions WHERE message_id = $1 ORDER BY id;
+
+-- name: GetLatestThreadMessages :many
+SELECT * FROM messages
+WHERE thread_id = @thread_id
+ORDER BY id DESC
+LIMIT @max_results;
+
+-- name: GetThreadMessagesBefore :many
+SELECT * FROM messages
+WHERE thread_id = @thread_id AND id < @before
+ORDER BY id DESC
+LIMIT @max_results;
+
+-- name: GetThreadMessagesAfter :many
+SELECT * FROM messages
+WHERE thread_id = @thread_id AND id > @after
+ORDER BY id
+LIMIT @max_results;
+
+-- name: AddThreadReply :one
+UPDATE messages SET thread_reply_count = thread_reply_count + 1, thread_last_activity_at = now()
+WHERE id = $1
+    RETURNING *;
+
+-- name: RemoveThreadReply :one
+UPDATE messages SET thread_reply_count = GREATEST(thread_reply_count - 1, 0)
+WHERE id = $1
+    RETURNING *;
+
+-- name: GetMessagesByIds :many
+SELECT * FROM messages WHERE channel_id = @channel_id AND id = ANY(@ids::uuid[]);
+
+-- name: InsertCustomEmoji :one
+INSERT INTO custom_emojis (id, community_id, name, content_type, created_by)
+VALUES ($1, $2, $3, $4, $5)
+    RETURNING *;
+
+-- name: GetCustomEmoji :one
+SELECT * FROM custom_emojis WHERE id = $1;
+
+-- name: GetCommunityCustomEmojis :many
+SELECT * FROM custom_emojis WHERE community_id = $1 ORDER BY name;
+
+-- name: CountCommunityCustomEmojis :one
+SELECT count(*) FROM custom_emojis WHERE community_id = $1;
+
+-- name: DeleteCustomEmoji :one
+DELETE FROM custom_emojis WHERE id = $1 AND community_id = $2
+    RETURNING *;
+
+-- name: DeleteCommunityCustomEmojis :many
+DELETE FROM custom_emojis WHERE community_id = $1
+    RETURNING *;
+
+-- name: AddReaction :execrows
+INSERT INTO message_reactions (message_id, emoji, custom_emoji_id, user_address)
+VALUES ($1, $2, $3, $4)
+    ON CONFLICT (message_id, emoji, user_address) DO NOTHING;
+
+-- name: RemoveReaction :execrows
+DELETE FROM message_reactions WHERE message_id = $1 AND emoji = $2 AND user_address = $3;
+
+-- name: CountOtherReactionEmojis :one
+SELECT count(DISTINCT emoji) FROM message_reactions WHERE message_id = $1 AND emoji <> $2;
+
+-- name: GetMessagesReactions :many
+SELECT message_id,
+       emoji,
+       custom_emoji_id,
+       count(*) AS count,
+       bool_or(user_address = @user_address) AS me
+FROM message_reactions
+WHERE message_id = ANY(@message_ids::uuid[])
+GROUP BY message_id, emoji, custom_emoji_id
+ORDER BY message_id, min(created_at);
+
+-- name: SearchMessages :many
+SELECT * FROM messages
+WHERE channel_id = ANY(@channel_ids::uuid[])
+  AND deleted_at IS NULL
+  AND to_tsvector('simple', body) @@ websearch_to_tsquery('simple', @query)
+  AND (sqlc.narg('before')::UUID IS NULL OR id < sqlc.narg('before'))
+  AND (sqlc.narg('user_address')::TEXT IS NULL OR lower(user_address) = lower(sqlc.narg('user_address')))
+  AND (sqlc.narg('created_after')::TIMESTAMPTZ IS NULL OR created_at >= sqlc.narg('created_after'))
+  AND (sqlc.narg('created_before')::TIMESTAMPTZ IS NULL OR created_at < sqlc.narg('created_before'))
+  AND (NOT @has_attachments::BOOL OR has_attachments)
+ORDER BY id DESC
+LIMIT @max_results;
+
+-- name: InsertMessageAttachment :one
+INSERT INTO message_attachments (id, community_id, channel_id, uploaded_by, filename, content_type, size, width, height)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+    RETURNING *;
+
+-- name: CountPendingMessageAttachments :one
+SELECT count(*) FROM message_attachments
+WHERE community_id = $1 AND uploaded_by = $2 AND message_id IS NULL;
+
+-- name: AttachMessageAttachments :many
+UPDATE message_attachments
+SET message_id = @message_id, position = array_position(@ids::uuid[], id)
+WHERE id = ANY(@ids::uuid[]) AND channel_id = @channel_id AND uploaded_by = @uploaded_by AND message_id IS NULL
+    RETURNING *;
+
+-- name: GetMessageAttachment :one
+SELECT * FROM message_attachments WHERE id = $1 AND channel_id = $2;
+
+-- name: GetMessagesAttachments :many
+SELECT * FROM message_attachments
+WHERE message_id = ANY(@message_ids::uuid[])
+ORDER BY message_id, position;
+
+-- name: DeleteMessageAttachments :many
+DELETE FROM message_attachments WHERE message_id = $1
+    RETURNING *;
+
+-- name: DeleteChannelAttachments :many
+DELETE FROM message_attachments WHERE channel_id = $1
+    RETURNING *;
+
+-- name: DeleteCommunityAttachments :many
+DELETE FROM message_attachments WHERE community_id = $1
+    RETURNING *;
+
+-- name: DeleteExpiredPendingAttachments :many
+DELETE FROM message_attachments
+WHERE id IN (
+    SELECT id FROM message_attachments
+    WHERE message_id IS NULL AND created_at < @expired_before
+    ORDER BY created_at
+    LIMIT @max_results
+)
+    RETURNING *;
+
+-- name: InsertMessageLinkPreview :exec
+INSERT INTO message_link_previews (message_id, position, url, title, description, site_name, image_url)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: DeleteMessageLinkPreviews :execrows
+DELETE FROM message_link_previews WHERE message_id = $1;
+
+-- name: GetMessagesLinkPreviews :many
+SELECT * FROM message_link_previews
+WHERE message_id = ANY(@message_ids::uuid[])
+ORDER BY message_id, position;
+
+-- name: PinMessage :one
+INSERT INTO pinned_messages (message_id, channel_id, pinned_by)
+VALUES ($1, $2, $3)
+    ON CONFLICT (message_id) DO NOTHING
+    RETURNING *;
+
+-- name: UnpinMessage :execrows
+DELETE FROM pinned_messages WHERE message_id = $1 AND channel_id = $2;
+
+-- name: CountPinnedMessages :one
+SELECT count(*) FROM pinned_messages WHERE channel_id = $1;
+
+-- name: IsMessagePinned :one
+SELECT EXISTS(SELECT 1 FROM pinned_messages WHERE message_id = $1);
+
+-- name: GetPinnedMessages :many
+SELECT * FROM pinned_messages
+WHERE channel_id = $1
+ORDER BY pinned_at DESC;
+
+-- name: GetRateLimitExemptions :many
+SELECT * FROM rate_limit_exemptions
+WHERE community_id = $1
+ORDER BY created_at, user_address;
+
+-- name: IsRateLimitExempt :one
+SELECT EXISTS(SELECT 1 FROM rate_limit_exemptions WHERE community_id = $1 AND user_address = $2);
+
+-- name: InsertRateLimitExemption :execrows
+INSERT INTO rate_limit_exemptions (community_id, user_address, created_by)
+VALUES ($1, $2, $3)
+    ON CONFLICT (community_id, user_address) DO NOTHING;
+
+-- name: DeleteRateLimitExemption :execrows
+DELETE FROM rate_limit_exemptions WHERE community_id = $1 AND user_address = $2;
+
+-- name: InsertConversation :one
+INSERT INTO conversations (id, direct_key, created_by)
+VALUES ($1, $2, $3)
+    ON CONFLICT (direct_key) DO NOTHING
+    RETURNING *;
+
+-- name: GetConversationByDirectKey :one
+SELECT * FROM conversations WHERE direct_key = $1;
+
+-- name: InsertConversationParticipants :exec
+INSERT INTO conversation_participants (conversation_id, user_address)
+SELECT @conversation_id::uuid, unnest(@user_addresses::text[]);
+
+-- name: GetConversation :one
+SELECT conversations.* FROM conversations
+    INNER JOIN conversation_participants ON conversation_participants.conversation_id = conversations.id
+WHERE conversations.id = @id AND conversation_participants.user_address = @user_address;
+
+-- name: GetUserConversations :many
+SELECT conversations.* FROM conversations
+    INNER JOIN conversation_participants ON conversation_participants.conversation_id = conversations.id
+WHERE conversation_participants.user_address = @user_address
+ORDER BY COALESCE(conversations.last_message_at, conversations.created_at) DESC, conversations.id DESC
+LIMIT @max_results;
+
+-- name: GetConversationsParticipants :many
+SELECT * FROM conversation_participants
+WHERE conversation_id = ANY(@conversation_ids::uuid[])
+ORDER BY conversation_id, joined_at, user_address;
+
+-- name: InsertConversationMessage :one
+INSERT INTO conversation_messages (id, conversation_id, user_address, body)
+VALUES ($1, $2, $3, $4)
+    RETURNING *;
+
+-- name: UpdateConversationLastMessage :exec
+UPDATE conversations SET last_message_at = $2 WHERE id = $1;
+
+-- name: GetConversationMessages :many
+SELECT * FROM conversation_messages
+WHERE conversation_id = @conversation_id AND (sqlc.narg('before')::uuid IS NULL OR id < sqlc.narg('before')::uuid)
+ORDER BY id DESC
+LIMIT @max_results;
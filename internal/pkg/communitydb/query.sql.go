@@ -9,53 +9,2996 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const ackChannel = `-- name: AckChannel :one
+INSERT INTO channel_read_states (member_id, channel_id, last_read_message_id)
+VALUES ($1, $2, $3)
+    ON CONFLICT (member_id, channel_id) DO UPDATE
+    SET last_read_message_id = GREATEST(channel_read_states.last_read_message_id, excluded.last_read_message_id),
+        mention_count = CASE
+            WHEN channel_read_states.last_read_message_id IS NULL OR excluded.last_read_message_id >= channel_read_states.last_read_message_id THEN 0
+            ELSE channel_read_states.mention_count
+        END,
+        updated_at = now()
+    RETURNING member_id, channel_id, last_read_message_id, mention_count, updated_at
+`
+
+type AckChannelParams struct {
+	MemberID          uuid.UUID
+	ChannelID         uuid.UUID
+	LastReadMessageID pgtype.UUID
+}
+
+func (q *Queries) AckChannel(ctx context.Context, arg AckChannelParams) (ChannelReadState, error) {
+	row := q.db.QueryRow(ctx, ackChannel, arg.MemberID, arg.ChannelID, arg.LastReadMessageID)
+	var i ChannelReadState
+	err := row.Scan(
+		&i.MemberID,
+		&i.ChannelID,
+		&i.LastReadMessageID,
+		&i.MentionCount,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const addReaction = `-- name: AddReaction :execrows
+INSERT INTO message_reactions (message_id, emoji, custom_emoji_id, user_address)
+VALUES ($1, $2, $3, $4)
+    ON CONFLICT (message_id, emoji, user_address) DO NOTHING
+`
+
+type AddReactionParams struct {
+	MessageID     uuid.UUID
+	Emoji         string
+	CustomEmojiID pgtype.UUID
+	UserAddress   string
+}
+
+func (q *Queries) AddReaction(ctx context.Context, arg AddReactionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, addReaction,
+		arg.MessageID,
+		arg.Emoji,
+		arg.CustomEmojiID,
+		arg.UserAddress,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const addThreadReply = `-- name: AddThreadReply :one
+UPDATE messages SET thread_reply_count = thread_reply_count + 1, thread_last_activity_at = now()
+WHERE id = $1
+    RETURNING id, channel_id, user_address, body, created_at, updated_at, deleted_at, deleted_by, reply_to_message_id, thread_id, thread_reply_count, thread_last_activity_at, has_attachments
+`
+
+func (q *Queries) AddThreadReply(ctx context.Context, id uuid.UUID) (Message, error) {
+	row := q.db.QueryRow(ctx, addThreadReply, id)
+	var i Message
+	err := row.Scan(
+		&i.ID,
+		&i.ChannelID,
+		&i.UserAddress,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.ReplyToMessageID,
+		&i.ThreadID,
+		&i.ThreadReplyCount,
+		&i.ThreadLastActivityAt,
+		&i.HasAttachments,
+	)
+	return i, err
+}
+
+const assignMemberRole = `-- name: AssignMemberRole :exec
+INSERT INTO member_roles (member_id, role_id)
+VALUES ($1, $2)
+    ON CONFLICT (member_id, role_id) DO NOTHING
+`
+
+type AssignMemberRoleParams struct {
+	MemberID uuid.UUID
+	RoleID   uuid.UUID
+}
+
+func (q *Queries) AssignMemberRole(ctx context.Context, arg AssignMemberRoleParams) error {
+	_, err := q.db.Exec(ctx, assignMemberRole, arg.MemberID, arg.RoleID)
+	return err
+}
+
+const attachMessageAttachments = `-- name: AttachMessageAttachments :many
+UPDATE message_attachments
+SET message_id = $1, position = array_position($2::uuid[], id)
+WHERE id = ANY($2::uuid[]) AND channel_id = $3 AND uploaded_by = $4 AND message_id IS NULL
+    RETURNING id, community_id, channel_id, message_id, uploaded_by, filename, content_type, size, width, height, position, created_at
+`
+
+type AttachMessageAttachmentsParams struct {
+	MessageID  pgtype.UUID
+	Ids        []uuid.UUID
+	ChannelID  uuid.UUID
+	UploadedBy string
+}
+
+func (q *Queries) AttachMessageAttachments(ctx context.Context, arg AttachMessageAttachmentsParams) ([]MessageAttachment, error) {
+	rows, err := q.db.Query(ctx, attachMessageAttachments,
+		arg.MessageID,
+		arg.Ids,
+		arg.ChannelID,
+		arg.UploadedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MessageAttachment
+	for rows.Next() {
+		var i MessageAttachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.CommunityID,
+			&i.ChannelID,
+			&i.MessageID,
+			&i.UploadedBy,
+			&i.Filename,
+			&i.ContentType,
+			&i.Size,
+			&i.Width,
+			&i.Height,
+			&i.Position,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countCommunityCustomEmojis = `-- name: CountCommunityCustomEmojis :one
+SELECT count(*) FROM custom_emojis WHERE community_id = $1
+`
+
+func (q *Queries) CountCommunityCustomEmojis(ctx context.Context, communityID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countCommunityCustomEmojis, communityID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countCommunityMembers = `-- name: CountCommunityMembers :one
+SELECT count(*) FROM community_members WHERE community_id = $1
+`
+
+func (q *Queries) CountCommunityMembers(ctx context.Context, communityID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countCommunityMembers, communityID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countOtherReactionEmojis = `-- name: CountOtherReactionEmojis :one
+SELECT count(DISTINCT emoji) FROM message_reactions WHERE message_id = $1 AND emoji <> $2
+`
+
+type CountOtherReactionEmojisParams struct {
+	MessageID uuid.UUID
+	Emoji     string
+}
+
+func (q *Queries) CountOtherReactionEmojis(ctx context.Context, arg CountOtherReactionEmojisParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countOtherReactionEmojis, arg.MessageID, arg.Emoji)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countPendingMessageAttachments = `-- name: CountPendingMessageAttachments :one
+SELECT count(*) FROM message_attachments
+WHERE community_id = $1 AND uploaded_by = $2 AND message_id IS NULL
+`
+
+type CountPendingMessageAttachmentsParams struct {
+	CommunityID uuid.UUID
+	UploadedBy  string
+}
+
+func (q *Queries) CountPendingMessageAttachments(ctx context.Context, arg CountPendingMessageAttachmentsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPendingMessageAttachments, arg.CommunityID, arg.UploadedBy)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countPinnedMessages = `-- name: CountPinnedMessages :one
+SELECT count(*) FROM pinned_messages WHERE channel_id = $1
+`
+
+func (q *Queries) CountPinnedMessages(ctx context.Context, channelID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countPinnedMessages, channelID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteBan = `-- name: DeleteBan :one
+DELETE FROM bans WHERE id = $1 AND community_id = $2
+    RETURNING id, community_id, target, is_host, reason, banned_by, expires_at, created_at
+`
+
+type DeleteBanParams struct {
+	ID          uuid.UUID
+	CommunityID uuid.UUID
+}
+
+func (q *Queries) DeleteBan(ctx context.Context, arg DeleteBanParams) (Ban, error) {
+	row := q.db.QueryRow(ctx, deleteBan, arg.ID, arg.CommunityID)
+	var i Ban
+	err := row.Scan(
+		&i.ID,
+		&i.CommunityID,
+		&i.Target,
+		&i.IsHost,
+		&i.Reason,
+		&i.BannedBy,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteChannel = `-- name: DeleteChannel :one
+DELETE FROM channels WHERE id = $1 AND community_id = $2
+    RETURNING id, community_id, name, created_at, slow_mode_seconds
+`
+
+type DeleteChannelParams struct {
+	ID          uuid.UUID
+	CommunityID uuid.UUID
+}
+
+func (q *Queries) DeleteChannel(ctx context.Context, arg DeleteChannelParams) (Channel, error) {
+	row := q.db.QueryRow(ctx, deleteChannel, arg.ID, arg.CommunityID)
+	var i Channel
+	err := row.Scan(
+		&i.ID,
+		&i.CommunityID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.SlowModeSeconds,
+	)
+	return i, err
+}
+
+const deleteChannelAttachments = `-- name: DeleteChannelAttachments :many
+DELETE FROM message_attachments WHERE channel_id = $1
+    RETURNING id, community_id, channel_id, message_id, uploaded_by, filename, content_type, size, width, height, position, created_at
+`
+
+func (q *Queries) DeleteChannelAttachments(ctx context.Context, channelID uuid.UUID) ([]MessageAttachment, error) {
+	rows, err := q.db.Query(ctx, deleteChannelAttachments, channelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MessageAttachment
+	for rows.Next() {
+		var i MessageAttachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.CommunityID,
+			&i.ChannelID,
+			&i.MessageID,
+			&i.UploadedBy,
+			&i.Filename,
+			&i.ContentType,
+			&i.Size,
+			&i.Width,
+			&i.Height,
+			&i.Position,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteChannelOverwrite = `-- name: DeleteChannelOverwrite :execrows
+DELETE FROM channel_overwrites WHERE channel_id = $1 AND target_type = $2 AND target = $3
+`
+
+type DeleteChannelOverwriteParams struct {
+	ChannelID  uuid.UUID
+	TargetType string
+	Target     string
+}
+
+func (q *Queries) DeleteChannelOverwrite(ctx context.Context, arg DeleteChannelOverwriteParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteChannelOverwrite, arg.ChannelID, arg.TargetType, arg.Target)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteCommunity = `-- name: DeleteCommunity :execrows
+DELETE FROM communities WHERE id = $1 AND is_default = false
+`
+
+func (q *Queries) DeleteCommunity(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCommunity, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteCommunityAttachments = `-- name: DeleteCommunityAttachments :many
+DELETE FROM message_attachments WHERE community_id = $1
+    RETURNING id, community_id, channel_id, message_id, uploaded_by, filename, content_type, size, width, height, position, created_at
+`
+
+func (q *Queries) DeleteCommunityAttachments(ctx context.Context, communityID uuid.UUID) ([]MessageAttachment, error) {
+	rows, err := q.db.Query(ctx, deleteCommunityAttachments, communityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MessageAttachment
+	for rows.Next() {
+		var i MessageAttachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.CommunityID,
+			&i.ChannelID,
+			&i.MessageID,
+			&i.UploadedBy,
+			&i.Filename,
+			&i.ContentType,
+			&i.Size,
+			&i.Width,
+			&i.Height,
+			&i.Position,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteCommunityCustomEmojis = `-- name: DeleteCommunityCustomEmojis :many
+DELETE FROM custom_emojis WHERE community_id = $1
+    RETURNING id, community_id, name, content_type, created_by, created_at
+`
+
+func (q *Queries) DeleteCommunityCustomEmojis(ctx context.Context, communityID uuid.UUID) ([]CustomEmoji, error) {
+	rows, err := q.db.Query(ctx, deleteCommunityCustomEmojis, communityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CustomEmoji
+	for rows.Next() {
+		var i CustomEmoji
+		if err := rows.Scan(
+			&i.ID,
+			&i.CommunityID,
+			&i.Name,
+			&i.ContentType,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteCommunityMember = `-- name: DeleteCommunityMember :execrows
+DELETE FROM community_members WHERE member_id = $1 AND community_id = $2
+`
+
+type DeleteCommunityMemberParams struct {
+	MemberID    uuid.UUID
+	CommunityID uuid.UUID
+}
+
+func (q *Queries) DeleteCommunityMember(ctx context.Context, arg DeleteCommunityMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCommunityMember, arg.MemberID, arg.CommunityID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteCommunityMemberReadStates = `-- name: DeleteCommunityMemberReadStates :exec
+DELETE FROM channel_read_states
+WHERE member_id = $1 AND channel_id IN (SELECT id FROM channels WHERE community_id = $2)
+`
+
+type DeleteCommunityMemberReadStatesParams struct {
+	MemberID    uuid.UUID
+	CommunityID uuid.UUID
+}
+
+func (q *Queries) DeleteCommunityMemberReadStates(ctx context.Context, arg DeleteCommunityMemberReadStatesParams) error {
+	_, err := q.db.Exec(ctx, deleteCommunityMemberReadStates, arg.MemberID, arg.CommunityID)
+	return err
+}
+
+const deleteCommunityMemberRoles = `-- name: DeleteCommunityMemberRoles :exec
+DELETE FROM member_roles USING roles r
+WHERE r.id = member_roles.role_id AND member_roles.member_id = $1 AND r.community_id = $2
+`
+
+type DeleteCommunityMemberRolesParams struct {
+	MemberID    uuid.UUID
+	CommunityID uuid.UUID
+}
+
+func (q *Queries) DeleteCommunityMemberRoles(ctx context.Context, arg DeleteCommunityMemberRolesParams) error {
+	_, err := q.db.Exec(ctx, deleteCommunityMemberRoles, arg.MemberID, arg.CommunityID)
+	return err
+}
+
+const deleteCustomEmoji = `-- name: DeleteCustomEmoji :one
+DELETE FROM custom_emojis WHERE id = $1 AND community_id = $2
+    RETURNING id, community_id, name, content_type, created_by, created_at
+`
+
+type DeleteCustomEmojiParams struct {
+	ID          uuid.UUID
+	CommunityID uuid.UUID
+}
+
+func (q *Queries) DeleteCustomEmoji(ctx context.Context, arg DeleteCustomEmojiParams) (CustomEmoji, error) {
+	row := q.db.QueryRow(ctx, deleteCustomEmoji, arg.ID, arg.CommunityID)
+	var i CustomEmoji
+	err := row.Scan(
+		&i.ID,
+		&i.CommunityID,
+		&i.Name,
+		&i.ContentType,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteExpiredPendingAttachments = `-- name: DeleteExpiredPendingAttachments :many
+DELETE FROM message_attachments
+WHERE id IN (
+    SELECT id FROM message_attachments
+    WHERE message_id IS NULL AND created_at < $1
+    ORDER BY created_at
+    LIMIT $2
+)
+    RETURNING id, community_id, channel_id, message_id, uploaded_by, filename, content_type, size, width, height, position, created_at
+`
+
+type DeleteExpiredPendingAttachmentsParams struct {
+	ExpiredBefore pgtype.Timestamptz
+	MaxResults    int32
+}
+
+func (q *Queries) DeleteExpiredPendingAttachments(ctx context.Context, arg DeleteExpiredPendingAttachmentsParams) ([]MessageAttachment, error) {
+	rows, err := q.db.Query(ctx, deleteExpiredPendingAttachments, arg.ExpiredBefore, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MessageAttachment
+	for rows.Next() {
+		var i MessageAttachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.CommunityID,
+			&i.ChannelID,
+			&i.MessageID,
+			&i.UploadedBy,
+			&i.Filename,
+			&i.ContentType,
+			&i.Size,
+			&i.Width,
+			&i.Height,
+			&i.Position,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteMessage = `-- name: DeleteMessage :one
+UPDATE messages SET body = '', deleted_at = now(), deleted_by = $2
+WHERE id = $1 AND deleted_at IS NULL
+    RETURNING id, channel_id, user_address, body, created_at, updated_at, deleted_at, deleted_by, reply_to_message_id, thread_id, thread_reply_count, thread_last_activity_at, has_attachments
+`
+
+type DeleteMessageParams struct {
+	ID        uuid.UUID
+	DeletedBy string
+}
+
+func (q *Queries) DeleteMessage(ctx context.Context, arg DeleteMessageParams) (Message, error) {
+	row := q.db.QueryRow(ctx, deleteMessage, arg.ID, arg.DeletedBy)
+	var i Message
+	err := row.Scan(
+		&i.ID,
+		&i.ChannelID,
+		&i.UserAddress,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.ReplyToMessageID,
+		&i.ThreadID,
+		&i.ThreadReplyCount,
+		&i.ThreadLastActivityAt,
+		&i.HasAttachments,
+	)
+	return i, err
+}
+
+const deleteMessageAttachments = `-- name: DeleteMessageAttachments :many
+DELETE FROM message_attachments WHERE message_id = $1
+    RETURNING id, community_id, channel_id, message_id, uploaded_by, filename, content_type, size, width, height, position, created_at
+`
+
+func (q *Queries) DeleteMessageAttachments(ctx context.Context, messageID pgtype.UUID) ([]MessageAttachment, error) {
+	rows, err := q.db.Query(ctx, deleteMessageAttachments, messageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MessageAttachment
+	for rows.Next() {
+		var i MessageAttachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.CommunityID,
+			&i.ChannelID,
+			&i.MessageID,
+			&i.UploadedBy,
+			&i.Filename,
+			&i.ContentType,
+			&i.Size,
+			&i.Width,
+			&i.Height,
+			&i.Position,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteMessageLinkPreviews = `-- name: DeleteMessageLinkPreviews :execrows
+DELETE FROM message_link_previews WHERE message_id = $1
+`
+
+func (q *Queries) DeleteMessageLinkPreviews(ctx context.Context, messageID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteMessageLinkPreviews, messageID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteRateLimitExemption = `-- name: DeleteRateLimitExemption :execrows
+DELETE FROM rate_limit_exemptions WHERE community_id = $1 AND user_address = $2
+`
+
+type DeleteRateLimitExemptionParams struct {
+	CommunityID uuid.UUID
+	UserAddress string
+}
+
+func (q *Queries) DeleteRateLimitExemption(ctx context.Context, arg DeleteRateLimitExemptionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteRateLimitExemption, arg.CommunityID, arg.UserAddress)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteRole = `-- name: DeleteRole :execrows
+DELETE FROM roles WHERE id = $1 AND community_id = $2
+`
+
+type DeleteRoleParams struct {
+	ID          uuid.UUID
+	CommunityID uuid.UUID
+}
+
+func (q *Queries) DeleteRole(ctx context.Context, arg DeleteRoleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteRole, arg.ID, arg.CommunityID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTargetOverwrites = `-- name: DeleteTargetOverwrites :exec
+DELETE FROM channel_overwrites WHERE target_type = $1 AND target = $2
+`
+
+type DeleteTargetOverwritesParams struct {
+	TargetType string
+	Target     string
+}
+
+func (q *Queries) DeleteTargetOverwrites(ctx context.Context, arg DeleteTargetOverwritesParams) error {
+	_, err := q.db.Exec(ctx, deleteTargetOverwrites, arg.TargetType, arg.Target)
+	return err
+}
+
+const getActiveBan = `-- name: GetActiveBan :one
+SELECT id, community_id, target, is_host, reason, banned_by, expires_at, created_at FROM bans
+WHERE community_id = $1
+  AND (expires_at IS NULL OR expires_at > now())
+  AND ((is_host = false AND lower(target) = lower($2)) OR (is_host = true AND target = $3))
+LIMIT 1
+`
+
+type GetActiveBanParams struct {
+	CommunityID uuid.UUID
+	UserAddress string
+	Host        string
+}
+
+func (q *Queries) GetActiveBan(ctx context.Context, arg GetActiveBanParams) (Ban, error) {
+	row := q.db.QueryRow(ctx, getActiveBan, arg.CommunityID, arg.UserAddress, arg.Host)
+	var i Ban
+	err := row.Scan(
+		&i.ID,
+		&i.CommunityID,
+		&i.Target,
+		&i.IsHost,
+		&i.Reason,
+		&i.BannedBy,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAuditLogEntries = `-- name: GetAuditLogEntries :many
+SELECT id, community_id, actor_user_address, action, target_id, reason, before, after, created_at FROM audit_log_entries
+WHERE community_id = $1
+  AND ($2::UUID IS NULL OR id < $2)
+  AND ($3::TEXT IS NULL OR action = $3)
+  AND ($4::TEXT IS NULL OR actor_user_address = $4)
+  AND ($5::TEXT IS NULL OR target_id = $5)
+ORDER BY id DESC
+LIMIT $6
+`
+
+type GetAuditLogEntriesParams struct {
+	CommunityID      uuid.UUID
+	Before           pgtype.UUID
+	Action           pgtype.Text
+	ActorUserAddress pgtype.Text
+	TargetID         pgtype.Text
+	MaxResults       int32
+}
+
+func (q *Queries) GetAuditLogEntries(ctx context.Context, arg GetAuditLogEntriesParams) ([]AuditLogEntry, error) {
+	rows, err := q.db.Query(ctx, getAuditLogEntries,
+		arg.CommunityID,
+		arg.Before,
+		arg.Action,
+		arg.ActorUserAddress,
+		arg.TargetID,
+		arg.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditLogEntry
+	for rows.Next() {
+		var i AuditLogEntry
+		if err := rows.Scan(
+			&i.ID,
+			&i.CommunityID,
+			&i.ActorUserAddress,
+			&i.Action,
+			&i.TargetID,
+			&i.Reason,
+			&i.Before,
+			&i.After,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getChannel = `-- name: GetChannel :one
+SELECT id, community_id, name, created_at, slow_mode_seconds FROM channels WHERE id = $1 AND community_id = $2
+`
+
+type GetChannelParams struct {
+	ID          uuid.UUID
+	CommunityID uuid.UUID
+}
+
+func (q *Queries) GetChannel(ctx context.Context, arg GetChannelParams) (Channel, error) {
+	row := q.db.QueryRow(ctx, getChannel, arg.ID, arg.CommunityID)
+	var i Channel
+	err := row.Scan(
+		&i.ID,
+		&i.CommunityID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.SlowModeSeconds,
+	)
+	return i, err
+}
+
+const getChannelForUpdate = `-- name: GetChannelForUpdate :one
+SELECT id, community_id, name, created_at, slow_mode_seconds FROM channels WHERE id = $1 AND community_id = $2
+    FOR UPDATE
+`
+
+type GetChannelForUpdateParams struct {
+	ID          uuid.UUID
+	CommunityID uuid.UUID
+}
+
+func (q *Queries) GetChannelForUpdate(ctx context.Context, arg GetChannelForUpdateParams) (Channel, error) {
+	row := q.db.QueryRow(ctx, getChannelForUpdate, arg.ID, arg.CommunityID)
+	var i Channel
+	err := row.Scan(
+		&i.ID,
+		&i.CommunityID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.SlowModeSeconds,
+	)
+	return i, err
+}
+
+const getChannelMessagesAfter = `-- name: GetChannelMessagesAfter :many
+SELECT id, channel_id, user_address, body, created_at, updated_at, deleted_at, deleted_by, reply_to_message_id, thread_id, thread_reply_count, thread_last_activity_at, has_attachments FROM messages
+WHERE channel_id = $1 AND id > $2 AND thread_id IS NULL
+ORDER BY id
+LIMIT $3
+`
+
+type GetChannelMessagesAfterParams struct {
+	ChannelID  uuid.UUID
+	After      uuid.UUID
+	MaxResults int32
+}
+
+func (q *Queries) GetChannelMessagesAfter(ctx context.Context, arg GetChannelMessagesAfterParams) ([]Message, error) {
+	rows, err := q.db.Query(ctx, getChannelMessagesAfter, arg.ChannelID, arg.After, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Message
+	for rows.Next() {
+		var i Message
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChannelID,
+			&i.UserAddress,
+			&i.Body,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.DeletedBy,
+			&i.ReplyToMessageID,
+			&i.ThreadID,
+			&i.ThreadReplyCount,
+			&i.ThreadLastActivityAt,
+			&i.HasAttachments,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getChannelMessagesBefore = `-- name: GetChannelMessagesBefore :many
+SELECT id, channel_id, user_address, body, created_at, updated_at, deleted_at, deleted_by, reply_to_message_id, thread_id, thread_reply_count, thread_last_activity_at, has_attachments FROM messages
+WHERE channel_id = $1 AND id < $2 AND thread_id IS NULL
+ORDER BY id DESC
+LIMIT $3
+`
+
+type GetChannelMessagesBeforeParams struct {
+	ChannelID  uuid.UUID
+	Before     uuid.UUID
+	MaxResults int32
+}
+
+func (q *Queries) GetChannelMessagesBefore(ctx context.Context, arg GetChannelMessagesBeforeParams) ([]Message, error) {
+	rows, err := q.db.Query(ctx, getChannelMessagesBefore, arg.ChannelID, arg.Before, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Message
+	for rows.Next() {
+		var i Message
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChannelID,
+			&i.UserAddress,
+			&i.Body,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.DeletedBy,
+			&i.ReplyToMessageID,
+			&i.ThreadID,
+			&i.ThreadReplyCount,
+			&i.ThreadLastActivityAt,
+			&i.HasAttachments,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getChannelOverwrite = `-- name: GetChannelOverwrite :one
+SELECT channel_id, target_type, target, allow, deny, created_at FROM channel_overwrites WHERE channel_id = $1 AND target_type = $2 AND target = $3
+`
+
+type GetChannelOverwriteParams struct {
+	ChannelID  uuid.UUID
+	TargetType string
+	Target     string
+}
+
+func (q *Queries) GetChannelOverwrite(ctx context.Context, arg GetChannelOverwriteParams) (ChannelOverwrite, error) {
+	row := q.db.QueryRow(ctx, getChannelOverwrite, arg.ChannelID, arg.TargetType, arg.Target)
+	var i ChannelOverwrite
+	err := row.Scan(
+		&i.ChannelID,
+		&i.TargetType,
+		&i.Target,
+		&i.Allow,
+		&i.Deny,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getChannelOverwrites = `-- name: GetChannelOverwrites :many
+SELECT channel_id, target_type, target, allow, deny, created_at FROM channel_overwrites WHERE channel_id = $1 ORDER BY created_at, target_type, target
+`
+
+func (q *Queries) GetChannelOverwrites(ctx context.Context, channelID uuid.UUID) ([]ChannelOverwrite, error) {
+	rows, err := q.db.Query(ctx, getChannelOverwrites, channelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChannelOverwrite
+	for rows.Next() {
+		var i ChannelOverwrite
+		if err := rows.Scan(
+			&i.ChannelID,
+			&i.TargetType,
+			&i.Target,
+			&i.Allow,
+			&i.Deny,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getChannelReadStates = `-- name: GetChannelReadStates :many
+SELECT channels.id AS channel_id,
+       channel_read_states.last_read_message_id,
+       COALESCE(channel_read_states.mention_count, 0)::INT AS mention_count,
+       (SELECT count(*) FROM (
+            SELECT 1 FROM messages
+            WHERE messages.channel_id = channels.id
+              AND messages.user_address <> members.user_address
+              AND messages.deleted_at IS NULL
+              AND messages.thread_id IS NULL
+              AND (messages.id > channel_read_states.last_read_message_id
+                OR (channel_read_states.last_read_message_id IS NULL AND messages.created_at > community_members.created_at))
+            LIMIT $3
+        ) unread_messages) AS unread_count
+FROM channels
+    INNER JOIN community_members ON community_members.community_id = channels.community_id AND community_members.member_id = $1
+    INNER JOIN members ON members.id = community_members.member_id
+    LEFT JOIN channel_read_states ON channel_read_states.channel_id = channels.id AND channel_read_states.member_id = community_members.member_id
+WHERE channels.community_id = $2
+ORDER BY channels.created_at, channels.id
+`
+
+type GetChannelReadStatesParams struct {
+	MemberID    uuid.UUID
+	CommunityID uuid.UUID
+	Limit       int32
+}
+
+type GetChannelReadStatesRow struct {
+	ChannelID         uuid.UUID
+	LastReadMessageID pgtype.UUID
+	MentionCount      int32
+	UnreadCount       int64
+}
+
+func (q *Queries) GetChannelReadStates(ctx context.Context, arg GetChannelReadStatesParams) ([]GetChannelReadStatesRow, error) {
+	rows, err := q.db.Query(ctx, getChannelReadStates, arg.MemberID, arg.CommunityID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetChannelReadStatesRow
+	for rows.Next() {
+		var i GetChannelReadStatesRow
+		if err := rows.Scan(
+			&i.ChannelID,
+			&i.LastReadMessageID,
+			&i.MentionCount,
+			&i.UnreadCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCommunity = `-- name: GetCommunity :one
+SELECT id, name, is_default, created_at, owner_member_id, icon_url FROM communities WHERE id = $1
+`
+
+func (q *Queries) GetCommunity(ctx context.Context, id uuid.UUID) (Community, error) {
+	row := q.db.QueryRow(ctx, getCommunity, id)
+	var i Community
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.IsDefault,
+		&i.CreatedAt,
+		&i.OwnerMemberID,
+		&i.IconUrl,
+	)
+	return i, err
+}
+
+const getCommunityBans = `-- name: GetCommunityBans :many
+SELECT id, community_id, target, is_host, reason, banned_by, expires_at, created_at FROM bans
+WHERE community_id = $1 AND (expires_at IS NULL OR expires_at > now())
+ORDER BY created_at, id
+`
+
+func (q *Queries) GetCommunityBans(ctx context.Context, communityID uuid.UUID) ([]Ban, error) {
+	rows, err := q.db.Query(ctx, getCommunityBans, communityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Ban
+	for rows.Next() {
+		var i Ban
+		if err := rows.Scan(
+			&i.ID,
+			&i.CommunityID,
+			&i.Target,
+			&i.IsHost,
+			&i.Reason,
+			&i.BannedBy,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCommunityChannelOverwrites = `-- name: GetCommunityChannelOverwrites :many
+SELECT o.channel_id, o.target_type, o.target, o.allow, o.deny, o.created_at FROM channel_overwrites o INNER JOIN channels c ON c.id = o.channel_id
+WHERE c.community_id = $1
+`
+
+func (q *Queries) GetCommunityChannelOverwrites(ctx context.Context, communityID uuid.UUID) ([]ChannelOverwrite, error) {
+	rows, err := q.db.Query(ctx, getCommunityChannelOverwrites, communityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChannelOverwrite
+	for rows.Next() {
+		var i ChannelOverwrite
+		if err := rows.Scan(
+			&i.ChannelID,
+			&i.TargetType,
+			&i.Target,
+			&i.Allow,
+			&i.Deny,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCommunityChannels = `-- name: GetCommunityChannels :many
+SELECT id, community_id, name, created_at, slow_mode_seconds FROM channels WHERE community_id = $1 ORDER BY created_at, id
+`
+
+func (q *Queries) GetCommunityChannels(ctx context.Context, communityID uuid.UUID) ([]Channel, error) {
+	rows, err := q.db.Query(ctx, getCommunityChannels, communityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Channel
+	for rows.Next() {
+		var i Channel
+		if err := rows.Scan(
+			&i.ID,
+			&i.CommunityID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.SlowModeSeconds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCommunityCustomEmojis = `-- name: GetCommunityCustomEmojis :many
+SELECT id, community_id, name, content_type, created_by, created_at FROM custom_emojis WHERE community_id = $1 ORDER BY name
+`
+
+func (q *Queries) GetCommunityCustomEmojis(ctx context.Context, communityID uuid.UUID) ([]CustomEmoji, error) {
+	rows, err := q.db.Query(ctx, getCommunityCustomEmojis, communityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CustomEmoji
+	for rows.Next() {
+		var i CustomEmoji
+		if err := rows.Scan(
+			&i.ID,
+			&i.CommunityID,
+			&i.Name,
+			&i.ContentType,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCommunityForUpdate = `-- name: GetCommunityForUpdate :one
+SELECT id, name, is_default, created_at, owner_member_id, icon_url FROM communities WHERE id = $1
+    FOR UPDATE
+`
+
+func (q *Queries) GetCommunityForUpdate(ctx context.Context, id uuid.UUID) (Community, error) {
+	row := q.db.QueryRow(ctx, getCommunityForUpdate, id)
+	var i Community
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.IsDefault,
+		&i.CreatedAt,
+		&i.OwnerMemberID,
+		&i.IconUrl,
+	)
+	return i, err
+}
+
+const getCommunityInvites = `-- name: GetCommunityInvites :many
+SELECT code, community_id, channel_id, creator_user_address, max_uses, uses, expires_at, revoked_at, created_at FROM invites WHERE community_id = $1 AND revoked_at IS NULL ORDER BY created_at, code
+`
+
+func (q *Queries) GetCommunityInvites(ctx context.Context, communityID uuid.UUID) ([]Invite, error) {
+	rows, err := q.db.Query(ctx, getCommunityInvites, communityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Invite
+	for rows.Next() {
+		var i Invite
+		if err := rows.Scan(
+			&i.Code,
+			&i.CommunityID,
+			&i.ChannelID,
+			&i.CreatorUserAddress,
+			&i.MaxUses,
+			&i.Uses,
+			&i.ExpiresAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCommunityMember = `-- name: GetCommunityMember :one
+SELECT id, member_id, community_id, created_at, muted_until FROM community_members WHERE member_id = $1 AND community_id = $2
+`
+
+type GetCommunityMemberParams struct {
+	MemberID    uuid.UUID
+	CommunityID uuid.UUID
+}
+
+func (q *Queries) GetCommunityMember(ctx context.Context, arg GetCommunityMemberParams) (CommunityMember, error) {
+	row := q.db.QueryRow(ctx, getCommunityMember, arg.MemberID, arg.CommunityID)
+	var i CommunityMember
+	err := row.Scan(
+		&i.ID,
+		&i.MemberID,
+		&i.CommunityID,
+		&i.CreatedAt,
+		&i.MutedUntil,
+	)
+	return i, err
+}
+
+const getCommunityRoles = `-- name: GetCommunityRoles :many
+SELECT id, community_id, name, permissions, created_at FROM roles WHERE community_id = $1 ORDER BY created_at, id
+`
+
+func (q *Queries) GetCommunityRoles(ctx context.Context, communityID uuid.UUID) ([]Role, error) {
+	rows, err := q.db.Query(ctx, getCommunityRoles, communityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Role
+	for rows.Next() {
+		var i Role
+		if err := rows.Scan(
+			&i.ID,
+			&i.CommunityID,
+			&i.Name,
+			&i.Permissions,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getConversation = `-- name: GetConversation :one
+SELECT conversations.id, conversations.direct_key, conversations.created_by, conversations.created_at, conversations.last_message_at FROM conversations
+    INNER JOIN conversation_participants ON conversation_participants.conversation_id = conversations.id
+WHERE conversations.id = $1 AND conversation_participants.user_address = $2
+`
+
+type GetConversationParams struct {
+	ID          uuid.UUID
+	UserAddress string
+}
+
+func (q *Queries) GetConversation(ctx context.Context, arg GetConversationParams) (Conversation, error) {
+	row := q.db.QueryRow(ctx, getConversation, arg.ID, arg.UserAddress)
+	var i Conversation
+	err := row.Scan(
+		&i.ID,
+		&i.DirectKey,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.LastMessageAt,
+	)
+	return i, err
+}
+
+const getConversationByDirectKey = `-- name: GetConversationByDirectKey :one
+SELECT id, direct_key, created_by, created_at, last_message_at FROM conversations WHERE direct_key = $1
+`
+
+func (q *Queries) GetConversationByDirectKey(ctx context.Context, directKey pgtype.Text) (Conversation, error) {
+	row := q.db.QueryRow(ctx, getConversationByDirectKey, directKey)
+	var i Conversation
+	err := row.Scan(
+		&i.ID,
+		&i.DirectKey,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.LastMessageAt,
+	)
+	return i, err
+}
+
+const getConversationMessages = `-- name: GetConversationMessages :many
+SELECT id, conversation_id, user_address, body, created_at FROM conversation_messages
+WHERE conversation_id = $1 AND ($2::uuid IS NULL OR id < $2::uuid)
+ORDER BY id DESC
+LIMIT $3
+`
+
+type GetConversationMessagesParams struct {
+	ConversationID uuid.UUID
+	Before         pgtype.UUID
+	MaxResults     int32
+}
+
+func (q *Queries) GetConversationMessages(ctx context.Context, arg GetConversationMessagesParams) ([]ConversationMessage, error) {
+	rows, err := q.db.Query(ctx, getConversationMessages, arg.ConversationID, arg.Before, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ConversationMessage
+	for rows.Next() {
+		var i ConversationMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.ConversationID,
+			&i.UserAddress,
+			&i.Body,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getConversationsParticipants = `-- name: GetConversationsParticipants :many
+SELECT conversation_id, user_address, joined_at FROM conversation_participants
+WHERE conversation_id = ANY($1::uuid[])
+ORDER BY conversation_id, joined_at, user_address
+`
+
+func (q *Queries) GetConversationsParticipants(ctx context.Context, conversationIds []uuid.UUID) ([]ConversationParticipant, error) {
+	rows, err := q.db.Query(ctx, getConversationsParticipants, conversationIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ConversationParticipant
+	for rows.Next() {
+		var i ConversationParticipant
+		if err := rows.Scan(&i.ConversationID, &i.UserAddress, &i.JoinedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCreatorInvites = `-- name: GetCreatorInvites :many
+SELECT code, community_id, channel_id, creator_user_address, max_uses, uses, expires_at, revoked_at, created_at FROM invites WHERE community_id = $1 AND creator_user_address = $2 AND revoked_at IS NULL ORDER BY created_at, code
+`
+
+type GetCreatorInvitesParams struct {
+	CommunityID        uuid.UUID
+	CreatorUserAddress string
+}
+
+func (q *Queries) GetCreatorInvites(ctx context.Context, arg GetCreatorInvitesParams) ([]Invite, error) {
+	rows, err := q.db.Query(ctx, getCreatorInvites, arg.CommunityID, arg.CreatorUserAddress)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Invite
+	for rows.Next() {
+		var i Invite
+		if err := rows.Scan(
+			&i.Code,
+			&i.CommunityID,
+			&i.ChannelID,
+			&i.CreatorUserAddress,
+			&i.MaxUses,
+			&i.Uses,
+			&i.ExpiresAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCustomEmoji = `-- name: GetCustomEmoji :one
+SELECT id, community_id, name, content_type, created_by, created_at FROM custom_emojis WHERE id = $1
+`
+
+func (q *Queries) GetCustomEmoji(ctx context.Context, id uuid.UUID) (CustomEmoji, error) {
+	row := q.db.QueryRow(ctx, getCustomEmoji, id)
+	var i CustomEmoji
+	err := row.Scan(
+		&i.ID,
+		&i.CommunityID,
+		&i.Name,
+		&i.ContentType,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const getDefaultCommunity = `-- name: GetDefaultCommunity :one
-SELECT id, name, is_default, created_at FROM communities WHERE is_default = true
+SELECT id, name, is_default, created_at, owner_member_id, icon_url FROM communities WHERE is_default = true
+`
+
+func (q *Queries) GetDefaultCommunity(ctx context.Context) (Community, error) {
+	row := q.db.QueryRow(ctx, getDefaultCommunity)
+	var i Community
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.IsDefault,
+		&i.CreatedAt,
+		&i.OwnerMemberID,
+		&i.IconUrl,
+	)
+	return i, err
+}
+
+const getHostCommunityMembers = `-- name: GetHostCommunityMembers :many
+SELECT m.id, m.user_address, m.created_at FROM members m
+    INNER JOIN community_members ON community_members.member_id = m.id
+WHERE community_members.community_id = $1
+  AND lower(substring(m.user_address FROM position('@' IN m.user_address) + 1)) = $2
+ORDER BY m.id
+`
+
+type GetHostCommunityMembersParams struct {
+	CommunityID uuid.UUID
+	Host        string
+}
+
+func (q *Queries) GetHostCommunityMembers(ctx context.Context, arg GetHostCommunityMembersParams) ([]Member, error) {
+	rows, err := q.db.Query(ctx, getHostCommunityMembers, arg.CommunityID, arg.Host)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Member
+	for rows.Next() {
+		var i Member
+		if err := rows.Scan(&i.ID, &i.UserAddress, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getInvite = `-- name: GetInvite :one
+SELECT code, community_id, channel_id, creator_user_address, max_uses, uses, expires_at, revoked_at, created_at FROM invites WHERE code = $1
+`
+
+func (q *Queries) GetInvite(ctx context.Context, code string) (Invite, error) {
+	row := q.db.QueryRow(ctx, getInvite, code)
+	var i Invite
+	err := row.Scan(
+		&i.Code,
+		&i.CommunityID,
+		&i.ChannelID,
+		&i.CreatorUserAddress,
+		&i.MaxUses,
+		&i.Uses,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLatestChannelMessages = `-- name: GetLatestChannelMessages :many
+SELECT id, channel_id, user_address, body, created_at, updated_at, deleted_at, deleted_by, reply_to_message_id, thread_id, thread_reply_count, thread_last_activity_at, has_attachments FROM messages
+WHERE channel_id = $1 AND thread_id IS NULL
+ORDER BY id DESC
+LIMIT $2
+`
+
+type GetLatestChannelMessagesParams struct {
+	ChannelID  uuid.UUID
+	MaxResults int32
+}
+
+func (q *Queries) GetLatestChannelMessages(ctx context.Context, arg GetLatestChannelMessagesParams) ([]Message, error) {
+	rows, err := q.db.Query(ctx, getLatestChannelMessages, arg.ChannelID, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Message
+	for rows.Next() {
+		var i Message
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChannelID,
+			&i.UserAddress,
+			&i.Body,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.DeletedBy,
+			&i.ReplyToMessageID,
+			&i.ThreadID,
+			&i.ThreadReplyCount,
+			&i.ThreadLastActivityAt,
+			&i.HasAttachments,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLatestThreadMessages = `-- name: GetLatestThreadMessages :many
+SELECT id, channel_id, user_address, body, created_at, updated_at, deleted_at, deleted_by, reply_to_message_id, thread_id, thread_reply_count, thread_last_activity_at, has_attachments FROM messages
+WHERE thread_id = $1
+ORDER BY id DESC
+LIMIT $2
+`
+
+type GetLatestThreadMessagesParams struct {
+	ThreadID   pgtype.UUID
+	MaxResults int32
+}
+
+func (q *Queries) GetLatestThreadMessages(ctx context.Context, arg GetLatestThreadMessagesParams) ([]Message, error) {
+	rows, err := q.db.Query(ctx, getLatestThreadMessages, arg.ThreadID, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Message
+	for rows.Next() {
+		var i Message
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChannelID,
+			&i.UserAddress,
+			&i.Body,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.DeletedBy,
+			&i.ReplyToMessageID,
+			&i.ThreadID,
+			&i.ThreadReplyCount,
+			&i.ThreadLastActivityAt,
+			&i.HasAttachments,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMember = `-- name: GetMember :one
+SELECT id, user_address, created_at FROM members WHERE id = $1
+`
+
+func (q *Queries) GetMember(ctx context.Context, id uuid.UUID) (Member, error) {
+	row := q.db.QueryRow(ctx, getMember, id)
+	var i Member
+	err := row.Scan(&i.ID, &i.UserAddress, &i.CreatedAt)
+	return i, err
+}
+
+const getMemberByUserAddress = `-- name: GetMemberByUserAddress :one
+SELECT id, user_address, created_at FROM members WHERE user_address = $1
+`
+
+func (q *Queries) GetMemberByUserAddress(ctx context.Context, userAddress string) (Member, error) {
+	row := q.db.QueryRow(ctx, getMemberByUserAddress, userAddress)
+	var i Member
+	err := row.Scan(&i.ID, &i.UserAddress, &i.CreatedAt)
+	return i, err
+}
+
+const getMemberChannelOverwrites = `-- name: GetMemberChannelOverwrites :many
+SELECT o.channel_id, o.target_type, o.target, o.allow, o.deny, o.created_at FROM channel_overwrites o
+    INNER JOIN channels c ON c.id = o.channel_id
+    INNER JOIN community_members ON community_members.community_id = c.community_id
+WHERE community_members.member_id = $1
+`
+
+func (q *Queries) GetMemberChannelOverwrites(ctx context.Context, memberID uuid.UUID) ([]ChannelOverwrite, error) {
+	rows, err := q.db.Query(ctx, getMemberChannelOverwrites, memberID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChannelOverwrite
+	for rows.Next() {
+		var i ChannelOverwrite
+		if err := rows.Scan(
+			&i.ChannelID,
+			&i.TargetType,
+			&i.Target,
+			&i.Allow,
+			&i.Deny,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMemberChannelReadStates = `-- name: GetMemberChannelReadStates :many
+SELECT channels.community_id,
+       channels.id AS channel_id,
+       COALESCE(channel_read_states.mention_count, 0)::INT AS mention_count,
+       (SELECT count(*) FROM (
+            SELECT 1 FROM messages
+            WHERE messages.channel_id = channels.id
+              AND messages.user_address <> members.user_address
+              AND messages.deleted_at IS NULL
+              AND messages.thread_id IS NULL
+              AND (messages.id > channel_read_states.last_read_message_id
+                OR (channel_read_states.last_read_message_id IS NULL AND messages.created_at > community_members.created_at))
+            LIMIT $2
+        ) unread_messages) AS unread_count
+FROM channels
+    INNER JOIN community_members ON community_members.community_id = channels.community_id AND community_members.member_id = $1
+    INNER JOIN members ON members.id = community_members.member_id
+    LEFT JOIN channel_read_states ON channel_read_states.channel_id = channels.id AND channel_read_states.member_id = community_members.member_id
+`
+
+type GetMemberChannelReadStatesParams struct {
+	MemberID uuid.UUID
+	Limit    int32
+}
+
+type GetMemberChannelReadStatesRow struct {
+	CommunityID  uuid.UUID
+	ChannelID    uuid.UUID
+	MentionCount int32
+	UnreadCount  int64
+}
+
+func (q *Queries) GetMemberChannelReadStates(ctx context.Context, arg GetMemberChannelReadStatesParams) ([]GetMemberChannelReadStatesRow, error) {
+	rows, err := q.db.Query(ctx, getMemberChannelReadStates, arg.MemberID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMemberChannelReadStatesRow
+	for rows.Next() {
+		var i GetMemberChannelReadStatesRow
+		if err := rows.Scan(
+			&i.CommunityID,
+			&i.ChannelID,
+			&i.MentionCount,
+			&i.UnreadCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMemberCommunities = `-- name: GetMemberCommunities :many
+SELECT c.id, c.name, c.is_default, c.created_at, c.owner_member_id, c.icon_url FROM community_members INNER JOIN communities c ON c.id = community_members.community_id WHERE community_members.member_id = $1
+`
+
+func (q *Queries) GetMemberCommunities(ctx context.Context, memberID uuid.UUID) ([]Community, error) {
+	rows, err := q.db.Query(ctx, getMemberCommunities, memberID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Community
+	for rows.Next() {
+		var i Community
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.IsDefault,
+			&i.CreatedAt,
+			&i.OwnerMemberID,
+			&i.IconUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMemberCommunityRoles = `-- name: GetMemberCommunityRoles :many
+SELECT r.community_id, r.id, r.permissions FROM member_roles INNER JOIN roles r ON r.id = member_roles.role_id
+WHERE member_roles.member_id = $1
+`
+
+type GetMemberCommunityRolesRow struct {
+	CommunityID uuid.UUID
+	ID          uuid.UUID
+	Permissions int64
+}
+
+func (q *Queries) GetMemberCommunityRoles(ctx context.Context, memberID uuid.UUID) ([]GetMemberCommunityRolesRow, error) {
+	rows, err := q.db.Query(ctx, getMemberCommunityRoles, memberID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMemberCommunityRolesRow
+	for rows.Next() {
+		var i GetMemberCommunityRolesRow
+		if err := rows.Scan(&i.CommunityID, &i.ID, &i.Permissions); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMemberPermissions = `-- name: GetMemberPermissions :one
+SELECT COALESCE(bit_or(r.permissions), 0)::BIGINT AS permissions
+FROM member_roles INNER JOIN roles r ON r.id = member_roles.role_id
+WHERE member_roles.member_id = $1 AND r.community_id = $2
+`
+
+type GetMemberPermissionsParams struct {
+	MemberID    uuid.UUID
+	CommunityID uuid.UUID
+}
+
+func (q *Queries) GetMemberPermissions(ctx context.Context, arg GetMemberPermissionsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getMemberPermissions, arg.MemberID, arg.CommunityID)
+	var permissions int64
+	err := row.Scan(&permissions)
+	return permissions, err
+}
+
+const getMemberRoleIds = `-- name: GetMemberRoleIds :many
+SELECT member_roles.role_id FROM member_roles INNER JOIN roles r ON r.id = member_roles.role_id
+WHERE member_roles.member_id = $1 AND r.community_id = $2
+`
+
+type GetMemberRoleIdsParams struct {
+	MemberID    uuid.UUID
+	CommunityID uuid.UUID
+}
+
+func (q *Queries) GetMemberRoleIds(ctx context.Context, arg GetMemberRoleIdsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getMemberRoleIds, arg.MemberID, arg.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var role_id uuid.UUID
+		if err := rows.Scan(&role_id); err != nil {
+			return nil, err
+		}
+		items = append(items, role_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMembersByUserAddressInsensitive = `-- name: GetMembersByUserAddressInsensitive :many
+SELECT id, user_address, created_at FROM members WHERE lower(user_address) = lower($1)
+`
+
+func (q *Queries) GetMembersByUserAddressInsensitive(ctx context.Context, userAddress string) ([]Member, error) {
+	rows, err := q.db.Query(ctx, getMembersByUserAddressInsensitive, userAddress)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Member
+	for rows.Next() {
+		var i Member
+		if err := rows.Scan(&i.ID, &i.UserAddress, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMentionedMembers = `-- name: GetMentionedMembers :many
+SELECT members.id AS member_id, members.user_address,
+       COALESCE(array_agg(member_roles.role_id) FILTER (WHERE member_roles.role_id IS NOT NULL), '{}')::uuid[] AS role_ids
+FROM community_members
+    INNER JOIN members ON members.id = community_members.member_id
+    LEFT JOIN member_roles ON member_roles.member_id = members.id
+        AND member_roles.role_id IN (SELECT roles.id FROM roles WHERE roles.community_id = $1)
+WHERE community_members.community_id = $1
+    AND ($2::bool
+        OR members.user_address = ANY($3::text[])
+        OR members.id IN (SELECT mentioned_roles.member_id FROM member_roles mentioned_roles WHERE mentioned_roles.role_id = ANY($4::uuid[])))
+GROUP BY members.id, members.user_address
+`
+
+type GetMentionedMembersParams struct {
+	CommunityID   uuid.UUID
+	Everyone      bool
+	UserAddresses []string
+	RoleIds       []uuid.UUID
+}
+
+type GetMentionedMembersRow struct {
+	MemberID    uuid.UUID
+	UserAddress string
+	RoleIds     []uuid.UUID
+}
+
+func (q *Queries) GetMentionedMembers(ctx context.Context, arg GetMentionedMembersParams) ([]GetMentionedMembersRow, error) {
+	rows, err := q.db.Query(ctx, getMentionedMembers,
+		arg.CommunityID,
+		arg.Everyone,
+		arg.UserAddresses,
+		arg.RoleIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMentionedMembersRow
+	for rows.Next() {
+		var i GetMentionedMembersRow
+		if err := rows.Scan(&i.MemberID, &i.UserAddress, &i.RoleIds); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMessage = `-- name: GetMessage :one
+SELECT id, channel_id, user_address, body, created_at, updated_at, deleted_at, deleted_by, reply_to_message_id, thread_id, thread_reply_count, thread_last_activity_at, has_attachments FROM messages WHERE id = $1 AND channel_id = $2
+`
+
+type GetMessageParams struct {
+	ID        uuid.UUID
+	ChannelID uuid.UUID
+}
+
+func (q *Queries) GetMessage(ctx context.Context, arg GetMessageParams) (Message, error) {
+	row := q.db.QueryRow(ctx, getMessage, arg.ID, arg.ChannelID)
+	var i Message
+	err := row.Scan(
+		&i.ID,
+		&i.ChannelID,
+		&i.UserAddress,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.ReplyToMessageID,
+		&i.ThreadID,
+		&i.ThreadReplyCount,
+		&i.ThreadLastActivityAt,
+		&i.HasAttachments,
+	)
+	return i, err
+}
+
+const getMessageAttachment = `-- name: GetMessageAttachment :one
+SELECT id, community_id, channel_id, message_id, uploaded_by, filename, content_type, size, width, height, position, created_at FROM message_attachments WHERE id = $1 AND channel_id = $2
+`
+
+type GetMessageAttachmentParams struct {
+	ID        uuid.UUID
+	ChannelID uuid.UUID
+}
+
+func (q *Queries) GetMessageAttachment(ctx context.Context, arg GetMessageAttachmentParams) (MessageAttachment, error) {
+	row := q.db.QueryRow(ctx, getMessageAttachment, arg.ID, arg.ChannelID)
+	var i MessageAttachment
+	err := row.Scan(
+		&i.ID,
+		&i.CommunityID,
+		&i.ChannelID,
+		&i.MessageID,
+		&i.UploadedBy,
+		&i.Filename,
+		&i.ContentType,
+		&i.Size,
+		&i.Width,
+		&i.Height,
+		&i.Position,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getMessageForUpdate = `-- name: GetMessageForUpdate :one
+SELECT id, channel_id, user_address, body, created_at, updated_at, deleted_at, deleted_by, reply_to_message_id, thread_id, thread_reply_count, thread_last_activity_at, has_attachments FROM messages WHERE id = $1 AND channel_id = $2
+    FOR UPDATE
+`
+
+type GetMessageForUpdateParams struct {
+	ID        uuid.UUID
+	ChannelID uuid.UUID
+}
+
+func (q *Queries) GetMessageForUpdate(ctx context.Context, arg GetMessageForUpdateParams) (Message, error) {
+	row := q.db.QueryRow(ctx, getMessageForUpdate, arg.ID, arg.ChannelID)
+	var i Message
+	err := row.Scan(
+		&i.ID,
+		&i.ChannelID,
+		&i.UserAddress,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.ReplyToMessageID,
+		&i.ThreadID,
+		&i.ThreadReplyCount,
+		&i.ThreadLastActivityAt,
+		&i.HasAttachments,
+	)
+	return i, err
+}
+
+const getMessageRevisions = `-- name: GetMessageRevisions :many
+SELECT id, message_id, body, created_at FROM message_revisions WHERE message_id = $1 ORDER BY id
+`
+
+func (q *Queries) GetMessageRevisions(ctx context.Context, messageID uuid.UUID) ([]MessageRevision, error) {
+	rows, err := q.db.Query(ctx, getMessageRevisions, messageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MessageRevision
+	for rows.Next() {
+		var i MessageRevision
+		if err := rows.Scan(
+			&i.ID,
+			&i.MessageID,
+			&i.Body,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMessagesAttachments = `-- name: GetMessagesAttachments :many
+SELECT id, community_id, channel_id, message_id, uploaded_by, filename, content_type, size, width, height, position, created_at FROM message_attachments
+WHERE message_id = ANY($1::uuid[])
+ORDER BY message_id, position
+`
+
+func (q *Queries) GetMessagesAttachments(ctx context.Context, messageIds []uuid.UUID) ([]MessageAttachment, error) {
+	rows, err := q.db.Query(ctx, getMessagesAttachments, messageIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MessageAttachment
+	for rows.Next() {
+		var i MessageAttachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.CommunityID,
+			&i.ChannelID,
+			&i.MessageID,
+			&i.UploadedBy,
+			&i.Filename,
+			&i.ContentType,
+			&i.Size,
+			&i.Width,
+			&i.Height,
+			&i.Position,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMessagesByIds = `-- name: GetMessagesByIds :many
+SELECT id, channel_id, user_address, body, created_at, updated_at, deleted_at, deleted_by, reply_to_message_id, thread_id, thread_reply_count, thread_last_activity_at, has_attachments FROM messages WHERE channel_id = $1 AND id = ANY($2::uuid[])
+`
+
+type GetMessagesByIdsParams struct {
+	ChannelID uuid.UUID
+	Ids       []uuid.UUID
+}
+
+func (q *Queries) GetMessagesByIds(ctx context.Context, arg GetMessagesByIdsParams) ([]Message, error) {
+	rows, err := q.db.Query(ctx, getMessagesByIds, arg.ChannelID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Message
+	for rows.Next() {
+		var i Message
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChannelID,
+			&i.UserAddress,
+			&i.Body,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.DeletedBy,
+			&i.ReplyToMessageID,
+			&i.ThreadID,
+			&i.ThreadReplyCount,
+			&i.ThreadLastActivityAt,
+			&i.HasAttachments,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMessagesLinkPreviews = `-- name: GetMessagesLinkPreviews :many
+SELECT message_id, position, url, title, description, site_name, image_url FROM message_link_previews
+WHERE message_id = ANY($1::uuid[])
+ORDER BY message_id, position
+`
+
+func (q *Queries) GetMessagesLinkPreviews(ctx context.Context, messageIds []uuid.UUID) ([]MessageLinkPreview, error) {
+	rows, err := q.db.Query(ctx, getMessagesLinkPreviews, messageIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MessageLinkPreview
+	for rows.Next() {
+		var i MessageLinkPreview
+		if err := rows.Scan(
+			&i.MessageID,
+			&i.Position,
+			&i.Url,
+			&i.Title,
+			&i.Description,
+			&i.SiteName,
+			&i.ImageUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMessagesReactions = `-- name: GetMessagesReactions :many
+SELECT message_id,
+       emoji,
+       custom_emoji_id,
+       count(*) AS count,
+       bool_or(user_address = $1) AS me
+FROM message_reactions
+WHERE message_id = ANY($2::uuid[])
+GROUP BY message_id, emoji, custom_emoji_id
+ORDER BY message_id, min(created_at)
+`
+
+type GetMessagesReactionsParams struct {
+	UserAddress string
+	MessageIds  []uuid.UUID
+}
+
+type GetMessagesReactionsRow struct {
+	MessageID     uuid.UUID
+	Emoji         string
+	CustomEmojiID pgtype.UUID
+	Count         int64
+	Me            bool
+}
+
+func (q *Queries) GetMessagesReactions(ctx context.Context, arg GetMessagesReactionsParams) ([]GetMessagesReactionsRow, error) {
+	rows, err := q.db.Query(ctx, getMessagesReactions, arg.UserAddress, arg.MessageIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMessagesReactionsRow
+	for rows.Next() {
+		var i GetMessagesReactionsRow
+		if err := rows.Scan(
+			&i.MessageID,
+			&i.Emoji,
+			&i.CustomEmojiID,
+			&i.Count,
+			&i.Me,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPinnedMessages = `-- name: GetPinnedMessages :many
+SELECT message_id, channel_id, pinned_by, pinned_at FROM pinned_messages
+WHERE channel_id = $1
+ORDER BY pinned_at DESC
+`
+
+func (q *Queries) GetPinnedMessages(ctx context.Context, channelID uuid.UUID) ([]PinnedMessage, error) {
+	rows, err := q.db.Query(ctx, getPinnedMessages, channelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PinnedMessage
+	for rows.Next() {
+		var i PinnedMessage
+		if err := rows.Scan(
+			&i.MessageID,
+			&i.ChannelID,
+			&i.PinnedBy,
+			&i.PinnedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRateLimitExemptions = `-- name: GetRateLimitExemptions :many
+SELECT community_id, user_address, created_by, created_at FROM rate_limit_exemptions
+WHERE community_id = $1
+ORDER BY created_at, user_address
+`
+
+func (q *Queries) GetRateLimitExemptions(ctx context.Context, communityID uuid.UUID) ([]RateLimitExemption, error) {
+	rows, err := q.db.Query(ctx, getRateLimitExemptions, communityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RateLimitExemption
+	for rows.Next() {
+		var i RateLimitExemption
+		if err := rows.Scan(
+			&i.CommunityID,
+			&i.UserAddress,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRole = `-- name: GetRole :one
+SELECT id, community_id, name, permissions, created_at FROM roles WHERE id = $1 AND community_id = $2
+`
+
+type GetRoleParams struct {
+	ID          uuid.UUID
+	CommunityID uuid.UUID
+}
+
+func (q *Queries) GetRole(ctx context.Context, arg GetRoleParams) (Role, error) {
+	row := q.db.QueryRow(ctx, getRole, arg.ID, arg.CommunityID)
+	var i Role
+	err := row.Scan(
+		&i.ID,
+		&i.CommunityID,
+		&i.Name,
+		&i.Permissions,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getThreadMessagesAfter = `-- name: GetThreadMessagesAfter :many
+SELECT id, channel_id, user_address, body, created_at, updated_at, deleted_at, deleted_by, reply_to_message_id, thread_id, thread_reply_count, thread_last_activity_at, has_attachments FROM messages
+WHERE thread_id = $1 AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type GetThreadMessagesAfterParams struct {
+	ThreadID   pgtype.UUID
+	After      uuid.UUID
+	MaxResults int32
+}
+
+func (q *Queries) GetThreadMessagesAfter(ctx context.Context, arg GetThreadMessagesAfterParams) ([]Message, error) {
+	rows, err := q.db.Query(ctx, getThreadMessagesAfter, arg.ThreadID, arg.After, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Message
+	for rows.Next() {
+		var i Message
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChannelID,
+			&i.UserAddress,
+			&i.Body,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.DeletedBy,
+			&i.ReplyToMessageID,
+			&i.ThreadID,
+			&i.ThreadReplyCount,
+			&i.ThreadLastActivityAt,
+			&i.HasAttachments,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getThreadMessagesBefore = `-- name: GetThreadMessagesBefore :many
+SELECT id, channel_id, user_address, body, created_at, updated_at, deleted_at, deleted_by, reply_to_message_id, thread_id, thread_reply_count, thread_last_activity_at, has_attachments FROM messages
+WHERE thread_id = $1 AND id < $2
+ORDER BY id DESC
+LIMIT $3
+`
+
+type GetThreadMessagesBeforeParams struct {
+	ThreadID   pgtype.UUID
+	Before     uuid.UUID
+	MaxResults int32
+}
+
+func (q *Queries) GetThreadMessagesBefore(ctx context.Context, arg GetThreadMessagesBeforeParams) ([]Message, error) {
+	rows, err := q.db.Query(ctx, getThreadMessagesBefore, arg.ThreadID, arg.Before, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Message
+	for rows.Next() {
+		var i Message
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChannelID,
+			&i.UserAddress,
+			&i.Body,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.DeletedBy,
+			&i.ReplyToMessageID,
+			&i.ThreadID,
+			&i.ThreadReplyCount,
+			&i.ThreadLastActivityAt,
+			&i.HasAttachments,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserConversations = `-- name: GetUserConversations :many
+SELECT conversations.id, conversations.direct_key, conversations.created_by, conversations.created_at, conversations.last_message_at FROM conversations
+    INNER JOIN conversation_participants ON conversation_participants.conversation_id = conversations.id
+WHERE conversation_participants.user_address = $1
+ORDER BY COALESCE(conversations.last_message_at, conversations.created_at) DESC, conversations.id DESC
+LIMIT $2
+`
+
+type GetUserConversationsParams struct {
+	UserAddress string
+	MaxResults  int32
+}
+
+func (q *Queries) GetUserConversations(ctx context.Context, arg GetUserConversationsParams) ([]Conversation, error) {
+	rows, err := q.db.Query(ctx, getUserConversations, arg.UserAddress, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Conversation
+	for rows.Next() {
+		var i Conversation
+		if err := rows.Scan(
+			&i.ID,
+			&i.DirectKey,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.LastMessageAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const incrementMentionCounts = `-- name: IncrementMentionCounts :exec
+INSERT INTO channel_read_states (member_id, channel_id, mention_count)
+SELECT unnest($1::uuid[]), $2::uuid, 1
+    ON CONFLICT (member_id, channel_id) DO UPDATE
+    SET mention_count = channel_read_states.mention_count + 1,
+        updated_at = now()
+`
+
+type IncrementMentionCountsParams struct {
+	MemberIds []uuid.UUID
+	ChannelID uuid.UUID
+}
+
+func (q *Queries) IncrementMentionCounts(ctx context.Context, arg IncrementMentionCountsParams) error {
+	_, err := q.db.Exec(ctx, incrementMentionCounts, arg.MemberIds, arg.ChannelID)
+	return err
+}
+
+const insertAuditLogEntry = `-- name: InsertAuditLogEntry :exec
+INSERT INTO audit_log_entries (id, community_id, actor_user_address, action, target_id, reason, before, after)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type InsertAuditLogEntryParams struct {
+	ID               uuid.UUID
+	CommunityID      uuid.UUID
+	ActorUserAddress string
+	Action           string
+	TargetID         string
+	Reason           string
+	Before           []byte
+	After            []byte
+}
+
+func (q *Queries) InsertAuditLogEntry(ctx context.Context, arg InsertAuditLogEntryParams) error {
+	_, err := q.db.Exec(ctx, insertAuditLogEntry,
+		arg.ID,
+		arg.CommunityID,
+		arg.ActorUserAddress,
+		arg.Action,
+		arg.TargetID,
+		arg.Reason,
+		arg.Before,
+		arg.After,
+	)
+	return err
+}
+
+const insertChannel = `-- name: InsertChannel :one
+INSERT INTO channels (id, community_id, name)
+VALUES ($1, $2, $3)
+    RETURNING id, community_id, name, created_at, slow_mode_seconds
+`
+
+type InsertChannelParams struct {
+	ID          uuid.UUID
+	CommunityID uuid.UUID
+	Name        string
+}
+
+func (q *Queries) InsertChannel(ctx context.Context, arg InsertChannelParams) (Channel, error) {
+	row := q.db.QueryRow(ctx, insertChannel, arg.ID, arg.CommunityID, arg.Name)
+	var i Channel
+	err := row.Scan(
+		&i.ID,
+		&i.CommunityID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.SlowModeSeconds,
+	)
+	return i, err
+}
+
+const insertCommunity = `-- name: InsertCommunity :one
+INSERT INTO communities (id, name, icon_url)
+VALUES ($1, $2, $3)
+    RETURNING id, name, is_default, created_at, owner_member_id, icon_url
+`
+
+type InsertCommunityParams struct {
+	ID      uuid.UUID
+	Name    string
+	IconUrl string
+}
+
+func (q *Queries) InsertCommunity(ctx context.Context, arg InsertCommunityParams) (Community, error) {
+	row := q.db.QueryRow(ctx, insertCommunity, arg.ID, arg.Name, arg.IconUrl)
+	var i Community
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.IsDefault,
+		&i.CreatedAt,
+		&i.OwnerMemberID,
+		&i.IconUrl,
+	)
+	return i, err
+}
+
+const insertConversation = `-- name: InsertConversation :one
+INSERT INTO conversations (id, direct_key, created_by)
+VALUES ($1, $2, $3)
+    ON CONFLICT (direct_key) DO NOTHING
+    RETURNING id, direct_key, created_by, created_at, last_message_at
+`
+
+type InsertConversationParams struct {
+	ID        uuid.UUID
+	DirectKey pgtype.Text
+	CreatedBy string
+}
+
+func (q *Queries) InsertConversation(ctx context.Context, arg InsertConversationParams) (Conversation, error) {
+	row := q.db.QueryRow(ctx, insertConversation, arg.ID, arg.DirectKey, arg.CreatedBy)
+	var i Conversation
+	err := row.Scan(
+		&i.ID,
+		&i.DirectKey,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.LastMessageAt,
+	)
+	return i, err
+}
+
+const insertConversationMessage = `-- name: InsertConversationMessage :one
+INSERT INTO conversation_messages (id, conversation_id, user_address, body)
+VALUES ($1, $2, $3, $4)
+    RETURNING id, conversation_id, user_address, body, created_at
+`
+
+type InsertConversationMessageParams struct {
+	ID             uuid.UUID
+	ConversationID uuid.UUID
+	UserAddress    string
+	Body           string
+}
+
+func (q *Queries) InsertConversationMessage(ctx context.Context, arg InsertConversationMessageParams) (ConversationMessage, error) {
+	row := q.db.QueryRow(ctx, insertConversationMessage,
+		arg.ID,
+		arg.ConversationID,
+		arg.UserAddress,
+		arg.Body,
+	)
+	var i ConversationMessage
+	err := row.Scan(
+		&i.ID,
+		&i.ConversationID,
+		&i.UserAddress,
+		&i.Body,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const insertConversationParticipants = `-- name: InsertConversationParticipants :exec
+INSERT INTO conversation_participants (conversation_id, user_address)
+SELECT $1::uuid, unnest($2::text[])
+`
+
+type InsertConversationParticipantsParams struct {
+	ConversationID uuid.UUID
+	UserAddresses  []string
+}
+
+func (q *Queries) InsertConversationParticipants(ctx context.Context, arg InsertConversationParticipantsParams) error {
+	_, err := q.db.Exec(ctx, insertConversationParticipants, arg.ConversationID, arg.UserAddresses)
+	return err
+}
+
+const insertCustomEmoji = `-- name: InsertCustomEmoji :one
+INSERT INTO custom_emojis (id, community_id, name, content_type, created_by)
+VALUES ($1, $2, $3, $4, $5)
+    RETURNING id, community_id, name, content_type, created_by, created_at
+`
+
+type InsertCustomEmojiParams struct {
+	ID          uuid.UUID
+	CommunityID uuid.UUID
+	Name        string
+	ContentType string
+	CreatedBy   string
+}
+
+func (q *Queries) InsertCustomEmoji(ctx context.Context, arg InsertCustomEmojiParams) (CustomEmoji, error) {
+	row := q.db.QueryRow(ctx, insertCustomEmoji,
+		arg.ID,
+		arg.CommunityID,
+		arg.Name,
+		arg.ContentType,
+		arg.CreatedBy,
+	)
+	var i CustomEmoji
+	err := row.Scan(
+		&i.ID,
+		&i.CommunityID,
+		&i.Name,
+		&i.ContentType,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const insertInvite = `-- name: InsertInvite :one
+INSERT INTO invites (code, community_id, channel_id, creator_user_address, max_uses, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+    RETURNING code, community_id, channel_id, creator_user_address, max_uses, uses, expires_at, revoked_at, created_at
+`
+
+type InsertInviteParams struct {
+	Code               string
+	CommunityID        uuid.UUID
+	ChannelID          pgtype.UUID
+	CreatorUserAddress string
+	MaxUses            int32
+	ExpiresAt          pgtype.Timestamptz
+}
+
+func (q *Queries) InsertInvite(ctx context.Context, arg InsertInviteParams) (Invite, error) {
+	row := q.db.QueryRow(ctx, insertInvite,
+		arg.Code,
+		arg.CommunityID,
+		arg.ChannelID,
+		arg.CreatorUserAddress,
+		arg.MaxUses,
+		arg.ExpiresAt,
+	)
+	var i Invite
+	err := row.Scan(
+		&i.Code,
+		&i.CommunityID,
+		&i.ChannelID,
+		&i.CreatorUserAddress,
+		&i.MaxUses,
+		&i.Uses,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const insertMessage = `-- name: InsertMessage :one
+INSERT INTO messages (id, channel_id, user_address, body, reply_to_message_id, thread_id, has_attachments)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+    RETURNING id, channel_id, user_address, body, created_at, updated_at, deleted_at, deleted_by, reply_to_message_id, thread_id, thread_reply_count, thread_last_activity_at, has_attachments
+`
+
+type InsertMessageParams struct {
+	ID               uuid.UUID
+	ChannelID        uuid.UUID
+	UserAddress      string
+	Body             string
+	ReplyToMessageID pgtype.UUID
+	ThreadID         pgtype.UUID
+	HasAttachments   bool
+}
+
+func (q *Queries) InsertMessage(ctx context.Context, arg InsertMessageParams) (Message, error) {
+	row := q.db.QueryRow(ctx, insertMessage,
+		arg.ID,
+		arg.ChannelID,
+		arg.UserAddress,
+		arg.Body,
+		arg.ReplyToMessageID,
+		arg.ThreadID,
+		arg.HasAttachments,
+	)
+	var i Message
+	err := row.Scan(
+		&i.ID,
+		&i.ChannelID,
+		&i.UserAddress,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.ReplyToMessageID,
+		&i.ThreadID,
+		&i.ThreadReplyCount,
+		&i.ThreadLastActivityAt,
+		&i.HasAttachments,
+	)
+	return i, err
+}
+
+const insertMessageAttachment = `-- name: InsertMessageAttachment :one
+INSERT INTO message_attachments (id, community_id, channel_id, uploaded_by, filename, content_type, size, width, height)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+    RETURNING id, community_id, channel_id, message_id, uploaded_by, filename, content_type, size, width, height, position, created_at
 `
 
-func (q *Queries) GetDefaultCommunity(ctx context.Context) (Community, error) {
-	row := q.db.QueryRow(ctx, getDefaultCommunity)
-	var i Community
+type InsertMessageAttachmentParams struct {
+	ID          uuid.UUID
+	CommunityID uuid.UUID
+	ChannelID   uuid.UUID
+	UploadedBy  string
+	Filename    string
+	ContentType string
+	Size        int64
+	Width       pgtype.Int4
+	Height      pgtype.Int4
+}
+
+func (q *Queries) InsertMessageAttachment(ctx context.Context, arg InsertMessageAttachmentParams) (MessageAttachment, error) {
+	row := q.db.QueryRow(ctx, insertMessageAttachment,
+		arg.ID,
+		arg.CommunityID,
+		arg.ChannelID,
+		arg.UploadedBy,
+		arg.Filename,
+		arg.ContentType,
+		arg.Size,
+		arg.Width,
+		arg.Height,
+	)
+	var i MessageAttachment
+	err := row.Scan(
+		&i.ID,
+		&i.CommunityID,
+		&i.ChannelID,
+		&i.MessageID,
+		&i.UploadedBy,
+		&i.Filename,
+		&i.ContentType,
+		&i.Size,
+		&i.Width,
+		&i.Height,
+		&i.Position,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const insertMessageLinkPreview = `-- name: InsertMessageLinkPreview :exec
+INSERT INTO message_link_previews (message_id, position, url, title, description, site_name, image_url)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type InsertMessageLinkPreviewParams struct {
+	MessageID   uuid.UUID
+	Position    int32
+	Url         string
+	Title       string
+	Description string
+	SiteName    string
+	ImageUrl    string
+}
+
+func (q *Queries) InsertMessageLinkPreview(ctx context.Context, arg InsertMessageLinkPreviewParams) error {
+	_, err := q.db.Exec(ctx, insertMessageLinkPreview,
+		arg.MessageID,
+		arg.Position,
+		arg.Url,
+		arg.Title,
+		arg.Description,
+		arg.SiteName,
+		arg.ImageUrl,
+	)
+	return err
+}
+
+const insertMessageRevision = `-- name: InsertMessageRevision :exec
+INSERT INTO message_revisions (id, message_id, body, created_at)
+VALUES ($1, $2, $3, $4)
+`
+
+type InsertMessageRevisionParams struct {
+	ID        uuid.UUID
+	MessageID uuid.UUID
+	Body      string
+	CreatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) InsertMessageRevision(ctx context.Context, arg InsertMessageRevisionParams) error {
+	_, err := q.db.Exec(ctx, insertMessageRevision,
+		arg.ID,
+		arg.MessageID,
+		arg.Body,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const insertRateLimitExemption = `-- name: InsertRateLimitExemption :execrows
+INSERT INTO rate_limit_exemptions (community_id, user_address, created_by)
+VALUES ($1, $2, $3)
+    ON CONFLICT (community_id, user_address) DO NOTHING
+`
+
+type InsertRateLimitExemptionParams struct {
+	CommunityID uuid.UUID
+	UserAddress string
+	CreatedBy   string
+}
+
+func (q *Queries) InsertRateLimitExemption(ctx context.Context, arg InsertRateLimitExemptionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertRateLimitExemption, arg.CommunityID, arg.UserAddress, arg.CreatedBy)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const insertRole = `-- name: InsertRole :one
+INSERT INTO roles (id, community_id, name, permissions)
+VALUES ($1, $2, $3, $4)
+    RETURNING id, community_id, name, permissions, created_at
+`
+
+type InsertRoleParams struct {
+	ID          uuid.UUID
+	CommunityID uuid.UUID
+	Name        string
+	Permissions int64
+}
+
+func (q *Queries) InsertRole(ctx context.Context, arg InsertRoleParams) (Role, error) {
+	row := q.db.QueryRow(ctx, insertRole,
+		arg.ID,
+		arg.CommunityID,
+		arg.Name,
+		arg.Permissions,
+	)
+	var i Role
 	err := row.Scan(
 		&i.ID,
+		&i.CommunityID,
 		&i.Name,
-		&i.IsDefault,
+		&i.Permissions,
 		&i.CreatedAt,
 	)
 	return i, err
 }
 
-const getMemberByUserAddress = `-- name: GetMemberByUserAddress :one
-SELECT id, user_address, created_at FROM members WHERE user_address = $1
+const isMessagePinned = `-- name: IsMessagePinned :one
+SELECT EXISTS(SELECT 1 FROM pinned_messages WHERE message_id = $1)
 `
 
-func (q *Queries) GetMemberByUserAddress(ctx context.Context, userAddress string) (Member, error) {
-	row := q.db.QueryRow(ctx, getMemberByUserAddress, userAddress)
-	var i Member
-	err := row.Scan(&i.ID, &i.UserAddress, &i.CreatedAt)
+func (q *Queries) IsMessagePinned(ctx context.Context, messageID uuid.UUID) (bool, error) {
+	row := q.db.QueryRow(ctx, isMessagePinned, messageID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const isRateLimitExempt = `-- name: IsRateLimitExempt :one
+SELECT EXISTS(SELECT 1 FROM rate_limit_exemptions WHERE community_id = $1 AND user_address = $2)
+`
+
+type IsRateLimitExemptParams struct {
+	CommunityID uuid.UUID
+	UserAddress string
+}
+
+func (q *Queries) IsRateLimitExempt(ctx context.Context, arg IsRateLimitExemptParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isRateLimitExempt, arg.CommunityID, arg.UserAddress)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const pinMessage = `-- name: PinMessage :one
+INSERT INTO pinned_messages (message_id, channel_id, pinned_by)
+VALUES ($1, $2, $3)
+    ON CONFLICT (message_id) DO NOTHING
+    RETURNING message_id, channel_id, pinned_by, pinned_at
+`
+
+type PinMessageParams struct {
+	MessageID uuid.UUID
+	ChannelID uuid.UUID
+	PinnedBy  string
+}
+
+func (q *Queries) PinMessage(ctx context.Context, arg PinMessageParams) (PinnedMessage, error) {
+	row := q.db.QueryRow(ctx, pinMessage, arg.MessageID, arg.ChannelID, arg.PinnedBy)
+	var i PinnedMessage
+	err := row.Scan(
+		&i.MessageID,
+		&i.ChannelID,
+		&i.PinnedBy,
+		&i.PinnedAt,
+	)
 	return i, err
 }
 
-const getMemberCommunities = `-- name: GetMemberCommunities :many
-SELECT c.id, c.name, c.is_default, c.created_at FROM community_members INNER JOIN communities c ON c.id = community_members.community_id WHERE community_members.member_id = $1
+const removeReaction = `-- name: RemoveReaction :execrows
+DELETE FROM message_reactions WHERE message_id = $1 AND emoji = $2 AND user_address = $3
 `
 
-func (q *Queries) GetMemberCommunities(ctx context.Context, memberID uuid.UUID) ([]Community, error) {
-	rows, err := q.db.Query(ctx, getMemberCommunities, memberID)
+type RemoveReactionParams struct {
+	MessageID   uuid.UUID
+	Emoji       string
+	UserAddress string
+}
+
+func (q *Queries) RemoveReaction(ctx context.Context, arg RemoveReactionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeReaction, arg.MessageID, arg.Emoji, arg.UserAddress)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const removeThreadReply = `-- name: RemoveThreadReply :one
+UPDATE messages SET thread_reply_count = GREATEST(thread_reply_count - 1, 0)
+WHERE id = $1
+    RETURNING id, channel_id, user_address, body, created_at, updated_at, deleted_at, deleted_by, reply_to_message_id, thread_id, thread_reply_count, thread_last_activity_at, has_attachments
+`
+
+func (q *Queries) RemoveThreadReply(ctx context.Context, id uuid.UUID) (Message, error) {
+	row := q.db.QueryRow(ctx, removeThreadReply, id)
+	var i Message
+	err := row.Scan(
+		&i.ID,
+		&i.ChannelID,
+		&i.UserAddress,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.ReplyToMessageID,
+		&i.ThreadID,
+		&i.ThreadReplyCount,
+		&i.ThreadLastActivityAt,
+		&i.HasAttachments,
+	)
+	return i, err
+}
+
+const revokeInvite = `-- name: RevokeInvite :execrows
+UPDATE invites SET revoked_at = now()
+WHERE code = $1 AND community_id = $2 AND revoked_at IS NULL
+`
+
+type RevokeInviteParams struct {
+	Code        string
+	CommunityID uuid.UUID
+}
+
+func (q *Queries) RevokeInvite(ctx context.Context, arg RevokeInviteParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeInvite, arg.Code, arg.CommunityID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const searchMessages = `-- name: SearchMessages :many
+SELECT id, channel_id, user_address, body, created_at, updated_at, deleted_at, deleted_by, reply_to_message_id, thread_id, thread_reply_count, thread_last_activity_at, has_attachments FROM messages
+WHERE channel_id = ANY($1::uuid[])
+  AND deleted_at IS NULL
+  AND to_tsvector('simple', body) @@ websearch_to_tsquery('simple', $2)
+  AND ($3::UUID IS NULL OR id < $3)
+  AND ($4::TEXT IS NULL OR lower(user_address) = lower($4))
+  AND ($5::TIMESTAMPTZ IS NULL OR created_at >= $5)
+  AND ($6::TIMESTAMPTZ IS NULL OR created_at < $6)
+  AND (NOT $7::BOOL OR has_attachments)
+ORDER BY id DESC
+LIMIT $8
+`
+
+type SearchMessagesParams struct {
+	ChannelIds     []uuid.UUID
+	Query          string
+	Before         pgtype.UUID
+	UserAddress    pgtype.Text
+	CreatedAfter   pgtype.Timestamptz
+	CreatedBefore  pgtype.Timestamptz
+	HasAttachments bool
+	MaxResults     int32
+}
+
+func (q *Queries) SearchMessages(ctx context.Context, arg SearchMessagesParams) ([]Message, error) {
+	rows, err := q.db.Query(ctx, searchMessages,
+		arg.ChannelIds,
+		arg.Query,
+		arg.Before,
+		arg.UserAddress,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.HasAttachments,
+		arg.MaxResults,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Community
+	var items []Message
 	for rows.Next() {
-		var i Community
+		var i Message
 		if err := rows.Scan(
 			&i.ID,
-			&i.Name,
-			&i.IsDefault,
+			&i.ChannelID,
+			&i.UserAddress,
+			&i.Body,
 			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.DeletedBy,
+			&i.ReplyToMessageID,
+			&i.ThreadID,
+			&i.ThreadReplyCount,
+			&i.ThreadLastActivityAt,
+			&i.HasAttachments,
 		); err != nil {
 			return nil, err
 		}
@@ -67,25 +3010,298 @@ func (q *Queries) GetMemberCommunities(ctx context.Context, memberID uuid.UUID)
 	return items, nil
 }
 
-const insertCommunity = `-- name: InsertCommunity :one
-INSERT INTO communities (id, name)
-VALUES ($1, $2)
-    RETURNING id, name, is_default, created_at
+const setCommunityMemberMutedUntil = `-- name: SetCommunityMemberMutedUntil :execrows
+UPDATE community_members SET muted_until = $3 WHERE member_id = $1 AND community_id = $2
 `
 
-type InsertCommunityParams struct {
-	ID   uuid.UUID
-	Name string
+type SetCommunityMemberMutedUntilParams struct {
+	MemberID    uuid.UUID
+	CommunityID uuid.UUID
+	MutedUntil  pgtype.Timestamptz
 }
 
-func (q *Queries) InsertCommunity(ctx context.Context, arg InsertCommunityParams) (Community, error) {
-	row := q.db.QueryRow(ctx, insertCommunity, arg.ID, arg.Name)
+func (q *Queries) SetCommunityMemberMutedUntil(ctx context.Context, arg SetCommunityMemberMutedUntilParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setCommunityMemberMutedUntil, arg.MemberID, arg.CommunityID, arg.MutedUntil)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setCommunityOwner = `-- name: SetCommunityOwner :exec
+UPDATE communities SET owner_member_id = $2 WHERE id = $1
+`
+
+type SetCommunityOwnerParams struct {
+	ID            uuid.UUID
+	OwnerMemberID pgtype.UUID
+}
+
+func (q *Queries) SetCommunityOwner(ctx context.Context, arg SetCommunityOwnerParams) error {
+	_, err := q.db.Exec(ctx, setCommunityOwner, arg.ID, arg.OwnerMemberID)
+	return err
+}
+
+const unassignMemberRole = `-- name: UnassignMemberRole :execrows
+DELETE FROM member_roles WHERE member_id = $1 AND role_id = $2
+`
+
+type UnassignMemberRoleParams struct {
+	MemberID uuid.UUID
+	RoleID   uuid.UUID
+}
+
+func (q *Queries) UnassignMemberRole(ctx context.Context, arg UnassignMemberRoleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, unassignMemberRole, arg.MemberID, arg.RoleID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const unpinMessage = `-- name: UnpinMessage :execrows
+DELETE FROM pinned_messages WHERE message_id = $1 AND channel_id = $2
+`
+
+type UnpinMessageParams struct {
+	MessageID uuid.UUID
+	ChannelID uuid.UUID
+}
+
+func (q *Queries) UnpinMessage(ctx context.Context, arg UnpinMessageParams) (int64, error) {
+	result, err := q.db.Exec(ctx, unpinMessage, arg.MessageID, arg.ChannelID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateChannelName = `-- name: UpdateChannelName :one
+UPDATE channels SET name = $3
+WHERE id = $1 AND community_id = $2
+    RETURNING id, community_id, name, created_at, slow_mode_seconds
+`
+
+type UpdateChannelNameParams struct {
+	ID          uuid.UUID
+	CommunityID uuid.UUID
+	Name        string
+}
+
+func (q *Queries) UpdateChannelName(ctx context.Context, arg UpdateChannelNameParams) (Channel, error) {
+	row := q.db.QueryRow(ctx, updateChannelName, arg.ID, arg.CommunityID, arg.Name)
+	var i Channel
+	err := row.Scan(
+		&i.ID,
+		&i.CommunityID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.SlowModeSeconds,
+	)
+	return i, err
+}
+
+const updateChannelSlowMode = `-- name: UpdateChannelSlowMode :one
+UPDATE channels SET slow_mode_seconds = $3
+WHERE id = $1 AND community_id = $2
+    RETURNING id, community_id, name, created_at, slow_mode_seconds
+`
+
+type UpdateChannelSlowModeParams struct {
+	ID              uuid.UUID
+	CommunityID     uuid.UUID
+	SlowModeSeconds int32
+}
+
+func (q *Queries) UpdateChannelSlowMode(ctx context.Context, arg UpdateChannelSlowModeParams) (Channel, error) {
+	row := q.db.QueryRow(ctx, updateChannelSlowMode, arg.ID, arg.CommunityID, arg.SlowModeSeconds)
+	var i Channel
+	err := row.Scan(
+		&i.ID,
+		&i.CommunityID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.SlowModeSeconds,
+	)
+	return i, err
+}
+
+const updateCommunity = `-- name: UpdateCommunity :one
+UPDATE communities SET name = $2, icon_url = $3 WHERE id = $1
+    RETURNING id, name, is_default, created_at, owner_member_id, icon_url
+`
+
+type UpdateCommunityParams struct {
+	ID      uuid.UUID
+	Name    string
+	IconUrl string
+}
+
+func (q *Queries) UpdateCommunity(ctx context.Context, arg UpdateCommunityParams) (Community, error) {
+	row := q.db.QueryRow(ctx, updateCommunity, arg.ID, arg.Name, arg.IconUrl)
 	var i Community
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
 		&i.IsDefault,
 		&i.CreatedAt,
+		&i.OwnerMemberID,
+		&i.IconUrl,
+	)
+	return i, err
+}
+
+const updateConversationLastMessage = `-- name: UpdateConversationLastMessage :exec
+UPDATE conversations SET last_message_at = $2 WHERE id = $1
+`
+
+type UpdateConversationLastMessageParams struct {
+	ID            uuid.UUID
+	LastMessageAt pgtype.Timestamptz
+}
+
+func (q *Queries) UpdateConversationLastMessage(ctx context.Context, arg UpdateConversationLastMessageParams) error {
+	_, err := q.db.Exec(ctx, updateConversationLastMessage, arg.ID, arg.LastMessageAt)
+	return err
+}
+
+const updateMessageBody = `-- name: UpdateMessageBody :one
+UPDATE messages SET body = $2, updated_at = now()
+WHERE id = $1 AND deleted_at IS NULL
+    RETURNING id, channel_id, user_address, body, created_at, updated_at, deleted_at, deleted_by, reply_to_message_id, thread_id, thread_reply_count, thread_last_activity_at, has_attachments
+`
+
+type UpdateMessageBodyParams struct {
+	ID   uuid.UUID
+	Body string
+}
+
+func (q *Queries) UpdateMessageBody(ctx context.Context, arg UpdateMessageBodyParams) (Message, error) {
+	row := q.db.QueryRow(ctx, updateMessageBody, arg.ID, arg.Body)
+	var i Message
+	err := row.Scan(
+		&i.ID,
+		&i.ChannelID,
+		&i.UserAddress,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.ReplyToMessageID,
+		&i.ThreadID,
+		&i.ThreadReplyCount,
+		&i.ThreadLastActivityAt,
+		&i.HasAttachments,
+	)
+	return i, err
+}
+
+const updateRole = `-- name: UpdateRole :one
+UPDATE roles SET name = $3, permissions = $4
+WHERE id = $1 AND community_id = $2
+    RETURNING id, community_id, name, permissions, created_at
+`
+
+type UpdateRoleParams struct {
+	ID          uuid.UUID
+	CommunityID uuid.UUID
+	Name        string
+	Permissions int64
+}
+
+func (q *Queries) UpdateRole(ctx context.Context, arg UpdateRoleParams) (Role, error) {
+	row := q.db.QueryRow(ctx, updateRole,
+		arg.ID,
+		arg.CommunityID,
+		arg.Name,
+		arg.Permissions,
+	)
+	var i Role
+	err := row.Scan(
+		&i.ID,
+		&i.CommunityID,
+		&i.Name,
+		&i.Permissions,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const upsertBan = `-- name: UpsertBan :one
+INSERT INTO bans (id, community_id, target, is_host, reason, banned_by, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+    ON CONFLICT (community_id, target, is_host)
+    DO UPDATE SET reason = EXCLUDED.reason, banned_by = EXCLUDED.banned_by, expires_at = EXCLUDED.expires_at, created_at = now()
+    RETURNING id, community_id, target, is_host, reason, banned_by, expires_at, created_at
+`
+
+type UpsertBanParams struct {
+	ID          uuid.UUID
+	CommunityID uuid.UUID
+	Target      string
+	IsHost      bool
+	Reason      string
+	BannedBy    string
+	ExpiresAt   pgtype.Timestamptz
+}
+
+func (q *Queries) UpsertBan(ctx context.Context, arg UpsertBanParams) (Ban, error) {
+	row := q.db.QueryRow(ctx, upsertBan,
+		arg.ID,
+		arg.CommunityID,
+		arg.Target,
+		arg.IsHost,
+		arg.Reason,
+		arg.BannedBy,
+		arg.ExpiresAt,
+	)
+	var i Ban
+	err := row.Scan(
+		&i.ID,
+		&i.CommunityID,
+		&i.Target,
+		&i.IsHost,
+		&i.Reason,
+		&i.BannedBy,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const upsertChannelOverwrite = `-- name: UpsertChannelOverwrite :one
+INSERT INTO channel_overwrites (channel_id, target_type, target, allow, deny)
+VALUES ($1, $2, $3, $4, $5)
+    ON CONFLICT (channel_id, target_type, target)
+    DO UPDATE SET allow = EXCLUDED.allow, deny = EXCLUDED.deny
+    RETURNING channel_id, target_type, target, allow, deny, created_at
+`
+
+type UpsertChannelOverwriteParams struct {
+	ChannelID  uuid.UUID
+	TargetType string
+	Target     string
+	Allow      int64
+	Deny       int64
+}
+
+func (q *Queries) UpsertChannelOverwrite(ctx context.Context, arg UpsertChannelOverwriteParams) (ChannelOverwrite, error) {
+	row := q.db.QueryRow(ctx, upsertChannelOverwrite,
+		arg.ChannelID,
+		arg.TargetType,
+		arg.Target,
+		arg.Allow,
+		arg.Deny,
+	)
+	var i ChannelOverwrite
+	err := row.Scan(
+		&i.ChannelID,
+		&i.TargetType,
+		&i.Target,
+		&i.Allow,
+		&i.Deny,
+		&i.CreatedAt,
 	)
 	return i, err
 }
@@ -94,7 +3310,7 @@ const upsertCommunityMember = `-- name: UpsertCommunityMember :one
 INSERT INTO community_members (id, member_id, community_id)
 VALUES ($1, $2, $3)
     ON CONFLICT (member_id, community_id) DO NOTHING
-    RETURNING id, member_id, community_id, created_at
+    RETURNING id, member_id, community_id, created_at, muted_until
 `
 
 type UpsertCommunityMemberParams struct {
@@ -111,6 +3327,7 @@ func (q *Queries) UpsertCommunityMember(ctx context.Context, arg UpsertCommunity
 		&i.MemberID,
 		&i.CommunityID,
 		&i.CreatedAt,
+		&i.MutedUntil,
 	)
 	return i, err
 }
@@ -121,7 +3338,7 @@ VALUES ($1, $2, true)
     ON CONFLICT (is_default)
     WHERE is_default = TRUE
     DO NOTHING
-    RETURNING id, name, is_default, created_at
+    RETURNING id, name, is_default, created_at, owner_member_id, icon_url
 `
 
 type UpsertDefaultCommunityParams struct {
@@ -137,6 +3354,8 @@ func (q *Queries) UpsertDefaultCommunity(ctx context.Context, arg UpsertDefaultC
 		&i.Name,
 		&i.IsDefault,
 		&i.CreatedAt,
+		&i.OwnerMemberID,
+		&i.IconUrl,
 	)
 	return i, err
 }
@@ -159,3 +3378,29 @@ func (q *Queries) UpsertMember(ctx context.Context, arg UpsertMemberParams) (Mem
 	err := row.Scan(&i.ID, &i.UserAddress, &i.CreatedAt)
 	return i, err
 }
+
+const useInvite = `-- name: UseInvite :one
+UPDATE invites SET uses = uses + 1
+WHERE code = $1
+  AND revoked_at IS NULL
+  AND (expires_at IS NULL OR expires_at > now())
+  AND (max_uses = 0 OR uses < max_uses)
+    RETURNING code, community_id, channel_id, creator_user_address, max_uses, uses, expires_at, revoked_at, created_at
+`
+
+func (q *Queries) UseInvite(ctx context.Context, code string) (Invite, error) {
+	row := q.db.QueryRow(ctx, useInvite, code)
+	var i Invite
+	err := row.Scan(
+		&i.Code,
+		&i.CommunityID,
+		&i.ChannelID,
+		&i.CreatorUserAddress,
+		&i.MaxUses,
+		&i.Uses,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -68,3 +68,14 @@ func (c *S3Client) PutObject(ctx context.Context, key string, data io.Reader) (s
 	}
 	return key, nil
 }
+
+func (c *S3Client) DeleteObject(ctx context.Context, key string) error {
+	_, err := c.s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+	return nil
+}
@@ -16,6 +16,7 @@ type Scope struct {
 type FileStore interface {
 	GetObject(ctx context.Context, key string) (io.ReadCloser, error)
 	PutObject(ctx context.Context, key string, data io.Reader) (string, error)
+	DeleteObject(ctx context.Context, key string) error
 }
 
 func NewScope(store FileStore, prefix string) *Scope {
@@ -33,6 +34,10 @@ func (s *Scope) PutObject(ctx context.Context, key string, data io.Reader) (stri
 	return s.store.PutObject(ctx, s.buildKey(key), data)
 }
 
+func (s *Scope) DeleteObject(ctx context.Context, key string) error {
+	return s.store.DeleteObject(ctx, s.buildKey(key))
+}
+
 func (s *Scope) buildKey(key string) string {
 	return path.Join(s.prefix, key)
 }
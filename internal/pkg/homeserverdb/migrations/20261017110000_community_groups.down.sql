@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS community_group_entries;
+DROP TABLE IF EXISTS community_groups;
@@ -0,0 +1,24 @@
+CREATE TABLE community_groups (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    position INT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE INDEX community_groups_user_id_idx
+    ON community_groups (user_id);
+
+-- community_id is the id of the community on the community server at host
+CREATE TABLE community_group_entries (
+    group_id UUID NOT NULL REFERENCES community_groups (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    host TEXT NOT NULL,
+    community_id TEXT NOT NULL,
+    position INT NOT NULL,
+    PRIMARY KEY (group_id, host, community_id)
+);
+
+-- A community is in at most one group of a user
+CREATE UNIQUE INDEX community_group_entries_user_id_host_community_id_idx
+    ON community_group_entries (user_id, host, community_id);
@@ -0,0 +1,24 @@
+-- Notifications pushed by community servers to the users of this homeserver. host is the community server
+-- that sent the notification, and the ids are ids on that server.
+CREATE TABLE notifications (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    type SMALLINT NOT NULL,
+    host TEXT NOT NULL,
+    community_id TEXT NOT NULL,
+    community_name TEXT NOT NULL,
+    channel_id TEXT NOT NULL,
+    channel_name TEXT NOT NULL,
+    message_id TEXT NOT NULL,
+    author_address TEXT NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    read_at TIMESTAMPTZ
+);
+
+-- A message notifies a user at most once, so redelivered notifications are ignored
+CREATE UNIQUE INDEX notifications_user_id_host_message_id_idx
+    ON notifications (user_id, host, message_id);
+
+CREATE INDEX notifications_user_id_created_at_idx
+    ON notifications (user_id, created_at DESC, id DESC);
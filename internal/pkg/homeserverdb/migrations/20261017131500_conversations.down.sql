@@ -0,0 +1,3 @@
+ALTER TABLE notifications DROP COLUMN IF EXISTS conversation_id;
+
+DROP TABLE IF EXISTS user_conversation_hosts;
@@ -0,0 +1,11 @@
+-- Servers hosting direct message conversations of the users of this homeserver. They are added when a server
+-- notifies a user of a direct message, so the conversations of the user can be listed from every server.
+CREATE TABLE user_conversation_hosts (
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    host TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, host)
+);
+
+-- Direct message notifications reference a conversation instead of a community and channel
+ALTER TABLE notifications ADD COLUMN conversation_id TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,8 @@
+ALTER TABLE community_group_entries DROP CONSTRAINT IF EXISTS community_group_entries_user_id_group_id_fkey;
+ALTER TABLE community_group_entries DROP CONSTRAINT IF EXISTS community_group_entries_pkey;
+ALTER TABLE community_groups DROP CONSTRAINT IF EXISTS community_groups_pkey;
+
+ALTER TABLE community_groups ADD PRIMARY KEY (id);
+ALTER TABLE community_group_entries ADD PRIMARY KEY (group_id, host, community_id);
+ALTER TABLE community_group_entries ADD CONSTRAINT community_group_entries_group_id_fkey
+    FOREIGN KEY (group_id) REFERENCES community_groups (id) ON DELETE CASCADE;
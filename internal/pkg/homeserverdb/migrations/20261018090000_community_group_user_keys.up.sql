@@ -0,0 +1,9 @@
+-- Group ids are chosen by clients, so they are only unique per user
+ALTER TABLE community_group_entries DROP CONSTRAINT community_group_entries_group_id_fkey;
+ALTER TABLE community_group_entries DROP CONSTRAINT community_group_entries_pkey;
+ALTER TABLE community_groups DROP CONSTRAINT community_groups_pkey;
+
+ALTER TABLE community_groups ADD PRIMARY KEY (user_id, id);
+ALTER TABLE community_group_entries ADD PRIMARY KEY (user_id, group_id, host, community_id);
+ALTER TABLE community_group_entries ADD CONSTRAINT community_group_entries_user_id_group_id_fkey
+    FOREIGN KEY (user_id, group_id) REFERENCES community_groups (user_id, id) ON DELETE CASCADE;
@@ -0,0 +1 @@
+ALTER TABLE user_conversation_hosts DROP COLUMN IF EXISTS status;
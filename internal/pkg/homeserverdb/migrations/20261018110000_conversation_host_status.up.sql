@@ -0,0 +1,4 @@
+-- Servers that deliver a direct message to a user are pending until the user accepts or blocks them. Only
+-- accepted servers store direct message notifications and are asked for the conversations of the user.
+-- Status matches homeserver.v1.ConversationHost.Status: 1 is pending, 2 is accepted and 3 is blocked.
+ALTER TABLE user_conversation_hosts ADD COLUMN status SMALLINT NOT NULL DEFAULT 1;
@@ -9,6 +9,39 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type CommunityGroup struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Name      string
+	Position  int32
+	CreatedAt pgtype.Timestamptz
+}
+
+type CommunityGroupEntry struct {
+	GroupID     uuid.UUID
+	UserID      uuid.UUID
+	Host        string
+	CommunityID string
+	Position    int32
+}
+
+type Notification struct {
+	ID             uuid.UUID
+	UserID         uuid.UUID
+	Type           int16
+	Host           string
+	CommunityID    string
+	CommunityName  string
+	ChannelID      string
+	ChannelName    string
+	MessageID      string
+	AuthorAddress  string
+	Body           string
+	CreatedAt      pgtype.Timestamptz
+	ReadAt         pgtype.Timestamptz
+	ConversationID string
+}
+
 type User struct {
 	ID           uuid.UUID
 	Username     string
@@ -18,6 +51,13 @@ type User struct {
 	CreatedAt    pgtype.Timestamptz
 }
 
+type UserConversationHost struct {
+	UserID    uuid.UUID
+	Host      string
+	CreatedAt pgtype.Timestamptz
+	Status    int16
+}
+
 type UserServer struct {
 	ID        int64
 	UserID    uuid.UUID
@@ -21,4 +21,102 @@ VALUES ($1, $2)
     RETURNING *;
 
 -- name: GetUserServers :many
-SELECT host FROM user_servers WHERE user_id = @user_id;
\ No newline at end of file
+SELECT host FROM user_servers WHERE user_id = @user_id;
+
+-- name: DeleteUserServer :execrows
+DELETE FROM user_servers WHERE user_id = $1 AND host = $2;
+
+-- name: GetCommunityGroups :many
+SELECT * FROM community_groups WHERE user_id = $1 ORDER BY position;
+
+-- name: GetCommunityGroupEntries :many
+SELECT * FROM community_group_entries WHERE user_id = $1 ORDER BY position;
+
+-- name: InsertCommunityGroup :exec
+INSERT INTO community_groups (id, user_id, name, position)
+VALUES ($1, $2, $3, $4);
+
+-- name: InsertCommunityGroupEntry :exec
+INSERT INTO community_group_entries (group_id, user_id, host, community_id, position)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: DeleteCommunityGroups :exec
+DELETE FROM community_groups WHERE user_id = $1;
+
+-- name: DeleteHostCommunityGroupEntries :exec
+DELETE FROM community_group_entries WHERE user_id = $1 AND host = $2;
+
+-- name: InsertNotifications :execrows
+INSERT INTO notifications (id, user_id, type, host, community_id, community_name, channel_id, channel_name, message_id, author_address, body)
+SELECT gen_random_uuid(), user_servers.user_id, @type::smallint, @host::text, @community_id::text, @community_name::text,
+       @channel_id::text, @channel_name::text, @message_id::text, @author_address::text, @body::text
+FROM user_servers
+WHERE user_servers.user_id = ANY(@user_ids::uuid[]) AND user_servers.host = @host::text
+    ON CONFLICT (user_id, host, message_id) DO NOTHING;
+
+-- name: InsertDirectMessageNotifications :execrows
+INSERT INTO notifications (id, user_id, type, host, community_id, community_name, channel_id, channel_name, message_id, author_address, body, conversation_id)
+SELECT gen_random_uuid(), user_conversation_hosts.user_id, @type::smallint, @host::text, '', '', '', '',
+       @message_id::text, @author_address::text, @body::text, @conversation_id::text
+FROM user_conversation_hosts
+WHERE user_conversation_hosts.user_id = ANY(@user_ids::uuid[]) AND user_conversation_hosts.host = @host::text
+    AND user_conversation_hosts.status = 2
+    ON CONFLICT (user_id, host, message_id) DO NOTHING;
+
+-- name: DeleteExcessNotifications :exec
+DELETE FROM notifications
+WHERE id IN (
+    SELECT ranked.id FROM (
+        SELECT notifications.id, row_number() OVER (PARTITION BY notifications.user_id ORDER BY notifications.created_at DESC, notifications.id DESC) AS position
+        FROM notifications
+        WHERE notifications.user_id = ANY(@user_ids::uuid[])
+    ) ranked
+    WHERE ranked.position > @max_notifications::bigint
+);
+
+-- name: GetLatestNotifications :many
+SELECT * FROM notifications
+WHERE user_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2;
+
+-- name: GetNotificationsBefore :many
+SELECT notifications.* FROM notifications
+    INNER JOIN notifications anchor ON anchor.id = @before AND anchor.user_id = notifications.user_id
+WHERE notifications.user_id = @user_id
+    AND (notifications.created_at, notifications.id) < (anchor.created_at, anchor.id)
+ORDER BY notifications.created_at DESC, notifications.id DESC
+LIMIT @max_results;
+
+-- name: CountUnreadNotifications :one
+SELECT count(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL;
+
+-- name: MarkNotificationsRead :exec
+UPDATE notifications SET read_at = now()
+WHERE user_id = $1 AND id = ANY(@ids::uuid[]) AND read_at IS NULL;
+
+-- name: MarkAllNotificationsRead :exec
+UPDATE notifications SET read_at = now()
+WHERE user_id = $1 AND read_at IS NULL;
+
+-- name: InsertUserConversationHosts :exec
+INSERT INTO user_conversation_hosts (user_id, host, status)
+SELECT users.id, @host::text,
+       CASE WHEN EXISTS (SELECT 1 FROM user_servers WHERE user_servers.user_id = users.id AND lower(user_servers.host) = lower(@host::text)) THEN 2 ELSE 1 END
+FROM users
+WHERE users.id = ANY(@user_ids::uuid[])
+    AND (SELECT count(*) FROM user_conversation_hosts WHERE user_conversation_hosts.user_id = users.id) < @max_hosts::bigint
+    ON CONFLICT (user_id, host) DO NOTHING;
+
+-- name: GetUserConversationHosts :many
+SELECT * FROM user_conversation_hosts WHERE user_id = $1 ORDER BY created_at, host;
+
+-- name: GetAcceptedUserConversationHosts :many
+SELECT host FROM user_conversation_hosts WHERE user_id = $1 AND status = 2 ORDER BY created_at, host;
+
+-- name: UpdateUserConversationHostStatus :execrows
+UPDATE user_conversation_hosts SET status = $3
+WHERE user_id = $1 AND host = $2;
+
+-- name: DeleteUserConversationHost :execrows
+DELETE FROM user_conversation_hosts WHERE user_id = $1 AND host = $2;
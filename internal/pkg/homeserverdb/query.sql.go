@@ -12,6 +12,17 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countUnreadNotifications = `-- name: CountUnreadNotifications :one
+SELECT count(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL
+`
+
+func (q *Queries) CountUnreadNotifications(ctx context.Context, userID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countUnreadNotifications, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createAnonymousUser = `-- name: CreateAnonymousUser :one
 INSERT INTO users (id, username, display_name)
 VALUES ($1, $2, $3)
@@ -72,6 +83,266 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 	return i, err
 }
 
+const deleteCommunityGroups = `-- name: DeleteCommunityGroups :exec
+DELETE FROM community_groups WHERE user_id = $1
+`
+
+func (q *Queries) DeleteCommunityGroups(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteCommunityGroups, userID)
+	return err
+}
+
+const deleteExcessNotifications = `-- name: DeleteExcessNotifications :exec
+DELETE FROM notifications
+WHERE id IN (
+    SELECT ranked.id FROM (
+        SELECT notifications.id, row_number() OVER (PARTITION BY notifications.user_id ORDER BY notifications.created_at DESC, notifications.id DESC) AS position
+        FROM notifications
+        WHERE notifications.user_id = ANY($1::uuid[])
+    ) ranked
+    WHERE ranked.position > $2::bigint
+)
+`
+
+type DeleteExcessNotificationsParams struct {
+	UserIds          []uuid.UUID
+	MaxNotifications int64
+}
+
+func (q *Queries) DeleteExcessNotifications(ctx context.Context, arg DeleteExcessNotificationsParams) error {
+	_, err := q.db.Exec(ctx, deleteExcessNotifications, arg.UserIds, arg.MaxNotifications)
+	return err
+}
+
+const deleteHostCommunityGroupEntries = `-- name: DeleteHostCommunityGroupEntries :exec
+DELETE FROM community_group_entries WHERE user_id = $1 AND host = $2
+`
+
+type DeleteHostCommunityGroupEntriesParams struct {
+	UserID uuid.UUID
+	Host   string
+}
+
+func (q *Queries) DeleteHostCommunityGroupEntries(ctx context.Context, arg DeleteHostCommunityGroupEntriesParams) error {
+	_, err := q.db.Exec(ctx, deleteHostCommunityGroupEntries, arg.UserID, arg.Host)
+	return err
+}
+
+const deleteUserConversationHost = `-- name: DeleteUserConversationHost :execrows
+DELETE FROM user_conversation_hosts WHERE user_id = $1 AND host = $2
+`
+
+type DeleteUserConversationHostParams struct {
+	UserID uuid.UUID
+	Host   string
+}
+
+func (q *Queries) DeleteUserConversationHost(ctx context.Context, arg DeleteUserConversationHostParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUserConversationHost, arg.UserID, arg.Host)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteUserServer = `-- name: DeleteUserServer :execrows
+DELETE FROM user_servers WHERE user_id = $1 AND host = $2
+`
+
+type DeleteUserServerParams struct {
+	UserID uuid.UUID
+	Host   string
+}
+
+func (q *Queries) DeleteUserServer(ctx context.Context, arg DeleteUserServerParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUserServer, arg.UserID, arg.Host)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getAcceptedUserConversationHosts = `-- name: GetAcceptedUserConversationHosts :many
+SELECT host FROM user_conversation_hosts WHERE user_id = $1 AND status = 2 ORDER BY created_at, host
+`
+
+func (q *Queries) GetAcceptedUserConversationHosts(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	rows, err := q.db.Query(ctx, getAcceptedUserConversationHosts, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var host string
+		if err := rows.Scan(&host); err != nil {
+			return nil, err
+		}
+		items = append(items, host)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCommunityGroupEntries = `-- name: GetCommunityGroupEntries :many
+SELECT group_id, user_id, host, community_id, position FROM community_group_entries WHERE user_id = $1 ORDER BY position
+`
+
+func (q *Queries) GetCommunityGroupEntries(ctx context.Context, userID uuid.UUID) ([]CommunityGroupEntry, error) {
+	rows, err := q.db.Query(ctx, getCommunityGroupEntries, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CommunityGroupEntry
+	for rows.Next() {
+		var i CommunityGroupEntry
+		if err := rows.Scan(
+			&i.GroupID,
+			&i.UserID,
+			&i.Host,
+			&i.CommunityID,
+			&i.Position,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCommunityGroups = `-- name: GetCommunityGroups :many
+SELECT id, user_id, name, position, created_at FROM community_groups WHERE user_id = $1 ORDER BY position
+`
+
+func (q *Queries) GetCommunityGroups(ctx context.Context, userID uuid.UUID) ([]CommunityGroup, error) {
+	rows, err := q.db.Query(ctx, getCommunityGroups, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CommunityGroup
+	for rows.Next() {
+		var i CommunityGroup
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Position,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLatestNotifications = `-- name: GetLatestNotifications :many
+SELECT id, user_id, type, host, community_id, community_name, channel_id, channel_name, message_id, author_address, body, created_at, read_at, conversation_id FROM notifications
+WHERE user_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2
+`
+
+type GetLatestNotificationsParams struct {
+	UserID uuid.UUID
+	Limit  int32
+}
+
+func (q *Queries) GetLatestNotifications(ctx context.Context, arg GetLatestNotificationsParams) ([]Notification, error) {
+	rows, err := q.db.Query(ctx, getLatestNotifications, arg.UserID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Notification
+	for rows.Next() {
+		var i Notification
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Type,
+			&i.Host,
+			&i.CommunityID,
+			&i.CommunityName,
+			&i.ChannelID,
+			&i.ChannelName,
+			&i.MessageID,
+			&i.AuthorAddress,
+			&i.Body,
+			&i.CreatedAt,
+			&i.ReadAt,
+			&i.ConversationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getNotificationsBefore = `-- name: GetNotificationsBefore :many
+SELECT notifications.id, notifications.user_id, notifications.type, notifications.host, notifications.community_id, notifications.community_name, notifications.channel_id, notifications.channel_name, notifications.message_id, notifications.author_address, notifications.body, notifications.created_at, notifications.read_at, notifications.conversation_id FROM notifications
+    INNER JOIN notifications anchor ON anchor.id = $1 AND anchor.user_id = notifications.user_id
+WHERE notifications.user_id = $2
+    AND (notifications.created_at, notifications.id) < (anchor.created_at, anchor.id)
+ORDER BY notifications.created_at DESC, notifications.id DESC
+LIMIT $3
+`
+
+type GetNotificationsBeforeParams struct {
+	Before     uuid.UUID
+	UserID     uuid.UUID
+	MaxResults int32
+}
+
+func (q *Queries) GetNotificationsBefore(ctx context.Context, arg GetNotificationsBeforeParams) ([]Notification, error) {
+	rows, err := q.db.Query(ctx, getNotificationsBefore, arg.Before, arg.UserID, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Notification
+	for rows.Next() {
+		var i Notification
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Type,
+			&i.Host,
+			&i.CommunityID,
+			&i.CommunityName,
+			&i.ChannelID,
+			&i.ChannelName,
+			&i.MessageID,
+			&i.AuthorAddress,
+			&i.Body,
+			&i.CreatedAt,
+			&i.ReadAt,
+			&i.ConversationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUserById = `-- name: GetUserById :one
 SELECT id, username, display_name, email FROM users WHERE id = $1
 `
@@ -113,6 +384,35 @@ func (q *Queries) GetUserByLogin(ctx context.Context, login string) (User, error
 	return i, err
 }
 
+const getUserConversationHosts = `-- name: GetUserConversationHosts :many
+SELECT user_id, host, created_at, status FROM user_conversation_hosts WHERE user_id = $1 ORDER BY created_at, host
+`
+
+func (q *Queries) GetUserConversationHosts(ctx context.Context, userID uuid.UUID) ([]UserConversationHost, error) {
+	rows, err := q.db.Query(ctx, getUserConversationHosts, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserConversationHost
+	for rows.Next() {
+		var i UserConversationHost
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Host,
+			&i.CreatedAt,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUserServers = `-- name: GetUserServers :many
 SELECT host FROM user_servers WHERE user_id = $1
 `
@@ -137,6 +437,194 @@ func (q *Queries) GetUserServers(ctx context.Context, userID uuid.UUID) ([]strin
 	return items, nil
 }
 
+const insertCommunityGroup = `-- name: InsertCommunityGroup :exec
+INSERT INTO community_groups (id, user_id, name, position)
+VALUES ($1, $2, $3, $4)
+`
+
+type InsertCommunityGroupParams struct {
+	ID       uuid.UUID
+	UserID   uuid.UUID
+	Name     string
+	Position int32
+}
+
+func (q *Queries) InsertCommunityGroup(ctx context.Context, arg InsertCommunityGroupParams) error {
+	_, err := q.db.Exec(ctx, insertCommunityGroup,
+		arg.ID,
+		arg.UserID,
+		arg.Name,
+		arg.Position,
+	)
+	return err
+}
+
+const insertCommunityGroupEntry = `-- name: InsertCommunityGroupEntry :exec
+INSERT INTO community_group_entries (group_id, user_id, host, community_id, position)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type InsertCommunityGroupEntryParams struct {
+	GroupID     uuid.UUID
+	UserID      uuid.UUID
+	Host        string
+	CommunityID string
+	Position    int32
+}
+
+func (q *Queries) InsertCommunityGroupEntry(ctx context.Context, arg InsertCommunityGroupEntryParams) error {
+	_, err := q.db.Exec(ctx, insertCommunityGroupEntry,
+		arg.GroupID,
+		arg.UserID,
+		arg.Host,
+		arg.CommunityID,
+		arg.Position,
+	)
+	return err
+}
+
+const insertDirectMessageNotifications = `-- name: InsertDirectMessageNotifications :execrows
+INSERT INTO notifications (id, user_id, type, host, community_id, community_name, channel_id, channel_name, message_id, author_address, body, conversation_id)
+SELECT gen_random_uuid(), user_conversation_hosts.user_id, $1::smallint, $2::text, '', '', '', '',
+       $3::text, $4::text, $5::text, $6::text
+FROM user_conversation_hosts
+WHERE user_conversation_hosts.user_id = ANY($7::uuid[]) AND user_conversation_hosts.host = $2::text
+    AND user_conversation_hosts.status = 2
+    ON CONFLICT (user_id, host, message_id) DO NOTHING
+`
+
+type InsertDirectMessageNotificationsParams struct {
+	Type           int16
+	Host           string
+	MessageID      string
+	AuthorAddress  string
+	Body           string
+	ConversationID string
+	UserIds        []uuid.UUID
+}
+
+func (q *Queries) InsertDirectMessageNotifications(ctx context.Context, arg InsertDirectMessageNotificationsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertDirectMessageNotifications,
+		arg.Type,
+		arg.Host,
+		arg.MessageID,
+		arg.AuthorAddress,
+		arg.Body,
+		arg.ConversationID,
+		arg.UserIds,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const insertNotifications = `-- name: InsertNotifications :execrows
+INSERT INTO notifications (id, user_id, type, host, community_id, community_name, channel_id, channel_name, message_id, author_address, body)
+SELECT gen_random_uuid(), user_servers.user_id, $1::smallint, $2::text, $3::text, $4::text,
+       $5::text, $6::text, $7::text, $8::text, $9::text
+FROM user_servers
+WHERE user_servers.user_id = ANY($10::uuid[]) AND user_servers.host = $2::text
+    ON CONFLICT (user_id, host, message_id) DO NOTHING
+`
+
+type InsertNotificationsParams struct {
+	Type          int16
+	Host          string
+	CommunityID   string
+	CommunityName string
+	ChannelID     string
+	ChannelName   string
+	MessageID     string
+	AuthorAddress string
+	Body          string
+	UserIds       []uuid.UUID
+}
+
+func (q *Queries) InsertNotifications(ctx context.Context, arg InsertNotificationsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertNotifications,
+		arg.Type,
+		arg.Host,
+		arg.CommunityID,
+		arg.CommunityName,
+		arg.ChannelID,
+		arg.ChannelName,
+		arg.MessageID,
+		arg.AuthorAddress,
+		arg.Body,
+		arg.UserIds,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const insertUserConversationHosts = `-- name: InsertUserConversationHosts :exec
+INSERT INTO user_conversation_hosts (user_id, host, status)
+SELECT users.id, $1::text,
+       CASE WHEN EXISTS (SELECT 1 FROM user_servers WHERE user_servers.user_id = users.id AND lower(user_servers.host) = lower($1::text)) THEN 2 ELSE 1 END
+FROM users
+WHERE users.id = ANY($2::uuid[])
+    AND (SELECT count(*) FROM user_conversation_hosts WHERE user_conversation_hosts.user_id = users.id) < $3::bigint
+    ON CONFLICT (user_id, host) DO NOTHING
+`
+
+type InsertUserConversationHostsParams struct {
+	Host     string
+	UserIds  []uuid.UUID
+	MaxHosts int64
+}
+
+func (q *Queries) InsertUserConversationHosts(ctx context.Context, arg InsertUserConversationHostsParams) error {
+	_, err := q.db.Exec(ctx, insertUserConversationHosts, arg.Host, arg.UserIds, arg.MaxHosts)
+	return err
+}
+
+const markAllNotificationsRead = `-- name: MarkAllNotificationsRead :exec
+UPDATE notifications SET read_at = now()
+WHERE user_id = $1 AND read_at IS NULL
+`
+
+func (q *Queries) MarkAllNotificationsRead(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, markAllNotificationsRead, userID)
+	return err
+}
+
+const markNotificationsRead = `-- name: MarkNotificationsRead :exec
+UPDATE notifications SET read_at = now()
+WHERE user_id = $1 AND id = ANY($2::uuid[]) AND read_at IS NULL
+`
+
+type MarkNotificationsReadParams struct {
+	UserID uuid.UUID
+	Ids    []uuid.UUID
+}
+
+func (q *Queries) MarkNotificationsRead(ctx context.Context, arg MarkNotificationsReadParams) error {
+	_, err := q.db.Exec(ctx, markNotificationsRead, arg.UserID, arg.Ids)
+	return err
+}
+
+const updateUserConversationHostStatus = `-- name: UpdateUserConversationHostStatus :execrows
+UPDATE user_conversation_hosts SET status = $3
+WHERE user_id = $1 AND host = $2
+`
+
+type UpdateUserConversationHostStatusParams struct {
+	UserID uuid.UUID
+	Host   string
+	Status int16
+}
+
+func (q *Queries) UpdateUserConversationHostStatus(ctx context.Context, arg UpdateUserConversationHostStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateUserConversationHostStatus, arg.UserID, arg.Host, arg.Status)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const upsertUserServer = `-- name: UpsertUserServer :one
 INSERT INTO user_servers (user_id, host)
 VALUES ($1, $2)
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"os"
 	"os/signal"
+	"strconv"
 
 	"github.com/joho/godotenv"
 	"github.com/redis/go-redis/v9"
@@ -69,6 +70,7 @@ func Run() error {
 
 	baseFileStore := filestore.NewScope(s3Client, os.Getenv("FILE_STORE_PREFIX"))
 	externalFileStore := filestore.NewScope(baseFileStore, "external")
+	communityFileStore := filestore.NewScope(baseFileStore, "community")
 
 	htmlTemplate, err := html2.NewTemplate()
 	if err != nil {
@@ -84,13 +86,30 @@ func Run() error {
 
 	homeserverHost := os.Getenv("HOMESERVER_HOST")
 
+	communityCreationPolicy, err := community.ParseCreationPolicy(os.Getenv("COMMUNITY_CREATION_POLICY"), os.Getenv("COMMUNITY_CREATION_ALLOWLIST"))
+	if err != nil {
+		slog.Error("failed parsing community creation policy", "error", err)
+		return err
+	}
+
+	// Identity tokens are addressed to the server they are used with. Tokens of homeservers that don't address
+	// them yet are only accepted while this is set.
+	allowTokensWithoutAudience := false
+	if value := os.Getenv("COMMUNITY_ALLOW_IDENTITY_TOKENS_WITHOUT_AUDIENCE"); value != "" {
+		allowTokensWithoutAudience, err = strconv.ParseBool(value)
+		if err != nil {
+			slog.Error("failed parsing COMMUNITY_ALLOW_IDENTITY_TOKENS_WITHOUT_AUDIENCE", "error", err)
+			return err
+		}
+	}
+
 	// HTTP routes
 	homeserverRoutes := homeserver.NewRoutes(redisClient, homeserverDbClient, htmlTemplate, imageProxyConfig, homeserverHost, os.Getenv("HOMESERVER_IDENTITY_PRIVATE_KEY"), os.Getenv("HOMESERVER_IDENTITY_PUBLIC_KEY"))
-	communityRoutes := community.NewRoutes(communityDbClient)
+	communityRoutes := community.NewRoutes(redisClient, communityDbClient, imageProxyConfig, communityCreationPolicy, communityFileStore, homeserverHost, os.Getenv("HOMESERVER_IDENTITY_PRIVATE_KEY"), allowTokensWithoutAudience)
 	imageProxyRoutes := imageproxy.NewRoutes(externalFileStore, imageProxyConfig)
 
 	// Initializations
-	err = community.Initialize(ctx, communityDbClient, homeserverHost)
+	err = community.Initialize(ctx, communityDbClient, homeserverHost, os.Getenv("COMMUNITY_DEFAULT_OWNER"))
 	if err != nil {
 		slog.Error("failed initializing communityserver", "error", err)
 		return err
@@ -101,6 +120,12 @@ func Run() error {
 
 	httpServer := httputil.NewServer(ctx, os.Getenv("HTTP_SERVER_PORT"), homeserverRoutes, communityRoutes, imageProxyRoutes)
 	errGroup.Go(httpServer.Serve)
+	errGroup.Go(func() error {
+		return communityRoutes.RunEventBus(ctx)
+	})
+	errGroup.Go(func() error {
+		return communityRoutes.RunAttachmentCleanup(ctx)
+	})
 
 	err = errGroup.Wait()
 	if err != nil {